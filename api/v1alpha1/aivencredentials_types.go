@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CredentialsSecretReference references a Secret in any namespace containing an Aiven authentication token
+type CredentialsSecretReference struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the secret
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Key in the secret containing the token
+	Key string `json:"key"`
+}
+
+// AivenCredentialsSpec defines the desired state of AivenCredentials
+type AivenCredentialsSpec struct {
+	// Secret containing the Aiven token
+	SecretRef CredentialsSecretReference `json:"secretRef"`
+
+	// Namespaces allowed to use the credentials.
+	// When not set, only the objects in the namespace of the secret can use the credentials.
+	// An empty selector allows all namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=aivencredentials,scope=Cluster
+
+// AivenCredentials is a cluster-wide Aiven token that objects in the selected namespaces can use with `credentialsRef`
+// +kubebuilder:printcolumn:name="Secret Namespace",type="string",JSONPath=".spec.secretRef.namespace"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name"
+type AivenCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AivenCredentialsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AivenCredentialsList contains a list of AivenCredentials
+type AivenCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AivenCredentials `json:"items"`
+}
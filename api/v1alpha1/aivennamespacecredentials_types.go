@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AivenNamespaceCredentialsSpec defines the desired state of AivenNamespaceCredentials
+type AivenNamespaceCredentialsSpec struct {
+	// Secret in the same namespace containing the Aiven token
+	SecretRef AuthSecretReference `json:"secretRef"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=aivennamespacecredentials
+
+// AivenNamespaceCredentials is an Aiven token that objects in the same namespace can use with `credentialsRef`
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name"
+type AivenNamespaceCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AivenNamespaceCredentialsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AivenNamespaceCredentialsList contains a list of AivenNamespaceCredentials
+type AivenNamespaceCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AivenNamespaceCredentials `json:"items"`
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSPrivateLinkSpec defines the desired state of AWSPrivateLink
+type AWSPrivateLinkSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern="^arn:aws:iam::[0-9]{12}:[^ ]+$"
+	// +listType=set
+	// AWS principals allowed to connect to the VPC endpoint service, for example `arn:aws:iam::012345678901:root`
+	Principals []string `json:"principals"`
+}
+
+// AWSPrivateLinkStatus defines the observed state of AWSPrivateLink
+type AWSPrivateLinkStatus struct {
+	PrivateLinkStatus `json:",inline"`
+
+	// ID of the AWS VPC endpoint service
+	AWSServiceID string `json:"awsServiceId,omitempty"`
+
+	// Name of the AWS VPC endpoint service to create the VPC endpoints for
+	AWSServiceName string `json:"awsServiceName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AWSPrivateLink is the Schema for the awsprivatelinks API.
+// Exposes a service through an AWS VPC endpoint service.
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="AWS Service Name",type="string",JSONPath=".status.awsServiceName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type AWSPrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSPrivateLinkSpec   `json:"spec,omitempty"`
+	Status AWSPrivateLinkStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &AWSPrivateLink{}
+
+func (*AWSPrivateLink) NoSecret() bool {
+	return true
+}
+
+func (in *AWSPrivateLink) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AWSPrivateLink) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *AWSPrivateLink) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AWSPrivateLink) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// AWSPrivateLinkList contains a list of AWSPrivateLink
+type AWSPrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSPrivateLink `json:"items"`
+}
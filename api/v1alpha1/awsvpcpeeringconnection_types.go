@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSVPCPeeringConnectionSpec defines the desired state of AWSVPCPeeringConnection
+type AWSVPCPeeringConnectionSpec struct {
+	VPCPeeringConnectionSpec `json:",inline"`
+
+	// +kubebuilder:validation:Pattern="^[0-9]{12}$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS account ID of the peered VPC
+	AWSAccountID string `json:"awsAccountId"`
+
+	// +kubebuilder:validation:Pattern="^vpc-[0-9a-f]+$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ID of the peered AWS VPC
+	AWSVPCID string `json:"awsVpcId"`
+
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS region of the peered VPC, for example `eu-west-1`
+	AWSVPCRegion string `json:"awsVpcRegion"`
+}
+
+// AWSVPCPeeringConnectionStatus defines the observed state of AWSVPCPeeringConnection
+type AWSVPCPeeringConnectionStatus struct {
+	VPCPeeringConnectionStatus `json:",inline"`
+
+	// The AWS VPC peering connection ID to accept on the AWS side
+	AWSVPCPeeringConnectionID string `json:"awsVpcPeeringConnectionId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AWSVPCPeeringConnection is the Schema for the awsvpcpeeringconnections API.
+// Peers a ProjectVPC with an AWS VPC. The connection stays `PENDING_PEER` until it is accepted on the AWS side.
+// Info "Exposes secret keys": `AWSVPCPEERINGCONNECTION_PROJECT_VPC_ID`, `AWSVPCPEERINGCONNECTION_STATE`, `AWSVPCPEERINGCONNECTION_AWS_VPC_PEERING_CONNECTION_ID`, `AWSVPCPEERINGCONNECTION_AWS_ACCOUNT_ID`, `AWSVPCPEERINGCONNECTION_AWS_VPC_ID`, `AWSVPCPEERINGCONNECTION_AWS_VPC_REGION`
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="AWS VPC ID",type="string",JSONPath=".spec.awsVpcId"
+// +kubebuilder:printcolumn:name="Peering Connection ID",type="string",JSONPath=".status.awsVpcPeeringConnectionId"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type AWSVPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSVPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status AWSVPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+var _ VPCPeeringConnection = &AWSVPCPeeringConnection{}
+
+func (in *AWSVPCPeeringConnection) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AWSVPCPeeringConnection) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *AWSVPCPeeringConnection) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AWSVPCPeeringConnection) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AWSVPCPeeringConnection) NoSecret() bool {
+	return in.Spec.ConnInfoSecretTargetDisabled != nil && *in.Spec.ConnInfoSecretTargetDisabled
+}
+
+func (in *AWSVPCPeeringConnection) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return in.Spec.ConnInfoSecretTarget
+}
+
+// GetRefs blocks the connection until the ProjectVPC is ACTIVE.
+func (in *AWSVPCPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.VPCPeeringConnectionSpec.GetRefs(in.GetNamespace())
+}
+
+func (in *AWSVPCPeeringConnection) GetPeeringSpec() *VPCPeeringConnectionSpec {
+	return &in.Spec.VPCPeeringConnectionSpec
+}
+
+func (in *AWSVPCPeeringConnection) GetPeeringStatus() *VPCPeeringConnectionStatus {
+	return &in.Status.VPCPeeringConnectionStatus
+}
+
+// +kubebuilder:object:root=true
+
+// AWSVPCPeeringConnectionList contains a list of AWSVPCPeeringConnection
+type AWSVPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSVPCPeeringConnection `json:"items"`
+}
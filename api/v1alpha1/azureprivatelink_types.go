@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzurePrivateLinkSpec defines the desired state of AzurePrivateLink
+type AzurePrivateLinkSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Format=uuid
+	// +listType=set
+	// Azure subscription IDs allowed to connect to the Private Link service
+	UserSubscriptionIDs []string `json:"userSubscriptionIds"`
+
+	// +kubebuilder:validation:MaxItems=64
+	// +listMapKey=privateEndpointId
+	// +listType=map
+	// Private endpoint connections to approve. A connection is approved once it is pending user approval.
+	Connections []AzurePrivateLinkConnection `json:"connections,omitempty"`
+}
+
+// AzurePrivateLinkConnection is a private endpoint connection to approve
+type AzurePrivateLinkConnection struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Azure resource ID of the private endpoint
+	PrivateEndpointID string `json:"privateEndpointId"`
+
+	// +kubebuilder:validation:Format=ipv4
+	// IP address of the private endpoint, used for the privatelink DNS records
+	UserIPAddress string `json:"userIpAddress,omitempty"`
+}
+
+// AzurePrivateLinkStatus defines the observed state of AzurePrivateLink
+type AzurePrivateLinkStatus struct {
+	PrivateLinkStatus `json:",inline"`
+
+	// Alias of the Azure Private Link service to create the private endpoints for
+	AzureServiceAlias string `json:"azureServiceAlias,omitempty"`
+
+	// ID of the Azure Private Link service
+	AzureServiceID string `json:"azureServiceId,omitempty"`
+
+	// Message of the Private Link service state
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AzurePrivateLink is the Schema for the azureprivatelinks API.
+// Exposes a service through an Azure Private Link service and approves the private endpoint connections.
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Azure Service Alias",type="string",JSONPath=".status.azureServiceAlias"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type AzurePrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzurePrivateLinkSpec   `json:"spec,omitempty"`
+	Status AzurePrivateLinkStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &AzurePrivateLink{}
+
+func (*AzurePrivateLink) NoSecret() bool {
+	return true
+}
+
+func (in *AzurePrivateLink) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AzurePrivateLink) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *AzurePrivateLink) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AzurePrivateLink) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// AzurePrivateLinkList contains a list of AzurePrivateLink
+type AzurePrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzurePrivateLink `json:"items"`
+}
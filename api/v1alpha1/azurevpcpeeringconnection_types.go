@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureVPCPeeringConnectionSpec defines the desired state of AzureVPCPeeringConnection
+type AzureVPCPeeringConnectionSpec struct {
+	VPCPeeringConnectionSpec `json:",inline"`
+
+	// +kubebuilder:validation:Format=uuid
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure subscription ID of the peered VNet
+	AzureSubscriptionID string `json:"azureSubscriptionId"`
+
+	// +kubebuilder:validation:MaxLength=90
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure resource group of the peered VNet
+	PeerResourceGroup string `json:"peerResourceGroup"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the peered Azure VNet
+	VnetName string `json:"vnetName"`
+
+	// +kubebuilder:validation:Format=uuid
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ID of the Azure app that is allowed to create the peering to the VNet
+	PeerAzureAppID string `json:"peerAzureAppId"`
+
+	// +kubebuilder:validation:Format=uuid
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure tenant ID of the app
+	PeerAzureTenantID string `json:"peerAzureTenantId"`
+}
+
+// AzureVPCPeeringConnectionStatus defines the observed state of AzureVPCPeeringConnection
+type AzureVPCPeeringConnectionStatus struct {
+	VPCPeeringConnectionStatus `json:",inline"`
+
+	// The Azure tenant ID of the Aiven VNet
+	AivenTenantID string `json:"aivenTenantId,omitempty"`
+
+	// The resource ID of the Aiven VNet to peer with on the Azure side
+	AivenNetworkID string `json:"aivenNetworkId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AzureVPCPeeringConnection is the Schema for the azurevpcpeeringconnections API.
+// Peers a ProjectVPC with an Azure VNet. The connection stays `PENDING_PEER` until the peering is created on the Azure side.
+// Info "Exposes secret keys": `AZUREVPCPEERINGCONNECTION_PROJECT_VPC_ID`, `AZUREVPCPEERINGCONNECTION_STATE`, `AZUREVPCPEERINGCONNECTION_AIVEN_TENANT_ID`, `AZUREVPCPEERINGCONNECTION_AIVEN_NETWORK_ID`, `AZUREVPCPEERINGCONNECTION_AZURE_SUBSCRIPTION_ID`, `AZUREVPCPEERINGCONNECTION_PEER_RESOURCE_GROUP`, `AZUREVPCPEERINGCONNECTION_VNET_NAME`
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Resource Group",type="string",JSONPath=".spec.peerResourceGroup"
+// +kubebuilder:printcolumn:name="VNet",type="string",JSONPath=".spec.vnetName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type AzureVPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureVPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status AzureVPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+var _ VPCPeeringConnection = &AzureVPCPeeringConnection{}
+
+func (in *AzureVPCPeeringConnection) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AzureVPCPeeringConnection) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *AzureVPCPeeringConnection) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AzureVPCPeeringConnection) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AzureVPCPeeringConnection) NoSecret() bool {
+	return in.Spec.ConnInfoSecretTargetDisabled != nil && *in.Spec.ConnInfoSecretTargetDisabled
+}
+
+func (in *AzureVPCPeeringConnection) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return in.Spec.ConnInfoSecretTarget
+}
+
+// GetRefs blocks the connection until the ProjectVPC is ACTIVE.
+func (in *AzureVPCPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.VPCPeeringConnectionSpec.GetRefs(in.GetNamespace())
+}
+
+func (in *AzureVPCPeeringConnection) GetPeeringSpec() *VPCPeeringConnectionSpec {
+	return &in.Spec.VPCPeeringConnectionSpec
+}
+
+func (in *AzureVPCPeeringConnection) GetPeeringStatus() *VPCPeeringConnectionStatus {
+	return &in.Status.VPCPeeringConnectionStatus
+}
+
+// +kubebuilder:object:root=true
+
+// AzureVPCPeeringConnectionList contains a list of AzureVPCPeeringConnection
+type AzureVPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureVPCPeeringConnection `json:"items"`
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"github.com/aiven/go-client-codegen/handler/billinggroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BillingGroupSpec defines the desired state of BillingGroup.
+type BillingGroupSpec struct {
+	AuthSecretRefField `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the billing group.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Name of the billing group.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// Credit card ID; The ID may be either last 4 digits of the card or the actual ID
+	CardID string `json:"cardId,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:items:MaxLength=255
+	// Address lines of the billing address, e.g. the street and the building.
+	AddressLines []string `json:"addressLines,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Company name of the billing address.
+	Company string `json:"company,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// City of the billing address.
+	City string `json:"city,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// State or province of the billing address.
+	State string `json:"state,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=32
+	// Zip code of the billing address.
+	ZipCode string `json:"zipCode,omitempty"`
+
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=2
+	// Two-letter country code of the billing address, e.g. `FI`.
+	CountryCode string `json:"countryCode,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MaxLength=254
+	// Billing contact emails of the billing group.
+	// This list is authoritative: when omitted, emails added outside Kubernetes are removed.
+	BillingEmails []string `json:"billingEmails,omitempty"`
+
+	// +kubebuilder:validation:Enum=AUD;CAD;CHF;DKK;EUR;GBP;NOK;SEK;USD
+	// Billing currency. Aiven uses `USD` if omitted.
+	BillingCurrency billinggroup.BillingCurrencyType `json:"billingCurrency,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1000
+	// Extra text to be included in all invoices of the billing group, e.g. purchase order or cost center number
+	BillingExtraText string `json:"billingExtraText,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// EU VAT Identification Number
+	VatID string `json:"vatId,omitempty"`
+}
+
+// BillingGroupStatus defines the observed state of BillingGroup.
+type BillingGroupStatus struct {
+	// Conditions represent the latest available observations of a BillingGroup state.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the billing group, used by `billingGroupRef` of Project and OrganizationProject.
+	BillingGroupID string `json:"billingGroupId,omitempty"`
+
+	// Payment method name
+	PaymentMethod string `json:"paymentMethod,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BillingGroup is the Schema for the billinggroups API.
+// Manages a billing group of an organization.
+// Projects use it with `billingGroupRef`, the billing group can't be deleted while projects in Kubernetes reference it.
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Billing Group ID",type="string",JSONPath=".status.billingGroupId"
+type BillingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BillingGroupSpec   `json:"spec,omitempty"`
+	Status BillingGroupStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &BillingGroup{}
+
+func (in *BillingGroup) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *BillingGroup) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *BillingGroup) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *BillingGroup) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*BillingGroup) NoSecret() bool {
+	return true
+}
+
+// +kubebuilder:object:root=true
+
+// BillingGroupList contains a list of BillingGroup.
+type BillingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BillingGroup `json:"items"`
+}
@@ -11,6 +11,7 @@ import (
 // ClickhouseSpec defines the desired state of Clickhouse
 type ClickhouseSpec struct {
 	ServiceCommonSpec `json:",inline"`
+	ServiceForkFields `json:",inline"`
 
 	// OpenSearch specific user configuration options
 	UserConfig *clickhouseuserconfig.ClickhouseUserConfig `json:"userConfig,omitempty"`
@@ -48,6 +49,10 @@ func (in *Clickhouse) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Clickhouse) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Clickhouse) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -59,6 +59,10 @@ func (in *ClickhouseDatabase) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ClickhouseDatabase) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ClickhouseDatabase) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
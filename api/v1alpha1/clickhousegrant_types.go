@@ -147,6 +147,10 @@ func (in *ClickhouseGrant) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ClickhouseGrant) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ClickhouseGrant) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -40,6 +40,10 @@ func (in *ClickhouseRole) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ClickhouseRole) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ClickhouseRole) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	"errors"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,6 +31,11 @@ type ClickhouseUserSpec struct {
 	// when the secret data is updated.
 	ConnInfoSecretSource *ConnInfoSecretSource `json:"connInfoSecretSource,omitempty"`
 
+	// Rotation makes the operator generate a new password on a schedule.
+	// Set the `controllers.aiven.io/rotate-password` annotation to a new value, e.g. the current time, to rotate the password now.
+	// Cannot be used with connInfoSecretSource.
+	Rotation *PasswordRotation `json:"rotation,omitempty"`
+
 	// Name of the Clickhouse user. Defaults to `metadata.name` if omitted.
 	// Note: `metadata.name` is ASCII-only. For UTF-8 names, use `spec.username`, but ASCII is advised for compatibility.
 	// +kubebuilder:validation:MaxLength=63
@@ -44,6 +51,8 @@ type ClickhouseUserStatus struct {
 	// Conditions represent the latest available observations of an ClickhouseUser state
 	// +kubebuilder:validation:type=array
 	Conditions []metav1.Condition `json:"conditions"`
+
+	PasswordRotationStatus `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
@@ -67,6 +76,19 @@ func (in *ClickhouseUser) GetConnInfoSecretSource() *ConnInfoSecretSource {
 	return in.Spec.ConnInfoSecretSource
 }
 
+// Validate runs complex validation on ClickhouseUserSpec
+func (in *ClickhouseUserSpec) Validate() error {
+	return errors.Join(in.ConnInfoSecretTarget.Validate(), validatePasswordRotation(in.Rotation, in.ConnInfoSecretSource))
+}
+
+func (in *ClickhouseUser) GetPasswordRotation() *PasswordRotation {
+	return in.Spec.Rotation
+}
+
+func (in *ClickhouseUser) GetPasswordRotationStatus() *PasswordRotationStatus {
+	return &in.Status.PasswordRotationStatus
+}
+
 var _ AivenManagedObject = &ClickhouseUser{}
 
 func (in *ClickhouseUser) GetUsername() string {
@@ -87,6 +109,10 @@ func (in *ClickhouseUser) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ClickhouseUser) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ClickhouseUser) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aiven/go-client-codegen/handler/service"
 	"github.com/docker/go-units"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -23,6 +25,19 @@ type AuthSecretReference struct {
 	Key string `json:"key"`
 }
 
+// CredentialsReference references an AivenCredentials or AivenNamespaceCredentials object
+type CredentialsReference struct {
+	// +kubebuilder:validation:Enum=AivenCredentials;AivenNamespaceCredentials
+	// +kubebuilder:default=AivenCredentials
+	// Kind of the credentials, AivenCredentials or AivenNamespaceCredentials
+	Kind string `json:"kind,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Name of the credentials.
+	// AivenNamespaceCredentials must be in the same namespace as the resource.
+	Name string `json:"name"`
+}
+
 // ConnInfoSecretTarget contains information secret name
 type ConnInfoSecretTarget struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
@@ -38,6 +53,137 @@ type ConnInfoSecretTarget struct {
 	// Added "as is" without any transformations.
 	// By default, is equal to the kind name in uppercase + underscore, e.g. `KAFKA_`, `REDIS_`, etc.
 	Prefix string `json:"prefix,omitempty"`
+	// Extra keys of the secret rendered from Go templates over the connection details
+	Template *ConnInfoSecretTemplate `json:"template,omitempty"`
+	// Where the connection details are written, a Kubernetes secret by default
+	Sink *ConnInfoSecretSink `json:"sink,omitempty"`
+}
+
+// GetSinkType returns the sink type, ConnInfoSecretSinkKubernetes if not set
+func (in *ConnInfoSecretTarget) GetSinkType() string {
+	if in.Sink == nil || in.Sink.Type == "" {
+		return ConnInfoSecretSinkKubernetes
+	}
+	return in.Sink.Type
+}
+
+// ConnInfoSecretTemplate renders extra keys of the connection secret, e.g. JDBC URLs or client configuration files.
+type ConnInfoSecretTemplate struct {
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[-._a-zA-Z0-9]+$'))",message="keys must consist of alphanumeric characters, '-', '_' or '.'"
+	// Secret keys and their Go templates.
+	// The templates get the other keys of the secret with the prefix, e.g. `{{ .PG_HOST }}:{{ .PG_PORT }}`.
+	// Template keys replace the keys of the secret with the same name.
+	// Functions: `b64enc`, `b64dec`, `default`, `lower`, `upper`, `replace`, `trim` and the Go template builtins.
+	Data map[string]string `json:"data"`
+}
+
+const (
+	ConnInfoSecretSinkKubernetes = "Kubernetes"
+	ConnInfoSecretSinkVault      = "Vault"
+	ConnInfoSecretSinkHTTP       = "HTTP"
+)
+
+// ConnInfoSecretSink writes the connection details outside the Kubernetes secret.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Vault' || has(self.vault)",message="vault is required for the Vault sink"
+// +kubebuilder:validation:XValidation:rule="self.type != 'HTTP' || has(self.http)",message="http is required for the HTTP sink"
+type ConnInfoSecretSink struct {
+	// +kubebuilder:validation:Enum=Kubernetes;Vault;HTTP
+	// +kubebuilder:default=Kubernetes
+	// Sink type. `Kubernetes` writes the secret `name`, `Vault` and `HTTP` write the connection details
+	// to the external store only.
+	// PostgreSQL, MySQL and OpenSearch also write the Kubernetes secret, other resources use it to connect to the service.
+	Type string `json:"type,omitempty"`
+	// Vault KV v2 secrets engine configuration
+	Vault *VaultSecretSink `json:"vault,omitempty"`
+	// HTTP endpoint configuration
+	HTTP *HTTPSecretSink `json:"http,omitempty"`
+}
+
+// VaultSecretSink writes the connection details to a Vault KV v2 secret.
+// A new secret version is written only when the details change.
+type VaultSecretSink struct {
+	// +kubebuilder:validation:Pattern="^https?://"
+	// Vault address, e.g. `https://vault.example.com:8200`
+	Address string `json:"address"`
+	// +kubebuilder:validation:Pattern="^[^/]+$"
+	// +kubebuilder:default=secret
+	// Mount path of the KV v2 secrets engine
+	Mount string `json:"mount,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	// Secret path within the mount, e.g. `apps/my-app/postgresql`
+	Path string `json:"path"`
+	// Vault Enterprise namespace
+	Namespace string `json:"namespace,omitempty"`
+	// Secret in the resource namespace with the Vault token
+	TokenSecretRef AuthSecretReference `json:"tokenSecretRef"`
+}
+
+// HTTPSecretSink sends the connection details to an HTTP endpoint.
+// The details are sent with PUT as a JSON object: `{"name": "...", "namespace": "...", "data": {...}}`.
+type HTTPSecretSink struct {
+	// +kubebuilder:validation:Pattern="^https?://"
+	// Endpoint URL
+	URL string `json:"url"`
+	// Secret in the resource namespace with the bearer token for the `Authorization` header
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// PasswordRotation makes the operator generate a new password on a schedule.
+type PasswordRotation struct {
+	// Time between rotations, e.g. `2160h` for 90 days. The minimum is `1h`
+	Interval metav1.Duration `json:"interval"`
+	// Limits the scheduled rotations to a weekly window. Rotations run at any time if omitted
+	MaintenanceWindow *PasswordRotationWindow `json:"maintenanceWindow,omitempty"`
+	// Keeps the previous password in the `<prefix>PREVIOUS_PASSWORD` secret key for this long after a rotation, e.g. `24h`.
+	// Aiven accepts only the current password, the previous one is kept for the applications to detect the change
+	KeepPreviousFor *metav1.Duration `json:"keepPreviousFor,omitempty"`
+}
+
+// PasswordRotationWindow is a weekly time window in UTC.
+type PasswordRotationWindow struct {
+	// +kubebuilder:validation:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
+	// Day of the week. Every day if omitted
+	Dow string `json:"dow,omitempty"`
+	// +kubebuilder:validation:Pattern="^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$"
+	// Start of the window, UTC time in HH:mm:ss format
+	Time string `json:"time"`
+	// Length of the window, defaults to `4h`
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// validatePasswordRotation checks the rotation of a user with the given password source
+func validatePasswordRotation(rotation *PasswordRotation, source *ConnInfoSecretSource) error {
+	if rotation == nil {
+		return nil
+	}
+	if source != nil {
+		return fmt.Errorf("rotation cannot be used with connInfoSecretSource, rotate the password in the source secret instead")
+	}
+	return rotation.Validate()
+}
+
+// PasswordRotationStatus is the observed state of the password rotation.
+type PasswordRotationStatus struct {
+	// Time of the last password rotation
+	LastRotatedAt *metav1.Time `json:"lastRotatedAt,omitempty"`
+
+	// Value of the `controllers.aiven.io/rotate-password` annotation handled by the last rotation
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+}
+
+// Validate checks the rotation interval and window
+func (in *PasswordRotation) Validate() error {
+	if in.Interval.Duration < time.Hour {
+		return fmt.Errorf("rotation.interval must be at least 1h, got %s", in.Interval.Duration)
+	}
+	if in.KeepPreviousFor != nil && in.KeepPreviousFor.Duration < 0 {
+		return fmt.Errorf("rotation.keepPreviousFor must not be negative")
+	}
+	if w := in.MaintenanceWindow; w != nil && w.Duration != nil && (w.Duration.Duration <= 0 || w.Duration.Duration > 7*24*time.Hour) {
+		return fmt.Errorf("rotation.maintenanceWindow.duration must be between 0 and 168h, got %s", w.Duration.Duration)
+	}
+	return nil
 }
 
 // ConnInfoSecretSource contains information about existing secret to read connection parameters from.
@@ -62,6 +208,78 @@ type ServiceStatus struct {
 
 	// Service state
 	State service.ServiceStateType `json:"state,omitempty"`
+
+	// IDs of the static IPs associated with the service by the operator
+	StaticIPs []string `json:"staticIps,omitempty"`
+
+	// Service and backup the service was created from with `forkFrom`
+	ForkedFrom *ServiceForkStatus `json:"forkedFrom,omitempty"`
+}
+
+// ServiceForkStatus is the origin of a forked service
+type ServiceForkStatus struct {
+	// Project of the forked service
+	Project string `json:"project"`
+
+	// Name of the forked service
+	ServiceName string `json:"serviceName"`
+
+	// Point in time the service was recovered to
+	RecoveryTargetTime *metav1.Time `json:"recoveryTargetTime,omitempty"`
+
+	// Name of the backup the service was created from
+	BackupName string `json:"backupName,omitempty"`
+
+	// Time of the backup the service was created from
+	BackupTime *metav1.Time `json:"backupTime,omitempty"`
+}
+
+// ServiceForkSource is the service to fork
+type ServiceForkSource struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z][-a-z0-9]+$"
+	// Name of the service to fork
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_-]+$"
+	// Project of the service to fork. Defaults to the project of the new service
+	Project string `json:"project,omitempty"`
+}
+
+// ServiceForkFields creates the service from a backup of another service.
+// The fields have effect only when the service is created and can't be changed later.
+// +kubebuilder:validation:XValidation:rule="!has(self.recoveryTargetTime) || has(self.forkFrom)",message="recoveryTargetTime requires forkFrom"
+type ServiceForkFields struct {
+	// Creates the service from the latest backup of another service. Can only be set during service creation.
+	// Takes precedence over the fork options of `userConfig`
+	ForkFrom *ServiceForkSource `json:"forkFrom,omitempty"`
+
+	// Recovers the forked service to the given point in time, e.g. `2026-01-02T15:04:05Z`.
+	// Supported by PostgreSQL and MySQL. Can only be set during service creation
+	RecoveryTargetTime *metav1.Time `json:"recoveryTargetTime,omitempty"`
+}
+
+// ValidateFork checks the fork fields of a new service, pitr tells whether the service type supports recoveryTargetTime
+func (in *ServiceForkFields) ValidateFork(pitr bool) error {
+	if in.RecoveryTargetTime == nil {
+		return nil
+	}
+	if !pitr {
+		return errors.New("recoveryTargetTime is not supported by the service type")
+	}
+	if in.RecoveryTargetTime.After(time.Now()) {
+		return errors.New("recoveryTargetTime must be in the past")
+	}
+	return nil
+}
+
+// ValidateForkUpdate rejects changes of the fork fields, the service can't be forked once created
+func (in *ServiceForkFields) ValidateForkUpdate(old *ServiceForkFields) error {
+	if !equality.Semantic.DeepEqual(in, old) {
+		return errors.New("forkFrom and recoveryTargetTime can only be set during service creation")
+	}
+	return nil
 }
 
 type ServiceTechEmail struct {
@@ -89,6 +307,10 @@ type ServiceField struct {
 type AuthSecretRefField struct {
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// Reference to AivenCredentials or AivenNamespaceCredentials with the Aiven token.
+	// Takes precedence over authSecretRef.
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
 }
 
 type ProjectDependant struct {
@@ -119,6 +341,10 @@ type BaseServiceFields struct {
 	// ProjectVPCRef reference to ProjectVPC resource to use its ID as ProjectVPCID automatically
 	ProjectVPCRef *ResourceReference `json:"projectVPCRef,omitempty"`
 
+	// +kubebuilder:validation:MaxItems=64
+	// StaticIPs to associate with the service, in the same cloud. Enables `static_ips` in the user config automatically.
+	StaticIPs []ResourceReference `json:"staticIps,omitempty"`
+
 	// +kubebuilder:validation:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
 	// Day of week when maintenance operations should be performed. One monday, tuesday, wednesday, etc.
 	MaintenanceWindowDow service.DowType `json:"maintenanceWindowDow,omitempty"`
@@ -182,6 +408,9 @@ func (in *BaseServiceFields) GetRefs(namespace string) (refs []*ResourceReferenc
 	if in.ProjectVPCRef != nil {
 		refs = append(refs, in.ProjectVPCRef.ProjectVPC(namespace))
 	}
+	for i := range in.StaticIPs {
+		refs = append(refs, in.StaticIPs[i].StaticIP(namespace))
+	}
 	return refs
 }
 
@@ -196,6 +425,11 @@ type ServiceCommonSpec struct {
 	DiskSpace string `json:"disk_space,omitempty"`
 }
 
+// Validate runs complex validation on ServiceCommonSpec and its secret target
+func (in *ServiceCommonSpec) Validate() error {
+	return errors.Join(in.BaseServiceFields.Validate(), in.ConnInfoSecretTarget.Validate())
+}
+
 // ResourceReference is a generic reference to another resource.
 // Resource referring to another (dependency) won't start reconciliation until
 // dependency is not ready
@@ -236,6 +470,46 @@ func (in *ResourceReference) ProjectVPC(objNamespace string) *ResourceReferenceO
 	return in.ref("ProjectVPC", objNamespace)
 }
 
+// StaticIP returns reference StaticIP kind
+func (in *ResourceReference) StaticIP(objNamespace string) *ResourceReferenceObject {
+	return in.ref("StaticIP", objNamespace)
+}
+
+// OpenSearch returns reference OpenSearch kind
+func (in *ResourceReference) OpenSearch(objNamespace string) *ResourceReferenceObject {
+	return in.ref("OpenSearch", objNamespace)
+}
+
+// MySQL returns reference MySQL kind
+func (in *ResourceReference) MySQL(objNamespace string) *ResourceReferenceObject {
+	return in.ref("MySQL", objNamespace)
+}
+
+// PostgreSQL returns reference PostgreSQL kind
+func (in *ResourceReference) PostgreSQL(objNamespace string) *ResourceReferenceObject {
+	return in.ref("PostgreSQL", objNamespace)
+}
+
+// BillingGroup returns reference BillingGroup kind
+func (in *ResourceReference) BillingGroup(objNamespace string) *ResourceReferenceObject {
+	return in.ref("BillingGroup", objNamespace)
+}
+
+// OrganizationApplicationUser returns reference OrganizationApplicationUser kind
+func (in *ResourceReference) OrganizationApplicationUser(objNamespace string) *ResourceReferenceObject {
+	return in.ref("OrganizationApplicationUser", objNamespace)
+}
+
+// OrganizationUserGroup returns reference OrganizationUserGroup kind
+func (in *ResourceReference) OrganizationUserGroup(objNamespace string) *ResourceReferenceObject {
+	return in.ref("OrganizationUserGroup", objNamespace)
+}
+
+// OpenSearchSecurityConfig returns reference OpenSearchSecurityConfig kind
+func (in *ResourceReference) OpenSearchSecurityConfig(objNamespace string) *ResourceReferenceObject {
+	return in.ref("OpenSearchSecurityConfig", objNamespace)
+}
+
 // ResourceReferenceObject is a composite "key" to resource
 // GroupVersionKind is for resource "type": GroupVersionKind{Group: "aiven.io", Version: "v1alpha1", Kind: "Kafka"}
 // NamespacedName is for specific instance: NamespacedName{Name: "my-kafka", Namespace: "default"}
@@ -262,6 +536,17 @@ func FindProjectVPC(refs []Object) *ProjectVPC {
 	return nil
 }
 
+// FindStaticIPs returns StaticIPs from reference list
+func FindStaticIPs(refs []Object) []*StaticIP {
+	var ips []*StaticIP
+	for _, o := range refs {
+		if ip, ok := o.(*StaticIP); ok {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
 // ErrorSubstrChecker returns error checker for containing given substrings
 func ErrorSubstrChecker(substrings ...string) func(error) bool {
 	return func(err error) bool {
@@ -332,6 +617,7 @@ type AivenManagedObject interface {
 	Object
 
 	AuthSecretRef() *AuthSecretReference
+	CredentialsRef() *CredentialsReference
 	Conditions() *[]metav1.Condition
 	GetObjectMeta() *metav1.ObjectMeta
 	NoSecret() bool
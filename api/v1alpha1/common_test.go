@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestConvertDiskSpace(t *testing.T) {
@@ -24,3 +26,17 @@ func TestConvertDiskSpace(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceForkFieldsValidate(t *testing.T) {
+	past := &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	fork := &ServiceForkFields{ForkFrom: &ServiceForkSource{ServiceName: "pg-source"}, RecoveryTargetTime: past}
+	assert.NoError(t, fork.ValidateFork(true))
+	assert.ErrorContains(t, fork.ValidateFork(false), "not supported")
+
+	future := &ServiceForkFields{ForkFrom: fork.ForkFrom, RecoveryTargetTime: &metav1.Time{Time: time.Now().Add(time.Hour)}}
+	assert.ErrorContains(t, future.ValidateFork(true), "must be in the past")
+
+	assert.NoError(t, fork.ValidateForkUpdate(fork.DeepCopy()))
+	assert.ErrorContains(t, fork.ValidateForkUpdate(&ServiceForkFields{}), "only be set during service creation")
+	assert.ErrorContains(t, (&ServiceForkFields{}).ValidateForkUpdate(fork), "only be set during service creation")
+}
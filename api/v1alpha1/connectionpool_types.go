@@ -64,6 +64,10 @@ func (in *ConnectionPool) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ConnectionPool) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ConnectionPool) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
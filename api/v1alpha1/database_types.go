@@ -65,6 +65,10 @@ func (in *Database) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Database) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Database) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
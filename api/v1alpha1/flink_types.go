@@ -38,6 +38,10 @@ func (in *Flink) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Flink) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Flink) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FlinkApplicationSpec defines the desired state of FlinkApplication
+type FlinkApplicationSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// Application name. Defaults to `metadata.name` if omitted.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	ApplicationName string `json:"applicationName,omitempty"`
+
+	// Job SQL statement
+	// +kubebuilder:validation:MinLength=1
+	Statement string `json:"statement"`
+
+	// Sink tables of the application
+	Sinks []FlinkApplicationTable `json:"sinks,omitempty"`
+
+	// Source tables of the application
+	Sources []FlinkApplicationTable `json:"sources,omitempty"`
+}
+
+// FlinkApplicationTable is a Flink table used as a sink or a source.
+type FlinkApplicationTable struct {
+	// The CREATE TABLE statement
+	// +kubebuilder:validation:MinLength=1
+	CreateTable string `json:"createTable"`
+
+	// The integration ID of the table. The table uses the Flink service integration if omitted.
+	// +kubebuilder:validation:Format=uuid
+	IntegrationID string `json:"integrationId,omitempty"`
+}
+
+// FlinkApplicationStatus defines the observed state of FlinkApplication
+type FlinkApplicationStatus struct {
+	// Conditions represent the latest available observations of an FlinkApplication state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Application ID
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// The latest application version number
+	Version int `json:"version,omitempty"`
+
+	// The latest application version ID
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkApplication is the Schema for the flinkapplications API.
+// Aiven application versions are immutable: every change of the statement, sinks or sources creates a new version.
+// Use FlinkApplicationDeployment to run the latest version.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Application ID",type="string",JSONPath=".status.applicationId"
+// +kubebuilder:printcolumn:name="Version",type="number",JSONPath=".status.version"
+type FlinkApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkApplicationSpec   `json:"spec,omitempty"`
+	Status FlinkApplicationStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &FlinkApplication{}
+
+// GetApplicationName returns Spec.ApplicationName or ObjectMeta.Name if empty.
+func (in *FlinkApplication) GetApplicationName() string {
+	if in.Spec.ApplicationName != "" {
+		return in.Spec.ApplicationName
+	}
+	return in.Name
+}
+
+func (*FlinkApplication) NoSecret() bool {
+	return true
+}
+
+func (in *FlinkApplication) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkApplication) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *FlinkApplication) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *FlinkApplication) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkApplicationList contains a list of FlinkApplication
+type FlinkApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkApplication `json:"items"`
+}
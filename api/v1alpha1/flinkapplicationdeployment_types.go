@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FlinkApplicationDeploymentSpec defines the desired state of FlinkApplicationDeployment
+type FlinkApplicationDeploymentSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// The FlinkApplication to deploy. The deployment runs the latest version of the application.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ApplicationRef LocalFlinkApplicationRef `json:"applicationRef"`
+
+	// Flink job parallelism
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=1
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// Restart the job automatically when it fails
+	// +kubebuilder:default=true
+	RestartEnabled *bool `json:"restartEnabled,omitempty"`
+
+	// The savepoint to start the first deployment from
+	// +kubebuilder:validation:MaxLength=2048
+	StartingSavepoint string `json:"startingSavepoint,omitempty"`
+
+	// When the application version or the deployment settings change, stops the running job with a savepoint
+	// and starts the new deployment from it. When false, the running job is canceled and the new deployment starts from scratch.
+	// +kubebuilder:default=true
+	RestartFromSavepoint *bool `json:"restartFromSavepoint,omitempty"`
+
+	// Cancel the job without a savepoint when the resource is deleted. By default, the job is stopped with a savepoint.
+	CancelOnDelete bool `json:"cancelOnDelete,omitempty"`
+}
+
+// LocalFlinkApplicationRef references a FlinkApplication in the same namespace as the owner.
+type LocalFlinkApplicationRef struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// Name of the FlinkApplication resource in the same namespace.
+	Name string `json:"name"`
+}
+
+// FlinkApplicationDeploymentStatus defines the observed state of FlinkApplicationDeployment
+type FlinkApplicationDeploymentStatus struct {
+	// Conditions represent the latest available observations of an FlinkApplicationDeployment state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Application ID
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	FlinkJobStatus `json:",inline"`
+}
+
+// FlinkJobStatus is the state of a Flink application deployment.
+type FlinkJobStatus struct {
+	// Deployment ID
+	DeploymentID string `json:"deploymentId,omitempty"`
+
+	// The deployed application version ID
+	VersionID string `json:"versionId,omitempty"`
+
+	// Flink job ID
+	JobID string `json:"jobId,omitempty"`
+
+	// Flink job state, for example `RUNNING`, `FAILED` or `CANCELED`
+	State string `json:"state,omitempty"`
+
+	// The last savepoint of the job
+	LastSavepoint string `json:"lastSavepoint,omitempty"`
+
+	// The last job error
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkApplicationDeployment is the Schema for the flinkapplicationdeployments API.
+// Aiven deployments are immutable: when the application version or the deployment settings change,
+// the running job is stopped and a new deployment is created.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Application",type="string",JSONPath=".spec.applicationRef.name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type FlinkApplicationDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkApplicationDeploymentSpec   `json:"spec,omitempty"`
+	Status FlinkApplicationDeploymentStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &FlinkApplicationDeployment{}
+
+func (*FlinkApplicationDeployment) NoSecret() bool {
+	return true
+}
+
+func (in *FlinkApplicationDeployment) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkApplicationDeployment) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *FlinkApplicationDeployment) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *FlinkApplicationDeployment) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetRefs blocks the deployment until the FlinkApplication is ready.
+func (in *FlinkApplicationDeployment) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{{
+		GroupVersionKind: GroupVersion.WithKind("FlinkApplication"),
+		NamespacedName: types.NamespacedName{
+			Namespace: in.GetNamespace(),
+			Name:      in.Spec.ApplicationRef.Name,
+		},
+	}}
+}
+
+// IsRestartFromSavepoint returns true when the redeployment starts from a savepoint of the running job.
+func (in *FlinkApplicationDeployment) IsRestartFromSavepoint() bool {
+	return in.Spec.RestartFromSavepoint == nil || *in.Spec.RestartFromSavepoint
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkApplicationDeploymentList contains a list of FlinkApplicationDeployment
+type FlinkApplicationDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkApplicationDeployment `json:"items"`
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FlinkJarApplicationSpec defines the desired state of FlinkJarApplication
+// +kubebuilder:validation:XValidation:rule="!has(self.deployment) || self.versions.exists(v, v.name == self.deployment.version)",message="deployment.version must be one of versions"
+type FlinkJarApplicationSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// Application name. Defaults to `metadata.name` if omitted.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	ApplicationName string `json:"applicationName,omitempty"`
+
+	// JAR versions of the application. Each version is uploaded once: use a new version name for a new JAR.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=map
+	// +listMapKey=name
+	Versions []FlinkJarApplicationVersion `json:"versions"`
+
+	// Deploys one of the versions. Changing the version rolls the job forward or back.
+	// The application is not deployed if omitted.
+	Deployment *FlinkJarApplicationDeployment `json:"deployment,omitempty"`
+}
+
+// FlinkJarApplicationVersion is a JAR uploaded to the application.
+type FlinkJarApplicationVersion struct {
+	// Version name referenced by deployment.version
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9._-]+$"
+	Name string `json:"name"`
+
+	// Where the operator reads the JAR from
+	Source FlinkJarSource `json:"source"`
+}
+
+// FlinkJarSource is the location of a JAR file. Exactly one of the fields must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.configMapKeyRef), has(self.file), has(self.pvc), has(self.url)].filter(x, x).size() == 1",message="exactly one of configMapKeyRef, file, pvc or url must be set"
+type FlinkJarSource struct {
+	// A key of a ConfigMap in the same namespace. The JAR is read from binaryData, or data if missing.
+	// ConfigMaps are limited to 1MiB.
+	ConfigMapKeyRef *FlinkJarConfigMapKeyRef `json:"configMapKeyRef,omitempty"`
+
+	// A file mounted into the operator pod at `/var/run/aiven-operator/flink-jars`,
+	// e.g. with the extraVolumes and extraVolumeMounts Helm values
+	File *FlinkJarFileSource `json:"file,omitempty"`
+
+	// A file on a PersistentVolumeClaim mounted into the operator pod at `/var/run/aiven-operator/pvc/<claimName>`
+	PVC *FlinkJarPVCSource `json:"pvc,omitempty"`
+
+	// An HTTP or HTTPS URL to download the JAR from. Private, loopback and link-local addresses are refused
+	URL *FlinkJarURLSource `json:"url,omitempty"`
+}
+
+// FlinkJarConfigMapKeyRef references a key of a ConfigMap.
+type FlinkJarConfigMapKeyRef struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// FlinkJarFileSource is a file in the operator pod.
+type FlinkJarFileSource struct {
+	// Path of the JAR relative to `/var/run/aiven-operator/flink-jars`
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// FlinkJarPVCSource is a file on a PersistentVolumeClaim.
+type FlinkJarPVCSource struct {
+	// The name of the PersistentVolumeClaim
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$"
+	ClaimName string `json:"claimName"`
+	// Path of the JAR relative to the volume root
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// FlinkJarURLSource is a file downloaded over HTTP.
+type FlinkJarURLSource struct {
+	// +kubebuilder:validation:Pattern="^https?://"
+	URL string `json:"url"`
+	// Expected SHA-256 checksum of the JAR in hex. The upload fails on mismatch.
+	// +kubebuilder:validation:Pattern="^[a-f0-9]{64}$"
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// FlinkJarApplicationDeployment defines how a version is deployed.
+// Aiven deployments are immutable: when any field changes, the running job is stopped and a new deployment is created.
+type FlinkJarApplicationDeployment struct {
+	// The name of the version to deploy
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// The main class of the job. Defaults to the Main-Class of the JAR manifest.
+	// +kubebuilder:validation:MaxLength=128
+	EntryClass string `json:"entryClass,omitempty"`
+
+	// Arguments of the main class
+	// +kubebuilder:validation:MaxItems=32
+	ProgramArgs []string `json:"programArgs,omitempty"`
+
+	// Flink job parallelism
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=1
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// Restart the job automatically when it fails
+	// +kubebuilder:default=true
+	RestartEnabled *bool `json:"restartEnabled,omitempty"`
+
+	// The savepoint to start the first deployment from
+	// +kubebuilder:validation:MaxLength=2048
+	StartingSavepoint string `json:"startingSavepoint,omitempty"`
+
+	// When the deployment changes, stops the running job with a savepoint and starts the new deployment from it.
+	// When false, the running job is canceled and the new deployment starts from scratch.
+	// +kubebuilder:default=true
+	RestartFromSavepoint *bool `json:"restartFromSavepoint,omitempty"`
+
+	// Cancel the job without a savepoint when the resource is deleted. By default, the job is stopped with a savepoint.
+	CancelOnDelete bool `json:"cancelOnDelete,omitempty"`
+}
+
+// IsRestartFromSavepoint returns true when the redeployment starts from a savepoint of the running job.
+func (in *FlinkJarApplicationDeployment) IsRestartFromSavepoint() bool {
+	return in.RestartFromSavepoint == nil || *in.RestartFromSavepoint
+}
+
+// FlinkJarApplicationStatus defines the observed state of FlinkJarApplication
+type FlinkJarApplicationStatus struct {
+	// Conditions represent the latest available observations of an FlinkJarApplication state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Application ID
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// Uploaded versions
+	Versions []FlinkJarApplicationVersionStatus `json:"versions,omitempty"`
+
+	// The current deployment
+	Deployment *FlinkJarApplicationDeploymentStatus `json:"deployment,omitempty"`
+}
+
+// FlinkJarApplicationVersionStatus is a version uploaded to Aiven.
+type FlinkJarApplicationVersionStatus struct {
+	// Version name
+	Name string `json:"name"`
+
+	// Aiven version ID
+	VersionID string `json:"versionId"`
+
+	// Aiven version number
+	Version int `json:"version"`
+
+	// SHA-256 checksum of the uploaded JAR
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// FlinkJarApplicationDeploymentStatus is the state of the deployed job.
+type FlinkJarApplicationDeploymentStatus struct {
+	// The deployed version name
+	Version string `json:"version,omitempty"`
+
+	FlinkJobStatus `json:",inline"`
+}
+
+// GetVersion returns the status of the uploaded version.
+func (in *FlinkJarApplicationStatus) GetVersion(name string) *FlinkJarApplicationVersionStatus {
+	for i := range in.Versions {
+		if in.Versions[i].Name == name {
+			return &in.Versions[i]
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkJarApplication is the Schema for the flinkjarapplications API.
+// Uploads the JAR versions and deploys one of them.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.deployment.version"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.deployment.state"
+type FlinkJarApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkJarApplicationSpec   `json:"spec,omitempty"`
+	Status FlinkJarApplicationStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &FlinkJarApplication{}
+
+// GetApplicationName returns Spec.ApplicationName or ObjectMeta.Name if empty.
+func (in *FlinkJarApplication) GetApplicationName() string {
+	if in.Spec.ApplicationName != "" {
+		return in.Spec.ApplicationName
+	}
+	return in.Name
+}
+
+func (*FlinkJarApplication) NoSecret() bool {
+	return true
+}
+
+func (in *FlinkJarApplication) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkJarApplication) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *FlinkJarApplication) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *FlinkJarApplication) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkJarApplicationList contains a list of FlinkJarApplication
+type FlinkJarApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkJarApplication `json:"items"`
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPPrivateLinkSpec defines the desired state of GCPPrivateLink
+type GCPPrivateLinkSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MaxItems=64
+	// +listMapKey=pscConnectionId
+	// +listType=map
+	// Private Service Connect connections to approve. A connection is approved once it is pending user approval.
+	Connections []GCPPrivateLinkConnection `json:"connections,omitempty"`
+}
+
+// GCPPrivateLinkConnection is a Private Service Connect connection to approve
+type GCPPrivateLinkConnection struct {
+	// +kubebuilder:validation:MaxLength=64
+	// ID of the Private Service Connect connection of the user endpoint
+	PSCConnectionID string `json:"pscConnectionId"`
+
+	// +kubebuilder:validation:Format=ipv4
+	// IP address of the user endpoint, used for the privatelink DNS records
+	UserIPAddress string `json:"userIpAddress"`
+}
+
+// GCPPrivateLinkStatus defines the observed state of GCPPrivateLink
+type GCPPrivateLinkStatus struct {
+	PrivateLinkStatus `json:",inline"`
+
+	// URI of the Google service attachment to create the Private Service Connect endpoints for
+	GoogleServiceAttachment string `json:"googleServiceAttachment,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GCPPrivateLink is the Schema for the gcpprivatelinks API.
+// Exposes a service through Google Private Service Connect and approves the endpoint connections.
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type GCPPrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPPrivateLinkSpec   `json:"spec,omitempty"`
+	Status GCPPrivateLinkStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &GCPPrivateLink{}
+
+func (*GCPPrivateLink) NoSecret() bool {
+	return true
+}
+
+func (in *GCPPrivateLink) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *GCPPrivateLink) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *GCPPrivateLink) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *GCPPrivateLink) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// GCPPrivateLinkList contains a list of GCPPrivateLink
+type GCPPrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPPrivateLink `json:"items"`
+}
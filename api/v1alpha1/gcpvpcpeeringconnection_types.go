@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPVPCPeeringConnectionSpec defines the desired state of GCPVPCPeeringConnection
+type GCPVPCPeeringConnectionSpec struct {
+	VPCPeeringConnectionSpec `json:",inline"`
+
+	// +kubebuilder:validation:MaxLength=30
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// GCP project ID of the peered VPC network
+	GCPProjectID string `json:"gcpProjectId"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the peered GCP VPC network
+	PeerVPC string `json:"peerVpc"`
+}
+
+// GCPVPCPeeringConnectionStatus defines the observed state of GCPVPCPeeringConnection
+type GCPVPCPeeringConnectionStatus struct {
+	VPCPeeringConnectionStatus `json:",inline"`
+
+	// The Aiven GCP project of the ProjectVPC
+	AivenProjectID string `json:"aivenProjectId,omitempty"`
+
+	// The Aiven VPC network name of the ProjectVPC
+	AivenVPCNetwork string `json:"aivenVpcNetwork,omitempty"`
+
+	// The self link of the Aiven VPC network to peer with on the GCP side
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GCPVPCPeeringConnection is the Schema for the gcpvpcpeeringconnections API.
+// Peers a ProjectVPC with a GCP VPC network. The connection stays `PENDING_PEER` until the peering is created on the GCP side.
+// Info "Exposes secret keys": `GCPVPCPEERINGCONNECTION_PROJECT_VPC_ID`, `GCPVPCPEERINGCONNECTION_STATE`, `GCPVPCPEERINGCONNECTION_AIVEN_PROJECT_ID`, `GCPVPCPEERINGCONNECTION_AIVEN_VPC_NETWORK`, `GCPVPCPEERINGCONNECTION_SELF_LINK`, `GCPVPCPEERINGCONNECTION_GCP_PROJECT_ID`, `GCPVPCPEERINGCONNECTION_PEER_VPC`
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="GCP Project",type="string",JSONPath=".spec.gcpProjectId"
+// +kubebuilder:printcolumn:name="Peer VPC",type="string",JSONPath=".spec.peerVpc"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type GCPVPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPVPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status GCPVPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+var _ VPCPeeringConnection = &GCPVPCPeeringConnection{}
+
+func (in *GCPVPCPeeringConnection) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *GCPVPCPeeringConnection) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *GCPVPCPeeringConnection) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *GCPVPCPeeringConnection) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *GCPVPCPeeringConnection) NoSecret() bool {
+	return in.Spec.ConnInfoSecretTargetDisabled != nil && *in.Spec.ConnInfoSecretTargetDisabled
+}
+
+func (in *GCPVPCPeeringConnection) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return in.Spec.ConnInfoSecretTarget
+}
+
+// GetRefs blocks the connection until the ProjectVPC is ACTIVE.
+func (in *GCPVPCPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.VPCPeeringConnectionSpec.GetRefs(in.GetNamespace())
+}
+
+func (in *GCPVPCPeeringConnection) GetPeeringSpec() *VPCPeeringConnectionSpec {
+	return &in.Spec.VPCPeeringConnectionSpec
+}
+
+func (in *GCPVPCPeeringConnection) GetPeeringStatus() *VPCPeeringConnectionStatus {
+	return &in.Status.VPCPeeringConnectionStatus
+}
+
+// +kubebuilder:object:root=true
+
+// GCPVPCPeeringConnectionList contains a list of GCPVPCPeeringConnection
+type GCPVPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPVPCPeeringConnection `json:"items"`
+}
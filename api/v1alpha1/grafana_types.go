@@ -40,6 +40,10 @@ func (in *Grafana) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Grafana) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Grafana) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -26,6 +26,13 @@ var (
 // When adding a new resource type, add its object and list here.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(GroupVersion,
+		&AWSPrivateLink{}, &AWSPrivateLinkList{},
+		&AWSVPCPeeringConnection{}, &AWSVPCPeeringConnectionList{},
+		&AivenCredentials{}, &AivenCredentialsList{},
+		&AivenNamespaceCredentials{}, &AivenNamespaceCredentialsList{},
+		&AzurePrivateLink{}, &AzurePrivateLinkList{},
+		&AzureVPCPeeringConnection{}, &AzureVPCPeeringConnectionList{},
+		&BillingGroup{}, &BillingGroupList{},
 		&Clickhouse{}, &ClickhouseList{},
 		&ClickhouseDatabase{}, &ClickhouseDatabaseList{},
 		&ClickhouseGrant{}, &ClickhouseGrantList{},
@@ -34,26 +41,50 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConnectionPool{}, &ConnectionPoolList{},
 		&Database{}, &DatabaseList{},
 		&Flink{}, &FlinkList{},
+		&FlinkApplication{}, &FlinkApplicationList{},
+		&FlinkApplicationDeployment{}, &FlinkApplicationDeploymentList{},
+		&FlinkJarApplication{}, &FlinkJarApplicationList{},
+		&GCPPrivateLink{}, &GCPPrivateLinkList{},
+		&GCPVPCPeeringConnection{}, &GCPVPCPeeringConnectionList{},
 		&Grafana{}, &GrafanaList{},
 		&Kafka{}, &KafkaList{},
 		&KafkaACL{}, &KafkaACLList{},
 		&KafkaConnect{}, &KafkaConnectList{},
 		&KafkaConnector{}, &KafkaConnectorList{},
+		&KafkaMirrorMaker{}, &KafkaMirrorMakerList{},
+		&KafkaMirrorMakerReplicationFlow{}, &KafkaMirrorMakerReplicationFlowList{},
 		&KafkaNativeACL{}, &KafkaNativeACLList{},
 		&KafkaQuota{}, &KafkaQuotaList{},
 		&KafkaSchema{}, &KafkaSchemaList{},
 		&KafkaSchemaRegistryACL{}, &KafkaSchemaRegistryACLList{},
 		&KafkaTopic{}, &KafkaTopicList{},
 		&MySQL{}, &MySQLList{},
+		&MySQLGrant{}, &MySQLGrantList{},
 		&OpenSearch{}, &OpenSearchList{},
 		&OpenSearchACLConfig{}, &OpenSearchACLConfigList{},
+		&OpenSearchISMPolicy{}, &OpenSearchISMPolicyList{},
+		&OpenSearchIndexTemplate{}, &OpenSearchIndexTemplateList{},
+		&OpenSearchRole{}, &OpenSearchRoleList{},
+		&OpenSearchRoleMapping{}, &OpenSearchRoleMappingList{},
+		&OpenSearchSecurityConfig{}, &OpenSearchSecurityConfigList{},
+		&OpenSearchSnapshotRepository{}, &OpenSearchSnapshotRepositoryList{},
+		&OrganizationApplicationUser{}, &OrganizationApplicationUserList{},
+		&OrganizationApplicationUserToken{}, &OrganizationApplicationUserTokenList{},
+		&OrganizationGroupMember{}, &OrganizationGroupMemberList{},
+		&OrganizationPermission{}, &OrganizationPermissionList{},
 		&OrganizationProject{}, &OrganizationProjectList{},
+		&OrganizationUserGroup{}, &OrganizationUserGroupList{},
 		&PostgreSQL{}, &PostgreSQLList{},
+		&PostgreSQLExtension{}, &PostgreSQLExtensionList{},
+		&PostgreSQLGrant{}, &PostgreSQLGrantList{},
+		&PostgreSQLSchema{}, &PostgreSQLSchemaList{},
 		&Project{}, &ProjectList{},
 		&ProjectVPC{}, &ProjectVPCList{},
 		&ServiceIntegration{}, &ServiceIntegrationList{},
 		&ServiceIntegrationEndpoint{}, &ServiceIntegrationEndpointList{},
 		&ServiceUser{}, &ServiceUserList{},
+		&StaticIP{}, &StaticIPList{},
+		&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{},
 		&UpgradePipelineStep{}, &UpgradePipelineStepList{},
 		&Valkey{}, &ValkeyList{},
 	)
@@ -8,7 +8,9 @@ import (
 	kafkauserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka"
 )
 
-// KafkaSpec defines the desired state of Kafka
+// KafkaSpec defines the desired state of Kafka.
+// Unlike other services, Kafka can't be created from a backup with `forkFrom`:
+// its user config has no `service_to_fork_from`.
 type KafkaSpec struct {
 	ServiceCommonSpec `json:",inline"`
 
@@ -46,6 +48,10 @@ func (in *Kafka) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Kafka) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Kafka) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -54,6 +54,10 @@ func (in *KafkaACL) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaACL) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaACL) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -42,6 +42,10 @@ func (in *KafkaConnect) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaConnect) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaConnect) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -83,6 +83,10 @@ func (in *KafkaConnector) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaConnector) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaConnector) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kafkamirrormakeruserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_mirrormaker"
+)
+
+// KafkaMirrorMakerSpec defines the desired state of KafkaMirrorMaker
+type KafkaMirrorMakerSpec struct {
+	BaseServiceFields `json:",inline"`
+
+	// KafkaMirrorMaker specific user configuration options
+	UserConfig *kafkamirrormakeruserconfig.KafkaMirrormakerUserConfig `json:"userConfig,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KafkaMirrorMaker is the Schema for the kafkamirrormakers API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type KafkaMirrorMaker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaMirrorMakerSpec `json:"spec,omitempty"`
+	Status ServiceStatus        `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &KafkaMirrorMaker{}
+
+func (*KafkaMirrorMaker) NoSecret() bool {
+	return true
+}
+
+func (in *KafkaMirrorMaker) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *KafkaMirrorMaker) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *KafkaMirrorMaker) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KafkaMirrorMaker) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *KafkaMirrorMaker) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.GetRefs(in.GetNamespace())
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaMirrorMakerList contains a list of KafkaMirrorMaker
+type KafkaMirrorMakerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaMirrorMaker `json:"items"`
+}
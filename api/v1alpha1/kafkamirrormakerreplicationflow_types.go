@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KafkaMirrorMakerReplicationFlowSpec defines the desired state of KafkaMirrorMakerReplicationFlow
+type KafkaMirrorMakerReplicationFlowSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Source cluster alias, as set in the `kafka_mirrormaker` integration's `cluster_alias`
+	SourceCluster string `json:"sourceCluster"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Target cluster alias, as set in the `kafka_mirrormaker` integration's `cluster_alias`
+	TargetCluster string `json:"targetCluster"`
+
+	// +kubebuilder:default=true
+	// Enable replication flow
+	Enable *bool `json:"enable,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// List of topics and/or regular expressions to replicate
+	Topics []string `json:"topics,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// List of topics and/or regular expressions to not replicate
+	TopicsBlacklist []string `json:"topicsBlacklist,omitempty"`
+
+	// +kubebuilder:validation:Enum="org.apache.kafka.connect.mirror.DefaultReplicationPolicy";"org.apache.kafka.connect.mirror.IdentityReplicationPolicy"
+	// Replication policy class
+	ReplicationPolicyClass string `json:"replicationPolicyClass,omitempty"`
+
+	// Sync consumer group offsets
+	SyncGroupOffsetsEnabled *bool `json:"syncGroupOffsetsEnabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Frequency of consumer group offset sync in seconds
+	SyncGroupOffsetsIntervalSeconds *int `json:"syncGroupOffsetsIntervalSeconds,omitempty"`
+
+	// Emit heartbeats to the target cluster
+	EmitHeartbeatsEnabled *bool `json:"emitHeartbeatsEnabled,omitempty"`
+
+	// Emit heartbeats to the direction opposite to the flow, i.e. to the source cluster
+	EmitBackwardHeartbeatsEnabled *bool `json:"emitBackwardHeartbeatsEnabled,omitempty"`
+
+	// +kubebuilder:validation:Enum="source";"target"
+	// Offset syncs topic location
+	OffsetSyncsTopicLocation string `json:"offsetSyncsTopicLocation,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Replication factor of the replicated topics
+	ReplicationFactor *int `json:"replicationFactor,omitempty"`
+}
+
+// KafkaMirrorMakerReplicationFlowStatus defines the observed state of KafkaMirrorMakerReplicationFlow
+type KafkaMirrorMakerReplicationFlowStatus struct {
+	// Conditions represent the latest available observations of a KafkaMirrorMakerReplicationFlow state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KafkaMirrorMakerReplicationFlow is the Schema for the kafkamirrormakerreplicationflows API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceCluster"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetCluster"
+// +kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".spec.enable"
+type KafkaMirrorMakerReplicationFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaMirrorMakerReplicationFlowSpec   `json:"spec,omitempty"`
+	Status KafkaMirrorMakerReplicationFlowStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &KafkaMirrorMakerReplicationFlow{}
+
+func (*KafkaMirrorMakerReplicationFlow) NoSecret() bool {
+	return true
+}
+
+func (in *KafkaMirrorMakerReplicationFlow) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *KafkaMirrorMakerReplicationFlow) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *KafkaMirrorMakerReplicationFlow) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KafkaMirrorMakerReplicationFlow) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaMirrorMakerReplicationFlowList contains a list of KafkaMirrorMakerReplicationFlow
+type KafkaMirrorMakerReplicationFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaMirrorMakerReplicationFlow `json:"items"`
+}
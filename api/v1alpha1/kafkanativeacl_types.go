@@ -77,6 +77,10 @@ func (in *KafkaNativeACL) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaNativeACL) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaNativeACL) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
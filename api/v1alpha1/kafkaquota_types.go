@@ -84,6 +84,10 @@ func (in *KafkaQuota) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaQuota) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaQuota) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
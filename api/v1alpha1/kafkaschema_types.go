@@ -128,6 +128,10 @@ func (in *KafkaSchema) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaSchema) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaSchema) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
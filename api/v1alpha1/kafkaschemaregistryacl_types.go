@@ -55,6 +55,10 @@ func (in *KafkaSchemaRegistryACL) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaSchemaRegistryACL) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaSchemaRegistryACL) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
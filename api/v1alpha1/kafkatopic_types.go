@@ -177,6 +177,10 @@ func (in *KafkaTopic) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *KafkaTopic) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *KafkaTopic) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
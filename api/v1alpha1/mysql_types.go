@@ -12,6 +12,7 @@ import (
 // +kubebuilder:validation:XValidation:rule="!(has(self.migrationSecretSource) && has(self.userConfig) && has(self.userConfig.migration))",message="migrationSecretSource and userConfig.migration are mutually exclusive; set only one"
 type MySQLSpec struct {
 	ServiceCommonSpec `json:",inline"`
+	ServiceForkFields `json:",inline"`
 
 	// Reference to a Secret containing migration credentials.
 	// Secret keys must match userConfig.migration JSON field names.
@@ -49,6 +50,10 @@ func (in *MySQL) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *MySQL) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *MySQL) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mysqlUtils "github.com/aiven/aiven-operator/utils/mysql"
+)
+
+// MySQLPrivilegeGrant represents the privileges to be granted to a user.
+// [See](https://dev.mysql.com/doc/refman/8.0/en/grant.html).
+// +kubebuilder:validation:XValidation:rule="!has(self.table) || self.table == '*' || self.database != '*'",message="`table` requires `database`"
+type MySQLPrivilegeGrant struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// The user to grant the privileges to
+	User string `json:"user"`
+
+	// +kubebuilder:default="%"
+	// +kubebuilder:validation:MaxLength=255
+	// The host part of the user account
+	Host string `json:"host,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=ALL;ALTER;ALTER ROUTINE;CREATE;CREATE ROUTINE;CREATE TEMPORARY TABLES;CREATE VIEW;DELETE;DROP;EVENT;EXECUTE;INDEX;INSERT;LOCK TABLES;REFERENCES;SELECT;SHOW VIEW;TRIGGER;UPDATE
+	// The privileges to grant, i.e. `SELECT`, `INSERT`
+	Privileges []string `json:"privileges"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// The database the privileges are granted on, `*` for all databases
+	Database string `json:"database"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// The table the privileges are granted on. If not provided, the privileges are granted on all tables of the database
+	Table string `json:"table,omitempty"`
+
+	// If true, the user can grant the privileges to other users
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+type MySQLGrants struct {
+	// +kubebuilder:validation:MinItems=1
+	// Configuration to grant privileges. Privileges removed from the manifest are revoked
+	PrivilegeGrants []MySQLPrivilegeGrant `json:"privilegeGrants"`
+}
+
+// BuildStatements returns the GRANT or REVOKE statements of the grants
+func (in *MySQLGrants) BuildStatements(t mysqlUtils.StatementType) []string {
+	stmts := make([]string, 0, len(in.PrivilegeGrants))
+	for _, g := range in.PrivilegeGrants {
+		stmts = append(stmts, g.buildStatement(t))
+	}
+	return stmts
+}
+
+// MySQLGrantSpec defines the desired state of MySQLGrant
+type MySQLGrantSpec struct {
+	ServiceDependant `json:",inline"`
+	MySQLGrants      `json:",inline"`
+}
+
+// MySQLGrantStatus defines the observed state of MySQLGrant
+type MySQLGrantStatus struct {
+	// Conditions represent the latest available observations of a MySQLGrant state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// The previous applied grants to revoke. Do not edit
+	State *MySQLGrants `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MySQLGrant is the Schema for the mysqlgrants API.
+// Grants database and table privileges to MySQL users. The privileges are compared with `SHOW GRANTS` on every reconciliation:
+// on the databases and tables of the manifest, missing privileges are granted again, extra privileges and grant options are revoked.
+// Only the privileges of the `privileges` enum are revoked, other privileges, like the dynamic privileges Aiven grants to the service users, are kept.
+// Requires the MySQL resource of the service in the same namespace, its connection secret is used to connect to the service.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+type MySQLGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MySQLGrantSpec   `json:"spec,omitempty"`
+	Status MySQLGrantStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &MySQLGrant{}
+
+func (in *MySQLGrant) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *MySQLGrant) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *MySQLGrant) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *MySQLGrant) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*MySQLGrant) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the MySQL resource of the service, its connection secret is used to connect to the service
+func (in *MySQLGrant) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).MySQL(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLGrantList contains a list of MySQLGrant
+type MySQLGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLGrant `json:"items"`
+}
+
+// GetHost returns the host part of the account, `%` if not set
+func (g *MySQLPrivilegeGrant) GetHost() string {
+	if g.Host == "" {
+		return "%"
+	}
+	return g.Host
+}
+
+// Generates a GRANT or REVOKE statement, e.g. GRANT SELECT, INSERT ON `db`.* TO `user`@`%`
+func (g *MySQLPrivilegeGrant) buildStatement(t mysqlUtils.StatementType) string {
+	privileges := make([]string, len(g.Privileges))
+	for i, p := range g.Privileges {
+		privileges[i] = mysqlUtils.NormalizePrivilege(p)
+	}
+
+	on := mysqlUtils.QuoteObject(g.Database, g.Table)
+	account := mysqlUtils.QuoteAccount(g.User, g.GetHost())
+	if t == mysqlUtils.REVOKE {
+		if g.WithGrantOption {
+			privileges = append(privileges, "GRANT OPTION")
+		}
+		return fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(privileges, ", "), on, account)
+	}
+
+	stmt := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(privileges, ", "), on, account)
+	if g.WithGrantOption {
+		stmt += " WITH GRANT OPTION"
+	}
+	return stmt
+}
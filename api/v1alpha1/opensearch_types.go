@@ -11,6 +11,7 @@ import (
 // OpenSearchSpec defines the desired state of OpenSearch
 type OpenSearchSpec struct {
 	ServiceCommonSpec `json:",inline"`
+	ServiceForkFields `json:",inline"`
 
 	// OpenSearch specific user configuration options
 	UserConfig *opensearchuserconfig.OpensearchUserConfig `json:"userConfig,omitempty"`
@@ -48,6 +49,10 @@ func (in *OpenSearch) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *OpenSearch) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *OpenSearch) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
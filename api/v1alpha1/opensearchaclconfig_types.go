@@ -70,6 +70,10 @@ func (in *OpenSearchACLConfig) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *OpenSearchACLConfig) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *OpenSearchACLConfig) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchIndexTemplateSpec defines the desired state of OpenSearchIndexTemplate
+type OpenSearchIndexTemplateSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the index template. If not provided, metadata.name is used
+	TemplateName string `json:"templateName,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Index template in JSON, the body of `PUT _index_template/<name>`, e.g. `{"index_patterns": ["logs-*"], "priority": 100}`
+	Body string `json:"body"`
+}
+
+// OpenSearchIndexTemplateStatus defines the observed state of OpenSearchIndexTemplate
+type OpenSearchIndexTemplateStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchIndexTemplate state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchIndexTemplate is the Schema for the opensearchindextemplates API.
+// Manages a composable index template of an OpenSearch service.
+// Requires the OpenSearch resource of the service in the same namespace, its connection secret is used to call the cluster.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateName"
+type OpenSearchIndexTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchIndexTemplateSpec   `json:"spec,omitempty"`
+	Status OpenSearchIndexTemplateStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchIndexTemplate{}
+
+func (in *OpenSearchIndexTemplate) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchIndexTemplate) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchIndexTemplate) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchIndexTemplate) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchIndexTemplate) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the OpenSearch resource of the service, its connection secret is used to call the cluster
+func (in *OpenSearchIndexTemplate) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).OpenSearch(in.Namespace)}
+}
+
+// GetTemplateName returns the index template name, metadata.name if not set in the spec
+func (in *OpenSearchIndexTemplate) GetTemplateName() string {
+	if in.Spec.TemplateName != "" {
+		return in.Spec.TemplateName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchIndexTemplateList contains a list of OpenSearchIndexTemplate
+type OpenSearchIndexTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchIndexTemplate `json:"items"`
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchISMPolicySpec defines the desired state of OpenSearchISMPolicy
+type OpenSearchISMPolicySpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ID of the policy. If not provided, metadata.name is used
+	PolicyID string `json:"policyId,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Policy in JSON, the `policy` object of `PUT _plugins/_ism/policies/<id>`, e.g. `{"default_state": "hot", "states": [...]}`
+	Policy string `json:"policy"`
+}
+
+// OpenSearchISMPolicyStatus defines the observed state of OpenSearchISMPolicy
+type OpenSearchISMPolicyStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchISMPolicy state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchISMPolicy is the Schema for the opensearchismpolicies API.
+// Manages an Index State Management policy of an OpenSearch service.
+// Requires the OpenSearch resource of the service in the same namespace, its connection secret is used to call the cluster.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Policy",type="string",JSONPath=".spec.policyId"
+type OpenSearchISMPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchISMPolicySpec   `json:"spec,omitempty"`
+	Status OpenSearchISMPolicyStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchISMPolicy{}
+
+func (in *OpenSearchISMPolicy) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchISMPolicy) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchISMPolicy) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchISMPolicy) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchISMPolicy) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the OpenSearch resource of the service, its connection secret is used to call the cluster
+func (in *OpenSearchISMPolicy) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).OpenSearch(in.Namespace)}
+}
+
+// GetPolicyID returns the ISM policy ID, metadata.name if not set in the spec
+func (in *OpenSearchISMPolicy) GetPolicyID() string {
+	if in.Spec.PolicyID != "" {
+		return in.Spec.PolicyID
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchISMPolicyList contains a list of OpenSearchISMPolicy
+type OpenSearchISMPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchISMPolicy `json:"items"`
+}
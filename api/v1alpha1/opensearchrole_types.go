@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchRoleSpec defines the desired state of OpenSearchRole
+type OpenSearchRoleSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:Required
+	// Reference to the OpenSearchSecurityConfig of the service
+	SecurityConfigRef ResourceReference `json:"securityConfigRef"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Role name. If not provided, metadata.name is used
+	RoleName string `json:"roleName,omitempty"`
+
+	// Role description
+	Description string `json:"description,omitempty"`
+
+	// Cluster-wide permissions, e.g. `cluster_composite_ops_ro`
+	ClusterPermissions []string `json:"clusterPermissions,omitempty"`
+
+	// Permissions on indices
+	IndexPermissions []OpenSearchRoleIndexPermission `json:"indexPermissions,omitempty"`
+
+	// Permissions on tenants
+	TenantPermissions []OpenSearchRoleTenantPermission `json:"tenantPermissions,omitempty"`
+}
+
+// OpenSearchRoleIndexPermission defines the permissions on a set of indices
+type OpenSearchRoleIndexPermission struct {
+	// +kubebuilder:validation:MinItems=1
+	// Index patterns, e.g. `logs-*`
+	IndexPatterns []string `json:"indexPatterns"`
+
+	// Allowed actions or action groups, e.g. `read`
+	AllowedActions []string `json:"allowedActions,omitempty"`
+
+	// Document-level security query, which limits the documents the role can read
+	DLS string `json:"dls,omitempty"`
+
+	// Field-level security, the fields the role can read. Fields prefixed with `~` are excluded instead
+	FLS []string `json:"fls,omitempty"`
+
+	// Fields to anonymize in the search results
+	MaskedFields []string `json:"maskedFields,omitempty"`
+}
+
+// OpenSearchRoleTenantPermission defines the permissions on a set of tenants
+type OpenSearchRoleTenantPermission struct {
+	// +kubebuilder:validation:MinItems=1
+	// Tenant patterns
+	TenantPatterns []string `json:"tenantPatterns"`
+
+	// Allowed actions, e.g. `kibana_all_read`
+	AllowedActions []string `json:"allowedActions,omitempty"`
+}
+
+// OpenSearchRoleStatus defines the observed state of OpenSearchRole
+type OpenSearchRoleStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchRole state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchRole is the Schema for the opensearchroles API.
+// Manages a role of the OpenSearch security plugin.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.roleName"
+type OpenSearchRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchRoleSpec   `json:"spec,omitempty"`
+	Status OpenSearchRoleStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchRole{}
+
+func (in *OpenSearchRole) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchRole) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchRole) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchRole) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchRole) NoSecret() bool {
+	return true
+}
+
+func (in *OpenSearchRole) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.SecurityConfigRef.OpenSearchSecurityConfig(in.Namespace)}
+}
+
+// GetRoleName returns the role name, metadata.name if not set in the spec
+func (in *OpenSearchRole) GetRoleName() string {
+	if in.Spec.RoleName != "" {
+		return in.Spec.RoleName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchRoleList contains a list of OpenSearchRole
+type OpenSearchRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchRole `json:"items"`
+}
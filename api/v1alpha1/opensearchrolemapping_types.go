@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchRoleMappingSpec defines the desired state of OpenSearchRoleMapping
+type OpenSearchRoleMappingSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:Required
+	// Reference to the OpenSearchSecurityConfig of the service
+	SecurityConfigRef ResourceReference `json:"securityConfigRef"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the mapped role. If not provided, metadata.name is used
+	RoleName string `json:"roleName,omitempty"`
+
+	// Users mapped to the role
+	Users []string `json:"users,omitempty"`
+
+	// Backend roles mapped to the role, e.g. groups of an identity provider
+	BackendRoles []string `json:"backendRoles,omitempty"`
+
+	// Hosts mapped to the role
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// OpenSearchRoleMappingStatus defines the observed state of OpenSearchRoleMapping
+type OpenSearchRoleMappingStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchRoleMapping state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchRoleMapping is the Schema for the opensearchrolemappings API.
+// Maps users, backend roles and hosts to a role of the OpenSearch security plugin.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.roleName"
+type OpenSearchRoleMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchRoleMappingSpec   `json:"spec,omitempty"`
+	Status OpenSearchRoleMappingStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchRoleMapping{}
+
+func (in *OpenSearchRoleMapping) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchRoleMapping) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchRoleMapping) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchRoleMapping) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchRoleMapping) NoSecret() bool {
+	return true
+}
+
+func (in *OpenSearchRoleMapping) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.SecurityConfigRef.OpenSearchSecurityConfig(in.Namespace)}
+}
+
+// GetRoleName returns the mapped role name, metadata.name if not set in the spec
+func (in *OpenSearchRoleMapping) GetRoleName() string {
+	if in.Spec.RoleName != "" {
+		return in.Spec.RoleName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchRoleMappingList contains a list of OpenSearchRoleMapping
+type OpenSearchRoleMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchRoleMapping `json:"items"`
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchSecurityConfigSpec defines the desired state of OpenSearchSecurityConfig
+type OpenSearchSecurityConfigSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// Secret with the password of the `os-sec-admin` user of the OpenSearch security plugin
+	AdminPasswordSecretSource OpenSearchSecurityAdminPasswordSource `json:"adminPasswordSecretSource"`
+}
+
+// OpenSearchSecurityAdminPasswordSource is the secret with the admin password of the security plugin
+type OpenSearchSecurityAdminPasswordSource struct {
+	ConnInfoSecretSource `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Key in the secret containing the current admin password, required when the secret changes.
+	// The admin password is changed from this one to the new password, unless they are equal
+	PreviousPasswordKey string `json:"previousPasswordKey,omitempty"`
+}
+
+// OpenSearchSecurityConfigStatus defines the observed state of OpenSearchSecurityConfig
+type OpenSearchSecurityConfigStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchSecurityConfig state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ResourceVersion of the admin password secret when the operator last set the password
+	AdminPasswordSecretVersion string `json:"adminPasswordSecretVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchSecurityConfig is the Schema for the opensearchsecurityconfigs API.
+// Enables the security management of the OpenSearch security plugin, which can't be disabled afterwards.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+type OpenSearchSecurityConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchSecurityConfigSpec   `json:"spec,omitempty"`
+	Status OpenSearchSecurityConfigStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchSecurityConfig{}
+
+func (in *OpenSearchSecurityConfig) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchSecurityConfig) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchSecurityConfig) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchSecurityConfig) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchSecurityConfig) NoSecret() bool {
+	return true
+}
+
+func (in *OpenSearchSecurityConfig) GetConnInfoSecretSource() *ConnInfoSecretSource {
+	return &in.Spec.AdminPasswordSecretSource.ConnInfoSecretSource
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchSecurityConfigList contains a list of OpenSearchSecurityConfig
+type OpenSearchSecurityConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchSecurityConfig `json:"items"`
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchSnapshotRepositorySpec defines the desired state of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositorySpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the repository. If not provided, metadata.name is used
+	RepositoryName string `json:"repositoryName,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Repository type, e.g. `s3`, `gcs` or `azure`
+	Type string `json:"type"`
+
+	// Repository settings, e.g. `bucket` and `base_path`
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// OpenSearchSnapshotRepositoryStatus defines the observed state of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositoryStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchSnapshotRepository state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchSnapshotRepository is the Schema for the opensearchsnapshotrepositories API.
+// Registers a snapshot repository in an OpenSearch service.
+// Requires the OpenSearch resource of the service in the same namespace, its connection secret is used to call the cluster.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".spec.repositoryName"
+type OpenSearchSnapshotRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchSnapshotRepositorySpec   `json:"spec,omitempty"`
+	Status OpenSearchSnapshotRepositoryStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OpenSearchSnapshotRepository{}
+
+func (in *OpenSearchSnapshotRepository) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchSnapshotRepository) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OpenSearchSnapshotRepository) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OpenSearchSnapshotRepository) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OpenSearchSnapshotRepository) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the OpenSearch resource of the service, its connection secret is used to call the cluster
+func (in *OpenSearchSnapshotRepository) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).OpenSearch(in.Namespace)}
+}
+
+// GetRepositoryName returns the snapshot repository name, metadata.name if not set in the spec
+func (in *OpenSearchSnapshotRepository) GetRepositoryName() string {
+	if in.Spec.RepositoryName != "" {
+		return in.Spec.RepositoryName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchSnapshotRepositoryList contains a list of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchSnapshotRepository `json:"items"`
+}
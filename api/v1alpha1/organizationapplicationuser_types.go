@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// OrganizationApplicationUserSpec defines the desired state of OrganizationApplicationUser.
+type OrganizationApplicationUserSpec struct {
+	AuthSecretRefField `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the application user.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// Name of the application user. An existing application user with the same name is adopted.
+	Name string `json:"name"`
+
+	// Makes the application user a super admin of the organization.
+	// Prefer OrganizationPermission to grant only the permissions the user needs.
+	IsSuperAdmin bool `json:"isSuperAdmin,omitempty"`
+}
+
+// OrganizationApplicationUserStatus defines the observed state of OrganizationApplicationUser.
+type OrganizationApplicationUserStatus struct {
+	// Conditions represent the latest available observations of an OrganizationApplicationUser state.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the application user, used by OrganizationApplicationUserToken and OrganizationPermission.
+	UserID string `json:"userId,omitempty"`
+
+	// Email of the application user generated by Aiven.
+	Email string `json:"email,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationApplicationUser is the Schema for the organizationapplicationusers API.
+// Manages an application user of an organization, a non-human user for the automation.
+// Use OrganizationPermission to grant the user roles and OrganizationApplicationUserToken to issue its tokens.
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="User ID",type="string",JSONPath=".status.userId"
+type OrganizationApplicationUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationApplicationUserSpec   `json:"spec,omitempty"`
+	Status OrganizationApplicationUserStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OrganizationApplicationUser{}
+
+func (in *OrganizationApplicationUser) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationApplicationUser) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OrganizationApplicationUser) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OrganizationApplicationUser) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OrganizationApplicationUser) NoSecret() bool {
+	return true
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationApplicationUserList contains a list of OrganizationApplicationUser.
+type OrganizationApplicationUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationApplicationUser `json:"items"`
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// TokenRotation makes the operator create a new token on a schedule.
+// +kubebuilder:validation:XValidation:rule="duration(self.interval) >= duration('1h')",message="interval must be at least 1h"
+type TokenRotation struct {
+	// Time between rotations, e.g. `720h` for 30 days. The minimum is `1h`.
+	// Must be shorter than `maxAgeSeconds` of the token, an expired token is replaced without the overlap
+	Interval metav1.Duration `json:"interval"`
+
+	// Limits the scheduled rotations to a weekly window. Rotations run at any time if omitted
+	MaintenanceWindow *PasswordRotationWindow `json:"maintenanceWindow,omitempty"`
+
+	// Minimum time the previous token stays valid after a rotation, e.g. `1h`.
+	// The previous token is revoked once this time has passed and all resources that use the secret have reconciled with the new token
+	MinOverlap *metav1.Duration `json:"minOverlap,omitempty"`
+}
+
+// OrganizationApplicationUserTokenSpec defines the desired state of OrganizationApplicationUserToken.
+// +kubebuilder:validation:XValidation:rule="has(self.userId) != has(self.applicationUserRef)",message="Exactly one of userId or applicationUserRef is required"
+type OrganizationApplicationUserTokenSpec struct {
+	AuthSecretRefField `json:",inline"`
+	SecretFields       `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the application user.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// UserID is the ID of the application user.
+	UserID string `json:"userId,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ApplicationUserRef is a reference to the OrganizationApplicationUser resource to use its ID as UserID.
+	ApplicationUserRef *ResourceReference `json:"applicationUserRef,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1000
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Description of the token.
+	Description string `json:"description,omitempty"`
+
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Time the token remains valid since creation, or since the last use if `extendWhenUsed` is true.
+	// The token doesn't expire if omitted.
+	MaxAgeSeconds *int `json:"maxAgeSeconds,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Extends the token lifetime by `maxAgeSeconds` every time the token is used.
+	ExtendWhenUsed bool `json:"extendWhenUsed,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Scopes the token is limited to, e.g. `projects:read`. The token has all permissions of the user if omitted.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// IP addresses and networks the token can be used from, e.g. `10.0.0.0/8`. Any address is allowed if omitted.
+	IPAllowlist []string `json:"ipAllowlist,omitempty"`
+
+	// Rotation makes the operator create a new token on a schedule.
+	// Set the `controllers.aiven.io/rotate-token` annotation to a new value, e.g. the current time, to rotate the token now.
+	Rotation *TokenRotation `json:"rotation,omitempty"`
+}
+
+// OrganizationApplicationUserTokenStatus defines the observed state of OrganizationApplicationUserToken.
+type OrganizationApplicationUserTokenStatus struct {
+	// Conditions represent the latest available observations of an OrganizationApplicationUserToken state.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the application user.
+	UserID string `json:"userId,omitempty"`
+
+	// Prefix of the current token, identifies the token in Aiven.
+	TokenPrefix string `json:"tokenPrefix,omitempty"`
+
+	// Fingerprint of the current token, matches the `controllers.aiven.io/auth-token` annotation
+	// of the resources that have reconciled with it.
+	TokenFingerprint string `json:"tokenFingerprint,omitempty"`
+
+	// Prefixes of the tokens replaced by rotations and not revoked yet.
+	PreviousTokenPrefixes []string `json:"previousTokenPrefixes,omitempty"`
+
+	// Time the current token was created
+	LastRotatedAt *metav1.Time `json:"lastRotatedAt,omitempty"`
+
+	// Value of the `controllers.aiven.io/rotate-token` annotation handled by the last rotation
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationApplicationUserToken is the Schema for the organizationapplicationusertokens API.
+// Creates a token of an application user and writes it to the connection secret,
+// so other resources can use it in `authSecretRef` with the `<prefix>TOKEN` key.
+// On rotation, the previous token stays valid until all resources that use the secret have reconciled with the new token.
+// Info "Exposes secret keys": `ORGANIZATIONAPPLICATIONUSERTOKEN_TOKEN`
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="User ID",type="string",JSONPath=".status.userId"
+// +kubebuilder:printcolumn:name="Token Prefix",type="string",JSONPath=".status.tokenPrefix"
+// +kubebuilder:printcolumn:name="Connection Information Secret",type="string",JSONPath=".spec.connInfoSecretTarget.name"
+type OrganizationApplicationUserToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationApplicationUserTokenSpec   `json:"spec,omitempty"`
+	Status OrganizationApplicationUserTokenStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OrganizationApplicationUserToken{}
+
+func (in *OrganizationApplicationUserToken) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationApplicationUserToken) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OrganizationApplicationUserToken) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OrganizationApplicationUserToken) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *OrganizationApplicationUserToken) NoSecret() bool {
+	return in.Spec.ConnInfoSecretTargetDisabled != nil && *in.Spec.ConnInfoSecretTargetDisabled
+}
+
+func (in *OrganizationApplicationUserToken) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return in.Spec.ConnInfoSecretTarget
+}
+
+func (in *OrganizationApplicationUserToken) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ApplicationUserRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ApplicationUserRef.OrganizationApplicationUser(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationApplicationUserTokenList contains a list of OrganizationApplicationUserToken.
+type OrganizationApplicationUserTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationApplicationUserToken `json:"items"`
+}
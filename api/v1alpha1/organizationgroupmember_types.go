@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// OrganizationGroupMemberSpec defines the desired state of OrganizationGroupMember.
+// +kubebuilder:validation:XValidation:rule="has(self.userGroupId) != has(self.userGroupRef)",message="Exactly one of userGroupId or userGroupRef is required"
+type OrganizationGroupMemberSpec struct {
+	AuthSecretRefField `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the user group.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// UserGroupID is the ID of the user group.
+	UserGroupID string `json:"userGroupId,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// UserGroupRef is a reference to the OrganizationUserGroup resource to use its ID as UserGroupID.
+	UserGroupRef *ResourceReference `json:"userGroupRef,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// UserID is the ID of the organization user to add to the group.
+	UserID string `json:"userId"`
+}
+
+// OrganizationGroupMemberStatus defines the observed state of OrganizationGroupMember.
+type OrganizationGroupMemberStatus struct {
+	// Conditions represent the latest available observations of an OrganizationGroupMember state.
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationGroupMember is the Schema for the organizationgroupmembers API.
+// Adds a user to a user group of an organization.
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="User Group",type="string",JSONPath=".spec.userGroupId"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.userId"
+type OrganizationGroupMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationGroupMemberSpec   `json:"spec,omitempty"`
+	Status OrganizationGroupMemberStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OrganizationGroupMember{}
+
+func (in *OrganizationGroupMember) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationGroupMember) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OrganizationGroupMember) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OrganizationGroupMember) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OrganizationGroupMember) NoSecret() bool {
+	return true
+}
+
+func (in *OrganizationGroupMember) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.UserGroupRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.UserGroupRef.OrganizationUserGroup(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationGroupMemberList contains a list of OrganizationGroupMember.
+type OrganizationGroupMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationGroupMember `json:"items"`
+}
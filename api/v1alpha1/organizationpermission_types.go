@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// OrganizationPermissionBinding grants roles and permissions to a principal.
+// +kubebuilder:validation:XValidation:rule="has(self.principalId) != has(self.userGroupRef)",message="Exactly one of principalId or userGroupRef is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.userGroupRef) || self.principalType == 'user_group'",message="userGroupRef requires principalType user_group"
+type OrganizationPermissionBinding struct {
+	// +kubebuilder:validation:Enum=user;user_group
+	// PrincipalType is the type of the principal.
+	PrincipalType string `json:"principalType"`
+
+	// +kubebuilder:validation:MinLength=1
+	// PrincipalID is the ID of the user or the user group.
+	PrincipalID string `json:"principalId,omitempty"`
+
+	// UserGroupRef is a reference to the OrganizationUserGroup resource to use its ID as PrincipalID.
+	UserGroupRef *ResourceReference `json:"userGroupRef,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// Permissions are the roles and permissions granted to the principal, e.g. `role:organization:admin`,
+	// `admin`, `developer`, `read_only` or `project:services:read`.
+	Permissions []string `json:"permissions"`
+}
+
+// OrganizationPermissionSpec defines the desired state of OrganizationPermission.
+type OrganizationPermissionSpec struct {
+	AuthSecretRefField `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the resource.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:Enum=project;organization;organization_unit
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ResourceType is the type of the resource the permissions are granted on.
+	ResourceType string `json:"resourceType"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ResourceID is the ID of the resource: the project name, the organization ID or the organizational unit ID.
+	ResourceID string `json:"resourceId"`
+
+	// Permissions is the full set of bindings of the resource.
+	// Bindings removed from the list, or added outside Kubernetes, are revoked.
+	Permissions []OrganizationPermissionBinding `json:"permissions,omitempty"`
+
+	// RevokeAllOnDelete revokes all permissions of the resource on delete, including the ones granted outside Kubernetes.
+	// By default, only the permissions applied by this resource are revoked.
+	RevokeAllOnDelete bool `json:"revokeAllOnDelete,omitempty"`
+}
+
+// OrganizationPermissionAppliedBinding is a binding applied to the resource, with the user group reference resolved.
+type OrganizationPermissionAppliedBinding struct {
+	// PrincipalType is the type of the principal.
+	PrincipalType string `json:"principalType"`
+
+	// PrincipalID is the ID of the user or the user group.
+	PrincipalID string `json:"principalId"`
+
+	// Permissions are the roles and permissions granted to the principal.
+	Permissions []string `json:"permissions"`
+}
+
+// OrganizationPermissionStatus defines the observed state of OrganizationPermission.
+type OrganizationPermissionStatus struct {
+	// Conditions represent the latest available observations of an OrganizationPermission state.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// AppliedPermissions are the bindings last applied to the resource, revoked on delete. Do not edit.
+	AppliedPermissions []OrganizationPermissionAppliedBinding `json:"appliedPermissions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationPermission is the Schema for the organizationpermissions API.
+// Warning "Authoritative":
+// The resource manages all permissions of the project, organization or organizational unit.
+// Permissions granted elsewhere, e.g. in the Aiven Console, are revoked.
+// Deleting the resource revokes the permissions it applied, set `revokeAllOnDelete` to revoke all permissions of the resource.
+// Use one OrganizationPermission per resource.
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Resource Type",type="string",JSONPath=".spec.resourceType"
+// +kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".spec.resourceId"
+type OrganizationPermission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationPermissionSpec   `json:"spec,omitempty"`
+	Status OrganizationPermissionStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OrganizationPermission{}
+
+func (in *OrganizationPermission) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationPermission) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OrganizationPermission) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OrganizationPermission) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OrganizationPermission) NoSecret() bool {
+	return true
+}
+
+func (in *OrganizationPermission) GetRefs() []*ResourceReferenceObject {
+	var refs []*ResourceReferenceObject
+	for _, p := range in.Spec.Permissions {
+		if p.UserGroupRef != nil {
+			refs = append(refs, p.UserGroupRef.OrganizationUserGroup(in.Namespace))
+		}
+	}
+	return refs
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationPermissionList contains a list of OrganizationPermission.
+type OrganizationPermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationPermission `json:"items"`
+}
@@ -5,6 +5,7 @@ package v1alpha1
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 // OrganizationProjectSpec defines the desired state of OrganizationProject.
+// +kubebuilder:validation:XValidation:rule="has(self.billingGroupId) != has(self.billingGroupRef)",message="Exactly one of billingGroupId or billingGroupRef is required"
 type OrganizationProjectSpec struct {
 	AuthSecretRefField `json:",inline"`
 	SecretFields       `json:",inline"`
@@ -25,7 +26,11 @@ type OrganizationProjectSpec struct {
 
 	// +kubebuilder:validation:MinLength=1
 	// BillingGroupID is the ID of the billing group the project is assigned to.
-	BillingGroupID string `json:"billingGroupId"`
+	BillingGroupID string `json:"billingGroupId,omitempty"`
+
+	// BillingGroupRef is a reference to the BillingGroup resource to use its ID as BillingGroupID.
+	// The billing group must belong to the same organization.
+	BillingGroupRef *ResourceReference `json:"billingGroupRef,omitempty"`
 
 	// +kubebuilder:validation:MinLength=1
 	// ParentID is the ID of the organization or organizational unit the project belongs to.
@@ -86,6 +91,10 @@ func (in *OrganizationProject) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *OrganizationProject) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *OrganizationProject) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -102,6 +111,13 @@ func (in *OrganizationProject) GetConnInfoSecretTarget() ConnInfoSecretTarget {
 	return in.Spec.ConnInfoSecretTarget
 }
 
+func (in *OrganizationProject) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.BillingGroupRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.BillingGroupRef.BillingGroup(in.Namespace)}
+}
+
 // +kubebuilder:object:root=true
 
 // OrganizationProjectList contains a list of OrganizationProject.
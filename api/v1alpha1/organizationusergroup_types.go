@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// OrganizationUserGroupSpec defines the desired state of OrganizationUserGroup.
+type OrganizationUserGroupSpec struct {
+	AuthSecretRefField `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationID is the Aiven organization ID that owns the user group.
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Name of the user group. An existing group with the same name is adopted.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MaxLength=4096
+	// Description of the user group.
+	Description string `json:"description,omitempty"`
+}
+
+// OrganizationUserGroupStatus defines the observed state of OrganizationUserGroup.
+type OrganizationUserGroupStatus struct {
+	// Conditions represent the latest available observations of an OrganizationUserGroup state.
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the user group, used by OrganizationGroupMember and OrganizationPermission references.
+	ID string `json:"id,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationUserGroup is the Schema for the organizationusergroups API.
+// Manages a user group of an organization. Use OrganizationGroupMember to add users
+// and OrganizationPermission to grant the group roles on projects.
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+type OrganizationUserGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationUserGroupSpec   `json:"spec,omitempty"`
+	Status OrganizationUserGroupStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &OrganizationUserGroup{}
+
+func (in *OrganizationUserGroup) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationUserGroup) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *OrganizationUserGroup) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *OrganizationUserGroup) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*OrganizationUserGroup) NoSecret() bool {
+	return true
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationUserGroupList contains a list of OrganizationUserGroup.
+type OrganizationUserGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationUserGroup `json:"items"`
+}
@@ -12,6 +12,7 @@ import (
 // +kubebuilder:validation:XValidation:rule="!(has(self.migrationSecretSource) && has(self.userConfig) && has(self.userConfig.migration))",message="migrationSecretSource and userConfig.migration are mutually exclusive; set only one"
 type PostgreSQLSpec struct {
 	ServiceCommonSpec `json:",inline"`
+	ServiceForkFields `json:",inline"`
 
 	// Reference to a Secret containing migration credentials.
 	// Secret keys must match userConfig.migration JSON field names.
@@ -52,6 +53,10 @@ func (in *PostgreSQL) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *PostgreSQL) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *PostgreSQL) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
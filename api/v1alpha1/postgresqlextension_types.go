@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PostgreSQLExtensionSpec defines the desired state of PostgreSQLExtension
+type PostgreSQLExtensionSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Database to connect to, the extension is installed in this database
+	Database string `json:"database"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the extension. If not provided, metadata.name is used
+	ExtensionName string `json:"extensionName,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// Schema for the objects of the extension. If not provided, the first schema of the search path is used, usually `public`
+	Schema string `json:"schema,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// Version of the extension. If not provided, the default version of the extension is installed and never updated
+	Version string `json:"version,omitempty"`
+}
+
+// PostgreSQLExtensionStatus defines the observed state of PostgreSQLExtension
+type PostgreSQLExtensionStatus struct {
+	// Conditions represent the latest available observations of a PostgreSQLExtension state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Installed version of the extension
+	Version string `json:"version,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PostgreSQLExtension is the Schema for the postgresqlextensions API.
+// Installs an extension in a database of a PostgreSQL service.
+// Requires the PostgreSQL resource of the service in the same namespace, its connection secret is used to connect to the database.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.database"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version"
+type PostgreSQLExtension struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLExtensionSpec   `json:"spec,omitempty"`
+	Status PostgreSQLExtensionStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &PostgreSQLExtension{}
+
+func (in *PostgreSQLExtension) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *PostgreSQLExtension) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *PostgreSQLExtension) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *PostgreSQLExtension) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*PostgreSQLExtension) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the PostgreSQL resource of the service, its connection secret is used to connect to the database
+func (in *PostgreSQLExtension) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).PostgreSQL(in.Namespace)}
+}
+
+// GetExtensionName returns the extension name, metadata.name if not set in the spec
+func (in *PostgreSQLExtension) GetExtensionName() string {
+	if in.Spec.ExtensionName != "" {
+		return in.Spec.ExtensionName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLExtensionList contains a list of PostgreSQLExtension
+type PostgreSQLExtensionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLExtension `json:"items"`
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/utils"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+// PostgreSQLPrivilegeGrant represents the privileges to be granted to roles.
+// [See](https://www.postgresql.org/docs/current/sql-grant.html).
+// +kubebuilder:validation:XValidation:rule="self.objectType == 'database' || has(self.schema)",message="`schema` is required unless objectType is database"
+// +kubebuilder:validation:XValidation:rule="!has(self.objects) || self.objectType in ['table', 'sequence']",message="`objects` are only supported for tables and sequences"
+type PostgreSQLPrivilegeGrant struct {
+	// +kubebuilder:validation:MinItems=1
+	// Roles to grant the privileges to
+	Grantees []string `json:"grantees"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=SELECT;INSERT;UPDATE;DELETE;TRUNCATE;REFERENCES;TRIGGER;CREATE;CONNECT;TEMPORARY;EXECUTE;USAGE;ALL
+	// The privileges to grant, i.e. `SELECT`, `USAGE`
+	Privileges []string `json:"privileges"`
+
+	// +kubebuilder:validation:Enum=database;schema;table;sequence;function
+	// Type of the objects the privileges are granted on. `database` refers to the database of the spec
+	ObjectType string `json:"objectType"`
+
+	// The schema of the objects, or the schema itself for the `schema` object type
+	Schema string `json:"schema,omitempty"`
+
+	// Tables or sequences in the schema. To grant the privileges on all objects of the type in the schema, omit this field
+	Objects []string `json:"objects,omitempty"`
+
+	// If true, the grantees can grant the privileges to other roles
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+// PostgreSQLRoleGrant represents the roles to be granted to other roles.
+// [See](https://www.postgresql.org/docs/current/sql-grant.html#SQL-GRANT-DESCRIPTION-ROLES).
+type PostgreSQLRoleGrant struct {
+	// +kubebuilder:validation:MinItems=1
+	// Roles to grant the roles to
+	Grantees []string `json:"grantees"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Roles to grant to the grantees
+	Roles []string `json:"roles"`
+
+	// If true, the grantees can grant the roles to other roles
+	WithAdminOption bool `json:"withAdminOption,omitempty"`
+}
+
+type PostgreSQLGrants struct {
+	// Configuration to grant privileges. Privileges removed from the manifest are revoked
+	PrivilegeGrants []PostgreSQLPrivilegeGrant `json:"privilegeGrants,omitempty"`
+	// Configuration to grant roles. Role grants removed from the manifest are revoked
+	RoleGrants []PostgreSQLRoleGrant `json:"roleGrants,omitempty"`
+}
+
+// BuildStatements returns the GRANT or REVOKE statements of the grants
+func (in *PostgreSQLGrants) BuildStatements(database string, t pgUtils.StatementType) []string {
+	stmts := make([]string, 0, len(in.PrivilegeGrants)+len(in.RoleGrants))
+	for _, g := range in.PrivilegeGrants {
+		stmts = append(stmts, g.buildStatement(database, t))
+	}
+	for _, g := range in.RoleGrants {
+		stmts = append(stmts, g.buildStatement(t))
+	}
+	return stmts
+}
+
+// PostgreSQLGrantSpec defines the desired state of PostgreSQLGrant
+type PostgreSQLGrantSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Database to connect to, the privileges are granted on the objects of this database
+	Database string `json:"database"`
+
+	PostgreSQLGrants `json:",inline"`
+}
+
+// PostgreSQLGrantStatus defines the observed state of PostgreSQLGrant
+type PostgreSQLGrantStatus struct {
+	// Conditions represent the latest available observations of a PostgreSQLGrant state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// The previous applied grants to revoke. Do not edit
+	State *PostgreSQLGrants `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PostgreSQLGrant is the Schema for the postgresqlgrants API.
+// Grants privileges and roles in a database of a PostgreSQL service. The previous grants are revoked and the new ones granted in a single transaction.
+// Requires the PostgreSQL resource of the service in the same namespace, its connection secret is used to connect to the database.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.database"
+type PostgreSQLGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLGrantSpec   `json:"spec,omitempty"`
+	Status PostgreSQLGrantStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &PostgreSQLGrant{}
+
+func (in *PostgreSQLGrant) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *PostgreSQLGrant) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *PostgreSQLGrant) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *PostgreSQLGrant) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*PostgreSQLGrant) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the PostgreSQL resource of the service, its connection secret is used to connect to the database
+func (in *PostgreSQLGrant) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).PostgreSQL(in.Namespace)}
+}
+
+// CollectRoles returns the grantees and the granted roles
+func (in *PostgreSQLGrantSpec) CollectRoles() []string {
+	var roles []string
+	for _, g := range in.PrivilegeGrants {
+		roles = append(roles, g.Grantees...)
+	}
+	for _, g := range in.RoleGrants {
+		roles = append(roles, g.Grantees...)
+		roles = append(roles, g.Roles...)
+	}
+	return utils.UniqueSliceElements(roles)
+}
+
+// CollectSchemas returns the schemas the privileges are granted on
+func (in *PostgreSQLGrantSpec) CollectSchemas() []string {
+	schemas := []string{}
+	for _, g := range in.PrivilegeGrants {
+		if g.Schema != "" {
+			schemas = append(schemas, g.Schema)
+		}
+	}
+	return utils.UniqueSliceElements(schemas)
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLGrantList contains a list of PostgreSQLGrant
+type PostgreSQLGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLGrant `json:"items"`
+}
+
+// Generates a GRANT or REVOKE statement for the privileges, e.g. `GRANT SELECT ON ALL TABLES IN SCHEMA "app" TO "reader"`
+func (g *PostgreSQLPrivilegeGrant) buildStatement(database string, t pgUtils.StatementType) string {
+	privileges := strings.Join(g.Privileges, ", ")
+	grantees := strings.Join(pgUtils.QuoteIdentifiers(g.Grantees), ", ")
+	on := g.constructOnPart(database)
+
+	if t == pgUtils.REVOKE {
+		return fmt.Sprintf("REVOKE %s ON %s FROM %s", privileges, on, grantees)
+	}
+
+	stmt := fmt.Sprintf("GRANT %s ON %s TO %s", privileges, on, grantees)
+	if g.WithGrantOption {
+		stmt += " WITH GRANT OPTION"
+	}
+	return stmt
+}
+
+// Helper function to construct the ON part of the statement
+func (g *PostgreSQLPrivilegeGrant) constructOnPart(database string) string {
+	schema := pgUtils.QuoteIdentifier(g.Schema)
+	switch g.ObjectType {
+	case "database":
+		return "DATABASE " + pgUtils.QuoteIdentifier(database)
+	case "schema":
+		return "SCHEMA " + schema
+	case "function":
+		return "ALL FUNCTIONS IN SCHEMA " + schema
+	}
+
+	kind := strings.ToUpper(g.ObjectType)
+	if len(g.Objects) == 0 {
+		return "ALL " + kind + "S IN SCHEMA " + schema
+	}
+
+	objects := make([]string, len(g.Objects))
+	for i, o := range g.Objects {
+		objects[i] = schema + "." + pgUtils.QuoteIdentifier(o)
+	}
+	return kind + " " + strings.Join(objects, ", ")
+}
+
+// Generates a GRANT or REVOKE statement for the roles, e.g. `GRANT "reader" TO "app"`
+func (g *PostgreSQLRoleGrant) buildStatement(t pgUtils.StatementType) string {
+	roles := strings.Join(pgUtils.QuoteIdentifiers(g.Roles), ", ")
+	grantees := strings.Join(pgUtils.QuoteIdentifiers(g.Grantees), ", ")
+
+	if t == pgUtils.REVOKE {
+		return fmt.Sprintf("REVOKE %s FROM %s", roles, grantees)
+	}
+
+	stmt := fmt.Sprintf("GRANT %s TO %s", roles, grantees)
+	if g.WithAdminOption {
+		stmt += " WITH ADMIN OPTION"
+	}
+	return stmt
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PostgreSQLSchemaSpec defines the desired state of PostgreSQLSchema
+type PostgreSQLSchemaSpec struct {
+	ServiceDependant `json:",inline"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Database to connect to, the schema is created in this database
+	Database string `json:"database"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the schema. If not provided, metadata.name is used
+	SchemaName string `json:"schemaName,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// Role that owns the schema. If not provided, the schema is owned by the admin user and the owner isn't managed
+	Owner string `json:"owner,omitempty"`
+}
+
+// PostgreSQLSchemaStatus defines the observed state of PostgreSQLSchema
+type PostgreSQLSchemaStatus struct {
+	// Conditions represent the latest available observations of a PostgreSQLSchema state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PostgreSQLSchema is the Schema for the postgresqlschemas API.
+// Creates a schema in a database of a PostgreSQL service. Deleting a schema that still has objects fails.
+// Requires the PostgreSQL resource of the service in the same namespace, its connection secret is used to connect to the database.
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.database"
+// +kubebuilder:printcolumn:name="Owner",type="string",JSONPath=".spec.owner"
+type PostgreSQLSchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLSchemaSpec   `json:"spec,omitempty"`
+	Status PostgreSQLSchemaStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &PostgreSQLSchema{}
+
+func (in *PostgreSQLSchema) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *PostgreSQLSchema) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *PostgreSQLSchema) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *PostgreSQLSchema) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (*PostgreSQLSchema) NoSecret() bool {
+	return true
+}
+
+// GetRefs returns the PostgreSQL resource of the service, its connection secret is used to connect to the database
+func (in *PostgreSQLSchema) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{(&ResourceReference{Name: in.Spec.ServiceName}).PostgreSQL(in.Namespace)}
+}
+
+// GetSchemaName returns the schema name, metadata.name if not set in the spec
+func (in *PostgreSQLSchema) GetSchemaName() string {
+	if in.Spec.SchemaName != "" {
+		return in.Spec.SchemaName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLSchemaList contains a list of PostgreSQLSchema
+type PostgreSQLSchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLSchema `json:"items"`
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PrivateLinkStatus defines the observed state shared by the privatelink kinds
+type PrivateLinkStatus struct {
+	// Conditions represent the latest available observations of the privatelink state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// State of the privatelink, for example `creating` or `active`
+	State string `json:"state,omitempty"`
+
+	// Connections from the user endpoints to the privatelink
+	Connections []PrivateLinkConnectionStatus `json:"connections,omitempty"`
+}
+
+// PrivateLinkConnectionStatus is a connection from a user endpoint to the privatelink
+type PrivateLinkConnectionStatus struct {
+	// Aiven privatelink connection ID
+	ID string `json:"id,omitempty"`
+
+	// ID of the user endpoint: the AWS VPC endpoint, the Azure private endpoint or the GCP PSC connection
+	EndpointID string `json:"endpointId,omitempty"`
+
+	// State of the connection, for example `pending-user-approval` or `active`
+	State string `json:"state,omitempty"`
+
+	// IP address of the user endpoint
+	UserIPAddress string `json:"userIpAddress,omitempty"`
+
+	// DNS name of the AWS VPC endpoint
+	DNSName string `json:"dnsName,omitempty"`
+}
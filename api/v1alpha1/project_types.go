@@ -8,6 +8,7 @@ import (
 )
 
 // ProjectSpec defines the desired state of Project
+// +kubebuilder:validation:XValidation:rule="!(has(self.billingGroupId) && has(self.billingGroupRef))",message="billingGroupId and billingGroupRef are mutually exclusive"
 type ProjectSpec struct {
 	AuthSecretRefField `json:",inline"`
 	SecretFields       `json:",inline"`
@@ -42,6 +43,10 @@ type ProjectSpec struct {
 	// BillingGroup ID
 	BillingGroupID string `json:"billingGroupId,omitempty"`
 
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// BillingGroupRef is a reference to the BillingGroup resource to use its ID as BillingGroupID
+	BillingGroupRef *ResourceReference `json:"billingGroupRef,omitempty"`
+
 	// +kubebuilder:validation:MinLength=2
 	// +kubebuilder:validation:MaxLength=2
 	// Billing country code of the project
@@ -105,6 +110,10 @@ func (in *Project) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Project) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Project) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -121,6 +130,13 @@ func (in *Project) GetConnInfoSecretTarget() ConnInfoSecretTarget {
 	return in.Spec.ConnInfoSecretTarget
 }
 
+func (in *Project) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.BillingGroupRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.BillingGroupRef.BillingGroup(in.Namespace)}
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectList contains a list of Project
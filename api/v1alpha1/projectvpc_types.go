@@ -60,6 +60,10 @@ func (in *ProjectVPC) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ProjectVPC) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ProjectVPC) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+var secretTemplateFuncs = template.FuncMap{
+	"b64enc": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+	"b64dec": func(s string) (string, error) {
+		b, err := base64.StdEncoding.DecodeString(s)
+		return string(b), err
+	},
+	"default": func(def, s string) string {
+		if s == "" {
+			return def
+		}
+		return s
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"replace": func(old, replacement, s string) string {
+		return strings.ReplaceAll(s, old, replacement)
+	},
+	"trim": strings.TrimSpace,
+}
+
+// parse parses the templates sorted by key.
+func (in *ConnInfoSecretTemplate) parse() (map[string]*template.Template, error) {
+	res := make(map[string]*template.Template, len(in.Data))
+	for _, k := range slices.Sorted(maps.Keys(in.Data)) {
+		// Missing keys fail, so typos are reported instead of rendering "<no value>".
+		t, err := template.New(k).Funcs(secretTemplateFuncs).Option("missingkey=error").Parse(in.Data[k])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for key %q: %w", k, err)
+		}
+		res[k] = t
+	}
+	return res, nil
+}
+
+// Validate checks the templates syntax
+func (in *ConnInfoSecretTemplate) Validate() error {
+	_, err := in.parse()
+	return err
+}
+
+// Render renders the templates with the given connection details.
+func (in *ConnInfoSecretTemplate) Render(details map[string]string) (map[string]string, error) {
+	templates, err := in.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(templates))
+	for k, t := range templates {
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, details); err != nil {
+			return nil, fmt.Errorf("unable to render template for key %q: %w", k, err)
+		}
+		res[k] = buf.String()
+	}
+	return res, nil
+}
+
+// Validate runs complex validation on ConnInfoSecretTarget
+func (in *ConnInfoSecretTarget) Validate() error {
+	if in.Template == nil {
+		return nil
+	}
+	if err := in.Template.Validate(); err != nil {
+		return fmt.Errorf("connInfoSecretTarget.template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnInfoSecretTemplate(t *testing.T) {
+	details := map[string]string{
+		"PG_HOST":     "pg.aivencloud.com",
+		"PG_PORT":     "12691",
+		"PG_USER":     "avnadmin",
+		"PG_PASSWORD": "secret",
+	}
+
+	t.Run("Renders templates with functions", func(t *testing.T) {
+		tmpl := &ConnInfoSecretTemplate{Data: map[string]string{
+			"JDBC_URL": "jdbc:postgresql://{{ .PG_HOST }}:{{ .PG_PORT }}/defaultdb",
+			".pgpass":  "{{ .PG_HOST | upper }}:*:{{ .PG_USER }}:{{ .PG_PASSWORD | b64enc | b64dec }}",
+			"SSLMODE":  `{{ .PG_SSLMODE | default "require" }}`,
+		}}
+		require.NoError(t, tmpl.Validate())
+
+		details["PG_SSLMODE"] = ""
+		res, err := tmpl.Render(details)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"JDBC_URL": "jdbc:postgresql://pg.aivencloud.com:12691/defaultdb",
+			".pgpass":  "PG.AIVENCLOUD.COM:*:avnadmin:secret",
+			"SSLMODE":  "require",
+		}, res)
+	})
+
+	t.Run("Rejects invalid templates", func(t *testing.T) {
+		target := &ConnInfoSecretTarget{Template: &ConnInfoSecretTemplate{Data: map[string]string{"URL": "{{ .PG_HOST "}}}
+		require.ErrorContains(t, target.Validate(), `connInfoSecretTarget.template: invalid template for key "URL"`)
+		assert.NoError(t, (&ConnInfoSecretTarget{}).Validate())
+	})
+
+	t.Run("Fails on missing keys", func(t *testing.T) {
+		tmpl := &ConnInfoSecretTemplate{Data: map[string]string{"URL": "{{ .PG_HOTS }}"}}
+		require.NoError(t, tmpl.Validate())
+
+		_, err := tmpl.Render(details)
+		require.ErrorContains(t, err, `unable to render template for key "URL"`)
+	})
+}
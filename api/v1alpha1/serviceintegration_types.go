@@ -17,7 +17,7 @@ import (
 	externalawscloudwatchmetricsuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/external_aws_cloudwatch_metrics"
 	kafkaconnectintegration "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_connect"
 	kafkalogsintegration "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_logs"
-	kafkamirrormakeruserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
+	kafkamirrormakerintegration "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
 	logsuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/logs"
 	metricsintegration "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/metrics"
 )
@@ -99,7 +99,7 @@ type ServiceIntegrationSpec struct {
 	ClickhouseKafkaUserConfig *clickhousekafkauserconfig.ClickhouseKafkaUserConfig `json:"clickhouseKafka,omitempty"`
 
 	// Kafka MirrorMaker configuration values
-	KafkaMirrormakerUserConfig *kafkamirrormakeruserconfig.KafkaMirrormakerUserConfig `json:"kafkaMirrormaker,omitempty"`
+	KafkaMirrormakerUserConfig *kafkamirrormakerintegration.KafkaMirrormakerUserConfig `json:"kafkaMirrormaker,omitempty"`
 
 	// Logs configuration values
 	LogsUserConfig *logsuserconfig.LogsUserConfig `json:"logs,omitempty"`
@@ -149,6 +149,10 @@ func (in *ServiceIntegration) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ServiceIntegration) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ServiceIntegration) GetRefs() []*ResourceReferenceObject {
 	if in.DeletionTimestamp != nil {
 		return nil
@@ -116,6 +116,10 @@ func (in *ServiceIntegrationEndpoint) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ServiceIntegrationEndpoint) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ServiceIntegrationEndpoint) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	"errors"
+
 	"github.com/aiven/go-client-codegen/handler/service"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -36,12 +38,17 @@ type ServiceUserSpec struct {
 	// Password must be 8-256 characters long.
 	ConnInfoSecretSource *ConnInfoSecretSource `json:"connInfoSecretSource,omitempty"`
 
+	// Rotation makes the operator generate a new password on a schedule.
+	// Set the `controllers.aiven.io/rotate-password` annotation to a new value, e.g. the current time, to rotate the password now.
+	// Cannot be used with connInfoSecretSource.
+	Rotation *PasswordRotation `json:"rotation,omitempty"`
+
 	// AccessControl Service type specific access control rules for user.
 	// When this block is present, the operator manages the full access-control scope it contains.
 	AccessControl *ServiceUserAccessControl `json:"accessControl,omitempty"`
 
 	// +kubebuilder:validation:Enum=caching_sha2_password;mysql_native_password
-	// Authentication details
+	// The authentication plugin of a MySQL user. Changing it resets the credentials of the user, keeping the password
 	Authentication service.AuthenticationType `json:"authentication,omitempty"`
 }
 
@@ -78,6 +85,8 @@ type ServiceUserStatus struct {
 
 	// Type of the user account
 	Type string `json:"type,omitempty"`
+
+	PasswordRotationStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -102,6 +111,19 @@ func (in *ServiceUser) GetConnInfoSecretSource() *ConnInfoSecretSource {
 	return in.Spec.ConnInfoSecretSource
 }
 
+// Validate runs complex validation on ServiceUserSpec
+func (in *ServiceUserSpec) Validate() error {
+	return errors.Join(in.ConnInfoSecretTarget.Validate(), validatePasswordRotation(in.Rotation, in.ConnInfoSecretSource))
+}
+
+func (in *ServiceUser) GetPasswordRotation() *PasswordRotation {
+	return in.Spec.Rotation
+}
+
+func (in *ServiceUser) GetPasswordRotationStatus() *PasswordRotationStatus {
+	return &in.Status.PasswordRotationStatus
+}
+
 // GetUsername returns the Aiven username for the ServiceUser.
 // Defaults to Spec.Username and falls back to ObjectMeta.Name when empty.
 func (in *ServiceUser) GetUsername() string {
@@ -117,6 +139,10 @@ func (in *ServiceUser) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *ServiceUser) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *ServiceUser) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StaticIPSpec defines the desired state of StaticIP
+type StaticIPSpec struct {
+	ProjectDependant `json:",inline"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Cloud the static IP is in. It can only be used by services in the same cloud.
+	CloudName string `json:"cloudName"`
+}
+
+// StaticIPStatus defines the observed state of StaticIP
+type StaticIPStatus struct {
+	// Conditions represent the latest available observations of a StaticIP state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Static IP address ID
+	ID string `json:"id,omitempty"`
+
+	// The IP address
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// State of the static IP, for example `created`, `available` or `assigned`
+	State string `json:"state,omitempty"`
+
+	// Name of the service the static IP is associated with
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// StaticIP is the Schema for the staticips API.
+// Services reference static IPs with `staticIps` to use them as their public IP addresses.
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="IP Address",type="string",JSONPath=".status.ipAddress"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".status.serviceName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type StaticIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticIPSpec   `json:"spec,omitempty"`
+	Status StaticIPStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &StaticIP{}
+
+func (*StaticIP) NoSecret() bool {
+	return true
+}
+
+func (in *StaticIP) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *StaticIP) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *StaticIP) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *StaticIP) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// +kubebuilder:object:root=true
+
+// StaticIPList contains a list of StaticIP
+type StaticIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticIP `json:"items"`
+}
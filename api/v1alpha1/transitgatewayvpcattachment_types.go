@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransitGatewayVPCAttachmentSpec defines the desired state of TransitGatewayVPCAttachment
+type TransitGatewayVPCAttachmentSpec struct {
+	ProjectDependant `json:",inline"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The ProjectVPC to attach. The attachment is created once the VPC is `ACTIVE`.
+	ProjectVPCRef ResourceReference `json:"projectVPCRef"`
+
+	// +kubebuilder:validation:Pattern="^[0-9]{12}$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS account ID of the transit gateway
+	AWSAccountID string `json:"awsAccountId"`
+
+	// +kubebuilder:validation:Pattern="^tgw-[0-9a-f]+$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ID of the AWS transit gateway
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=128
+	// +listType=set
+	// CIDRs of the networks routed through the transit gateway to the VPC, for example `10.10.0.0/16`.
+	// They can't overlap the `networkCidr` of the ProjectVPC.
+	UserPeerNetworkCIDRs []string `json:"userPeerNetworkCidrs"`
+}
+
+// TransitGatewayVPCAttachmentStatus defines the observed state of TransitGatewayVPCAttachment
+type TransitGatewayVPCAttachmentStatus struct {
+	VPCPeeringConnectionStatus `json:",inline"`
+
+	// The user peer network CIDRs of the attachment in Aiven
+	UserPeerNetworkCIDRs []string `json:"userPeerNetworkCidrs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// TransitGatewayVPCAttachment is the Schema for the transitgatewayvpcattachments API.
+// Attaches a ProjectVPC to an AWS transit gateway and routes the user peer network CIDRs through it.
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Transit Gateway",type="string",JSONPath=".spec.transitGatewayId"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec,omitempty"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+var _ AivenManagedObject = &TransitGatewayVPCAttachment{}
+
+func (*TransitGatewayVPCAttachment) NoSecret() bool {
+	return true
+}
+
+func (in *TransitGatewayVPCAttachment) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *TransitGatewayVPCAttachment) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
+func (in *TransitGatewayVPCAttachment) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *TransitGatewayVPCAttachment) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetRefs blocks the attachment until the ProjectVPC is ACTIVE.
+func (in *TransitGatewayVPCAttachment) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.ProjectVPCRef.ProjectVPC(in.GetNamespace())}
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of TransitGatewayVPCAttachment
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
@@ -99,6 +99,10 @@ func (in *UpgradePipelineStep) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *UpgradePipelineStep) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *UpgradePipelineStep) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
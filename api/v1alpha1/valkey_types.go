@@ -14,6 +14,7 @@ import (
 // ValkeySpec defines the desired state of Valkey
 type ValkeySpec struct {
 	ServiceCommonSpec `json:",inline"`
+	ServiceForkFields `json:",inline"`
 
 	// Valkey specific user configuration options
 	UserConfig *valkeyuserconfig.ValkeyUserConfig `json:"userConfig,omitempty"`
@@ -42,6 +43,10 @@ func (in *Valkey) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Valkey) CredentialsRef() *CredentialsReference {
+	return in.Spec.CredentialsRef
+}
+
 func (in *Valkey) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
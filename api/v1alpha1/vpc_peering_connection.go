@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCPeeringConnectionSpec contains the fields shared by the AWS, GCP and Azure VPC peering connections
+type VPCPeeringConnectionSpec struct {
+	ProjectDependant `json:",inline"`
+	SecretFields     `json:",inline"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The ProjectVPC to peer. The connection is created once the VPC is `ACTIVE`.
+	ProjectVPCRef ResourceReference `json:"projectVPCRef"`
+}
+
+// VPCPeeringConnectionStatus contains the status fields shared by the VPC peering connections
+type VPCPeeringConnectionStatus struct {
+	// Conditions represent the latest available observations of a VPC peering connection state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// The ID of the peered ProjectVPC
+	ProjectVPCID string `json:"projectVpcId,omitempty"`
+
+	// Peering connection state, for example `APPROVED`, `PENDING_PEER`, `ACTIVE` or `INVALID_SPECIFICATION`
+	State string `json:"state,omitempty"`
+
+	// Explains the state, for example why the specification is invalid
+	StateMessage string `json:"stateMessage,omitempty"`
+}
+
+// VPCPeeringConnection is implemented by the AWS, GCP and Azure VPC peering connections
+// +k8s:deepcopy-gen=false
+type VPCPeeringConnection interface {
+	AivenManagedObject
+	GetConnInfoSecretTarget() ConnInfoSecretTarget
+	GetPeeringSpec() *VPCPeeringConnectionSpec
+	GetPeeringStatus() *VPCPeeringConnectionStatus
+}
+
+func (in *VPCPeeringConnectionSpec) GetRefs(namespace string) []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.ProjectVPCRef.ProjectVPC(namespace)}
+}
@@ -17,7 +17,7 @@ import (
 	external_aws_cloudwatch_metrics "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/external_aws_cloudwatch_metrics"
 	integrationkafka_connect "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_connect"
 	kafka_logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_logs"
-	kafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
+	integrationkafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
 	logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/logs"
 	metrics "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/metrics"
 	integrationendpointsautoscaler "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoints/autoscaler"
@@ -39,6 +39,7 @@ import (
 	grafana "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/grafana"
 	kafka "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka"
 	kafka_connect "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_connect"
+	kafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_mirrormaker"
 	mysql "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/mysql"
 	opensearch "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/opensearch"
 	pg "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/pg"
@@ -46,123 +47,103 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthSecretRefField) DeepCopyInto(out *AuthSecretRefField) {
+func (in *AWSPrivateLink) DeepCopyInto(out *AWSPrivateLink) {
 	*out = *in
-	if in.AuthSecretRef != nil {
-		in, out := &in.AuthSecretRef, &out.AuthSecretRef
-		*out = new(AuthSecretReference)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretRefField.
-func (in *AuthSecretRefField) DeepCopy() *AuthSecretRefField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLink.
+func (in *AWSPrivateLink) DeepCopy() *AWSPrivateLink {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthSecretRefField)
+	out := new(AWSPrivateLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSPrivateLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
+func (in *AWSPrivateLinkList) DeepCopyInto(out *AWSPrivateLinkList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSPrivateLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
-func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkList.
+func (in *AWSPrivateLinkList) DeepCopy() *AWSPrivateLinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthSecretReference)
+	out := new(AWSPrivateLinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSPrivateLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BaseServiceFields) DeepCopyInto(out *BaseServiceFields) {
+func (in *AWSPrivateLinkSpec) DeepCopyInto(out *AWSPrivateLinkSpec) {
 	*out = *in
-	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
-	if in.ProjectVPCRef != nil {
-		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
-		*out = new(ResourceReference)
-		**out = **in
-	}
-	if in.TerminationProtection != nil {
-		in, out := &in.TerminationProtection, &out.TerminationProtection
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ServiceIntegrations != nil {
-		in, out := &in.ServiceIntegrations, &out.ServiceIntegrations
-		*out = make([]*ServiceIntegrationItem, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ServiceIntegrationItem)
-				**out = **in
-			}
-		}
-	}
-	if in.TechnicalEmails != nil {
-		in, out := &in.TechnicalEmails, &out.TechnicalEmails
-		*out = make([]ServiceTechEmail, len(*in))
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Powered != nil {
-		in, out := &in.Powered, &out.Powered
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseServiceFields.
-func (in *BaseServiceFields) DeepCopy() *BaseServiceFields {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkSpec.
+func (in *AWSPrivateLinkSpec) DeepCopy() *AWSPrivateLinkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BaseServiceFields)
+	out := new(AWSPrivateLinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
+func (in *AWSPrivateLinkStatus) DeepCopyInto(out *AWSPrivateLinkStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.PrivateLinkStatus.DeepCopyInto(&out.PrivateLinkStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
-func (in *Clickhouse) DeepCopy() *Clickhouse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkStatus.
+func (in *AWSPrivateLinkStatus) DeepCopy() *AWSPrivateLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Clickhouse)
+	out := new(AWSPrivateLinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Clickhouse) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseDatabase) DeepCopyInto(out *ClickhouseDatabase) {
+func (in *AWSVPCPeeringConnection) DeepCopyInto(out *AWSVPCPeeringConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -170,18 +151,18 @@ func (in *ClickhouseDatabase) DeepCopyInto(out *ClickhouseDatabase) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabase.
-func (in *ClickhouseDatabase) DeepCopy() *ClickhouseDatabase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSVPCPeeringConnection.
+func (in *AWSVPCPeeringConnection) DeepCopy() *AWSVPCPeeringConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseDatabase)
+	out := new(AWSVPCPeeringConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseDatabase) DeepCopyObject() runtime.Object {
+func (in *AWSVPCPeeringConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -189,31 +170,31 @@ func (in *ClickhouseDatabase) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseDatabaseList) DeepCopyInto(out *ClickhouseDatabaseList) {
+func (in *AWSVPCPeeringConnectionList) DeepCopyInto(out *AWSVPCPeeringConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseDatabase, len(*in))
+		*out = make([]AWSVPCPeeringConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseList.
-func (in *ClickhouseDatabaseList) DeepCopy() *ClickhouseDatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSVPCPeeringConnectionList.
+func (in *AWSVPCPeeringConnectionList) DeepCopy() *AWSVPCPeeringConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseDatabaseList)
+	out := new(AWSVPCPeeringConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseDatabaseList) DeepCopyObject() runtime.Object {
+func (in *AWSVPCPeeringConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -221,64 +202,57 @@ func (in *ClickhouseDatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseDatabaseSpec) DeepCopyInto(out *ClickhouseDatabaseSpec) {
+func (in *AWSVPCPeeringConnectionSpec) DeepCopyInto(out *AWSVPCPeeringConnectionSpec) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	in.VPCPeeringConnectionSpec.DeepCopyInto(&out.VPCPeeringConnectionSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseSpec.
-func (in *ClickhouseDatabaseSpec) DeepCopy() *ClickhouseDatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSVPCPeeringConnectionSpec.
+func (in *AWSVPCPeeringConnectionSpec) DeepCopy() *AWSVPCPeeringConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseDatabaseSpec)
+	out := new(AWSVPCPeeringConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseDatabaseStatus) DeepCopyInto(out *ClickhouseDatabaseStatus) {
+func (in *AWSVPCPeeringConnectionStatus) DeepCopyInto(out *AWSVPCPeeringConnectionStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.VPCPeeringConnectionStatus.DeepCopyInto(&out.VPCPeeringConnectionStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseStatus.
-func (in *ClickhouseDatabaseStatus) DeepCopy() *ClickhouseDatabaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSVPCPeeringConnectionStatus.
+func (in *AWSVPCPeeringConnectionStatus) DeepCopy() *AWSVPCPeeringConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseDatabaseStatus)
+	out := new(AWSVPCPeeringConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseGrant) DeepCopyInto(out *ClickhouseGrant) {
+func (in *AivenCredentials) DeepCopyInto(out *AivenCredentials) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrant.
-func (in *ClickhouseGrant) DeepCopy() *ClickhouseGrant {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenCredentials.
+func (in *AivenCredentials) DeepCopy() *AivenCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseGrant)
+	out := new(AivenCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseGrant) DeepCopyObject() runtime.Object {
+func (in *AivenCredentials) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -286,31 +260,31 @@ func (in *ClickhouseGrant) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseGrantList) DeepCopyInto(out *ClickhouseGrantList) {
+func (in *AivenCredentialsList) DeepCopyInto(out *AivenCredentialsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseGrant, len(*in))
+		*out = make([]AivenCredentials, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantList.
-func (in *ClickhouseGrantList) DeepCopy() *ClickhouseGrantList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenCredentialsList.
+func (in *AivenCredentialsList) DeepCopy() *AivenCredentialsList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseGrantList)
+	out := new(AivenCredentialsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseGrantList) DeepCopyObject() runtime.Object {
+func (in *AivenCredentialsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -318,75 +292,78 @@ func (in *ClickhouseGrantList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseGrantSpec) DeepCopyInto(out *ClickhouseGrantSpec) {
+func (in *AivenCredentialsSpec) DeepCopyInto(out *AivenCredentialsSpec) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	in.Grants.DeepCopyInto(&out.Grants)
+	out.SecretRef = in.SecretRef
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantSpec.
-func (in *ClickhouseGrantSpec) DeepCopy() *ClickhouseGrantSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenCredentialsSpec.
+func (in *AivenCredentialsSpec) DeepCopy() *AivenCredentialsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseGrantSpec)
+	out := new(AivenCredentialsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseGrantStatus) DeepCopyInto(out *ClickhouseGrantStatus) {
+func (in *AivenNamespaceCredentials) DeepCopyInto(out *AivenNamespaceCredentials) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(Grants)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantStatus.
-func (in *ClickhouseGrantStatus) DeepCopy() *ClickhouseGrantStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenNamespaceCredentials.
+func (in *AivenNamespaceCredentials) DeepCopy() *AivenNamespaceCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseGrantStatus)
+	out := new(AivenNamespaceCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AivenNamespaceCredentials) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
+func (in *AivenNamespaceCredentialsList) DeepCopyInto(out *AivenNamespaceCredentialsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Clickhouse, len(*in))
+		*out = make([]AivenNamespaceCredentials, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
-func (in *ClickhouseList) DeepCopy() *ClickhouseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenNamespaceCredentialsList.
+func (in *AivenNamespaceCredentialsList) DeepCopy() *AivenNamespaceCredentialsList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseList)
+	out := new(AivenNamespaceCredentialsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseList) DeepCopyObject() runtime.Object {
+func (in *AivenNamespaceCredentialsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -394,7 +371,63 @@ func (in *ClickhouseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseRole) DeepCopyInto(out *ClickhouseRole) {
+func (in *AivenNamespaceCredentialsSpec) DeepCopyInto(out *AivenNamespaceCredentialsSpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AivenNamespaceCredentialsSpec.
+func (in *AivenNamespaceCredentialsSpec) DeepCopy() *AivenNamespaceCredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AivenNamespaceCredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthSecretRefField) DeepCopyInto(out *AuthSecretRefField) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretRefField.
+func (in *AuthSecretRefField) DeepCopy() *AuthSecretRefField {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthSecretRefField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
+func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateLink) DeepCopyInto(out *AzurePrivateLink) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -402,18 +435,18 @@ func (in *ClickhouseRole) DeepCopyInto(out *ClickhouseRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRole.
-func (in *ClickhouseRole) DeepCopy() *ClickhouseRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLink.
+func (in *AzurePrivateLink) DeepCopy() *AzurePrivateLink {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseRole)
+	out := new(AzurePrivateLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseRole) DeepCopyObject() runtime.Object {
+func (in *AzurePrivateLink) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -421,31 +454,46 @@ func (in *ClickhouseRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseRoleList) DeepCopyInto(out *ClickhouseRoleList) {
+func (in *AzurePrivateLinkConnection) DeepCopyInto(out *AzurePrivateLinkConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkConnection.
+func (in *AzurePrivateLinkConnection) DeepCopy() *AzurePrivateLinkConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePrivateLinkConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateLinkList) DeepCopyInto(out *AzurePrivateLinkList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseRole, len(*in))
+		*out = make([]AzurePrivateLink, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleList.
-func (in *ClickhouseRoleList) DeepCopy() *ClickhouseRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkList.
+func (in *AzurePrivateLinkList) DeepCopy() *AzurePrivateLinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseRoleList)
+	out := new(AzurePrivateLinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseRoleList) DeepCopyObject() runtime.Object {
+func (in *AzurePrivateLinkList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -453,66 +501,49 @@ func (in *ClickhouseRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseRoleSpec) DeepCopyInto(out *ClickhouseRoleSpec) {
+func (in *AzurePrivateLinkSpec) DeepCopyInto(out *AzurePrivateLinkSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.UserSubscriptionIDs != nil {
+		in, out := &in.UserSubscriptionIDs, &out.UserSubscriptionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]AzurePrivateLinkConnection, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleSpec.
-func (in *ClickhouseRoleSpec) DeepCopy() *ClickhouseRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkSpec.
+func (in *AzurePrivateLinkSpec) DeepCopy() *AzurePrivateLinkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseRoleSpec)
+	out := new(AzurePrivateLinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseRoleStatus) DeepCopyInto(out *ClickhouseRoleStatus) {
+func (in *AzurePrivateLinkStatus) DeepCopyInto(out *AzurePrivateLinkStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleStatus.
-func (in *ClickhouseRoleStatus) DeepCopy() *ClickhouseRoleStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ClickhouseRoleStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
-	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(clickhouse.ClickhouseUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	in.PrivateLinkStatus.DeepCopyInto(&out.PrivateLinkStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
-func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkStatus.
+func (in *AzurePrivateLinkStatus) DeepCopy() *AzurePrivateLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseSpec)
+	out := new(AzurePrivateLinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
+func (in *AzureVPCPeeringConnection) DeepCopyInto(out *AzureVPCPeeringConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -520,18 +551,18 @@ func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
-func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVPCPeeringConnection.
+func (in *AzureVPCPeeringConnection) DeepCopy() *AzureVPCPeeringConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUser)
+	out := new(AzureVPCPeeringConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
+func (in *AzureVPCPeeringConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -539,31 +570,31 @@ func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
+func (in *AzureVPCPeeringConnectionList) DeepCopyInto(out *AzureVPCPeeringConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseUser, len(*in))
+		*out = make([]AzureVPCPeeringConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
-func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVPCPeeringConnectionList.
+func (in *AzureVPCPeeringConnectionList) DeepCopy() *AzureVPCPeeringConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserList)
+	out := new(AzureVPCPeeringConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
+func (in *AzureVPCPeeringConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -571,95 +602,98 @@ func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
+func (in *AzureVPCPeeringConnectionSpec) DeepCopyInto(out *AzureVPCPeeringConnectionSpec) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	in.SecretFields.DeepCopyInto(&out.SecretFields)
-	if in.ConnInfoSecretSource != nil {
-		in, out := &in.ConnInfoSecretSource, &out.ConnInfoSecretSource
-		*out = new(ConnInfoSecretSource)
-		**out = **in
-	}
+	in.VPCPeeringConnectionSpec.DeepCopyInto(&out.VPCPeeringConnectionSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
-func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVPCPeeringConnectionSpec.
+func (in *AzureVPCPeeringConnectionSpec) DeepCopy() *AzureVPCPeeringConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserSpec)
+	out := new(AzureVPCPeeringConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
+func (in *AzureVPCPeeringConnectionStatus) DeepCopyInto(out *AzureVPCPeeringConnectionStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.VPCPeeringConnectionStatus.DeepCopyInto(&out.VPCPeeringConnectionStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
-func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVPCPeeringConnectionStatus.
+func (in *AzureVPCPeeringConnectionStatus) DeepCopy() *AzureVPCPeeringConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserStatus)
+	out := new(AzureVPCPeeringConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnInfoSecretSource) DeepCopyInto(out *ConnInfoSecretSource) {
+func (in *BaseServiceFields) DeepCopyInto(out *BaseServiceFields) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretSource.
-func (in *ConnInfoSecretSource) DeepCopy() *ConnInfoSecretSource {
-	if in == nil {
-		return nil
+	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
+	if in.ProjectVPCRef != nil {
+		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
+		*out = new(ResourceReference)
+		**out = **in
 	}
-	out := new(ConnInfoSecretSource)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
-	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
+	if in.StaticIPs != nil {
+		in, out := &in.StaticIPs, &out.StaticIPs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.ServiceIntegrations != nil {
+		in, out := &in.ServiceIntegrations, &out.ServiceIntegrations
+		*out = make([]*ServiceIntegrationItem, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ServiceIntegrationItem)
+				**out = **in
+			}
 		}
 	}
+	if in.TechnicalEmails != nil {
+		in, out := &in.TechnicalEmails, &out.TechnicalEmails
+		*out = make([]ServiceTechEmail, len(*in))
+		copy(*out, *in)
+	}
+	if in.Powered != nil {
+		in, out := &in.Powered, &out.Powered
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
-func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseServiceFields.
+func (in *BaseServiceFields) DeepCopy() *BaseServiceFields {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnInfoSecretTarget)
+	out := new(BaseServiceFields)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
+func (in *BillingGroup) DeepCopyInto(out *BillingGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -667,18 +701,18 @@ func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
-func (in *ConnectionPool) DeepCopy() *ConnectionPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroup.
+func (in *BillingGroup) DeepCopy() *BillingGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPool)
+	out := new(BillingGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPool) DeepCopyObject() runtime.Object {
+func (in *BillingGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -686,31 +720,31 @@ func (in *ConnectionPool) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
+func (in *BillingGroupList) DeepCopyInto(out *BillingGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ConnectionPool, len(*in))
+		*out = make([]BillingGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
-func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupList.
+func (in *BillingGroupList) DeepCopy() *BillingGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolList)
+	out := new(BillingGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
+func (in *BillingGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -718,24 +752,33 @@ func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+func (in *BillingGroupSpec) DeepCopyInto(out *BillingGroupSpec) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+	if in.AddressLines != nil {
+		in, out := &in.AddressLines, &out.AddressLines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BillingEmails != nil {
+		in, out := &in.BillingEmails, &out.BillingEmails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
-func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupSpec.
+func (in *BillingGroupSpec) DeepCopy() *BillingGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolSpec)
+	out := new(BillingGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
+func (in *BillingGroupStatus) DeepCopyInto(out *BillingGroupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -746,18 +789,18 @@ func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
-func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupStatus.
+func (in *BillingGroupStatus) DeepCopy() *BillingGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolStatus)
+	out := new(BillingGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Database) DeepCopyInto(out *Database) {
+func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -765,18 +808,18 @@ func (in *Database) DeepCopyInto(out *Database) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
-func (in *Database) DeepCopy() *Database {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
+func (in *Clickhouse) DeepCopy() *Clickhouse {
 	if in == nil {
 		return nil
 	}
-	out := new(Database)
+	out := new(Clickhouse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Database) DeepCopyObject() runtime.Object {
+func (in *Clickhouse) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -784,31 +827,58 @@ func (in *Database) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
+func (in *ClickhouseDatabase) DeepCopyInto(out *ClickhouseDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabase.
+func (in *ClickhouseDatabase) DeepCopy() *ClickhouseDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClickhouseDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseDatabaseList) DeepCopyInto(out *ClickhouseDatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Database, len(*in))
+		*out = make([]ClickhouseDatabase, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
-func (in *DatabaseList) DeepCopy() *DatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseList.
+func (in *ClickhouseDatabaseList) DeepCopy() *ClickhouseDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseList)
+	out := new(ClickhouseDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatabaseList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseDatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -816,28 +886,23 @@ func (in *DatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+func (in *ClickhouseDatabaseSpec) DeepCopyInto(out *ClickhouseDatabaseSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.TerminationProtection != nil {
-		in, out := &in.TerminationProtection, &out.TerminationProtection
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
-func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseSpec.
+func (in *ClickhouseDatabaseSpec) DeepCopy() *ClickhouseDatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSpec)
+	out := new(ClickhouseDatabaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+func (in *ClickhouseDatabaseStatus) DeepCopyInto(out *ClickhouseDatabaseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -848,33 +913,18 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
-func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(DatabaseStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DestinationEndpointReference) DeepCopyInto(out *DestinationEndpointReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationEndpointReference.
-func (in *DestinationEndpointReference) DeepCopy() *DestinationEndpointReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseStatus.
+func (in *ClickhouseDatabaseStatus) DeepCopy() *ClickhouseDatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DestinationEndpointReference)
+	out := new(ClickhouseDatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Flink) DeepCopyInto(out *Flink) {
+func (in *ClickhouseGrant) DeepCopyInto(out *ClickhouseGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -882,18 +932,18 @@ func (in *Flink) DeepCopyInto(out *Flink) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flink.
-func (in *Flink) DeepCopy() *Flink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrant.
+func (in *ClickhouseGrant) DeepCopy() *ClickhouseGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(Flink)
+	out := new(ClickhouseGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Flink) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -901,31 +951,31 @@ func (in *Flink) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlinkList) DeepCopyInto(out *FlinkList) {
+func (in *ClickhouseGrantList) DeepCopyInto(out *ClickhouseGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Flink, len(*in))
+		*out = make([]ClickhouseGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkList.
-func (in *FlinkList) DeepCopy() *FlinkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantList.
+func (in *ClickhouseGrantList) DeepCopy() *ClickhouseGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(FlinkList)
+	out := new(ClickhouseGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FlinkList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -933,47 +983,75 @@ func (in *FlinkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlinkSpec) DeepCopyInto(out *FlinkSpec) {
+func (in *ClickhouseGrantSpec) DeepCopyInto(out *ClickhouseGrantSpec) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(flink.FlinkUserConfig)
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	in.Grants.DeepCopyInto(&out.Grants)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantSpec.
+func (in *ClickhouseGrantSpec) DeepCopy() *ClickhouseGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseGrantStatus) DeepCopyInto(out *ClickhouseGrantStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(Grants)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkSpec.
-func (in *FlinkSpec) DeepCopy() *FlinkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantStatus.
+func (in *ClickhouseGrantStatus) DeepCopy() *ClickhouseGrantStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FlinkSpec)
+	out := new(ClickhouseGrantStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grafana) DeepCopyInto(out *Grafana) {
+func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Clickhouse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
-func (in *Grafana) DeepCopy() *Grafana {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
+func (in *ClickhouseList) DeepCopy() *ClickhouseList {
 	if in == nil {
 		return nil
 	}
-	out := new(Grafana)
+	out := new(ClickhouseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Grafana) DeepCopyObject() runtime.Object {
+func (in *ClickhouseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -981,31 +1059,26 @@ func (in *Grafana) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+func (in *ClickhouseRole) DeepCopyInto(out *ClickhouseRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Grafana, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
-func (in *GrafanaList) DeepCopy() *GrafanaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRole.
+func (in *ClickhouseRole) DeepCopy() *ClickhouseRole {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaList)
+	out := new(ClickhouseRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GrafanaList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1013,99 +1086,99 @@ func (in *GrafanaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+func (in *ClickhouseRoleList) DeepCopyInto(out *ClickhouseRoleList) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(grafana.GrafanaUserConfig)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClickhouseRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
-func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleList.
+func (in *ClickhouseRoleList) DeepCopy() *ClickhouseRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaSpec)
+	out := new(ClickhouseRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClickhouseRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grantee) DeepCopyInto(out *Grantee) {
+func (in *ClickhouseRoleSpec) DeepCopyInto(out *ClickhouseRoleSpec) {
 	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grantee.
-func (in *Grantee) DeepCopy() *Grantee {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleSpec.
+func (in *ClickhouseRoleSpec) DeepCopy() *ClickhouseRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Grantee)
+	out := new(ClickhouseRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grants) DeepCopyInto(out *Grants) {
+func (in *ClickhouseRoleStatus) DeepCopyInto(out *ClickhouseRoleStatus) {
 	*out = *in
-	if in.PrivilegeGrants != nil {
-		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
-		*out = make([]PrivilegeGrant, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RoleGrants != nil {
-		in, out := &in.RoleGrants, &out.RoleGrants
-		*out = make([]RoleGrant, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grants.
-func (in *Grants) DeepCopy() *Grants {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleStatus.
+func (in *ClickhouseRoleStatus) DeepCopy() *ClickhouseRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Grants)
+	out := new(ClickhouseRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kafka) DeepCopyInto(out *Kafka) {
+func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.ServiceForkFields.DeepCopyInto(&out.ServiceForkFields)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(clickhouse.ClickhouseUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
-func (in *Kafka) DeepCopy() *Kafka {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
+func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Kafka)
+	out := new(ClickhouseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Kafka) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1113,18 +1186,18 @@ func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
-func (in *KafkaACL) DeepCopy() *KafkaACL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
+func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACL)
+	out := new(ClickhouseUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACL) DeepCopyObject() runtime.Object {
+func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1132,31 +1205,31 @@ func (in *KafkaACL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaACL, len(*in))
+		*out = make([]ClickhouseUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
-func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
+func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLList)
+	out := new(ClickhouseUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1164,23 +1237,34 @@ func (in *KafkaACLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	if in.ConnInfoSecretSource != nil {
+		in, out := &in.ConnInfoSecretSource, &out.ConnInfoSecretSource
+		*out = new(ConnInfoSecretSource)
+		**out = **in
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(PasswordRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
-func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
+func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLSpec)
+	out := new(ClickhouseUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1189,100 +1273,122 @@ func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PasswordRotationStatus.DeepCopyInto(&out.PasswordRotationStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
-func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
+func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLStatus)
+	out := new(ClickhouseUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+func (in *ConnInfoSecretSink) DeepCopyInto(out *ConnInfoSecretSink) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSecretSink)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
-func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretSink.
+func (in *ConnInfoSecretSink) DeepCopy() *ConnInfoSecretSink {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnect)
+	out := new(ConnInfoSecretSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnect) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+func (in *ConnInfoSecretSource) DeepCopyInto(out *ConnInfoSecretSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnect, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
-func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretSource.
+func (in *ConnInfoSecretSource) DeepCopy() *ConnInfoSecretSource {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectList)
+	out := new(ConnInfoSecretSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	return nil
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ConnInfoSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(ConnInfoSecretSink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
+func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnInfoSecretTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+func (in *ConnInfoSecretTemplate) DeepCopyInto(out *ConnInfoSecretTemplate) {
 	*out = *in
-	in.BaseServiceFields.DeepCopyInto(&out.BaseServiceFields)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka_connect.KafkaConnectUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
-func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTemplate.
+func (in *ConnInfoSecretTemplate) DeepCopy() *ConnInfoSecretTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectSpec)
+	out := new(ConnInfoSecretTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1290,18 +1396,18 @@ func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
-func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
+func (in *ConnectionPool) DeepCopy() *ConnectionPool {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnector)
+	out := new(ConnectionPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+func (in *ConnectionPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1309,31 +1415,31 @@ func (in *KafkaConnector) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnector, len(*in))
+		*out = make([]ConnectionPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
-func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
+func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorList)
+	out := new(ConnectionPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1341,45 +1447,24 @@ func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
-func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaConnectorPluginStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
-func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
+func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorSpec)
+	out := new(ConnectionPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1388,69 +1473,50 @@ func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.PluginStatus = in.PluginStatus
-	out.TasksStatus = in.TasksStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
-func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
+func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorStatus)
+	out := new(ConnectionPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
+func (in *CredentialsReference) DeepCopyInto(out *CredentialsReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
-func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsReference.
+func (in *CredentialsReference) DeepCopy() *CredentialsReference {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorTasksStatus)
+	out := new(CredentialsReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+func (in *CredentialsSecretReference) DeepCopyInto(out *CredentialsSecretReference) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Kafka, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
-func (in *KafkaList) DeepCopy() *KafkaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSecretReference.
+func (in *CredentialsSecretReference) DeepCopy() *CredentialsSecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaList)
+	out := new(CredentialsSecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaNativeACL) DeepCopyInto(out *KafkaNativeACL) {
+func (in *Database) DeepCopyInto(out *Database) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1458,18 +1524,18 @@ func (in *KafkaNativeACL) DeepCopyInto(out *KafkaNativeACL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACL.
-func (in *KafkaNativeACL) DeepCopy() *KafkaNativeACL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
+func (in *Database) DeepCopy() *Database {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaNativeACL)
+	out := new(Database)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaNativeACL) DeepCopyObject() runtime.Object {
+func (in *Database) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1477,31 +1543,31 @@ func (in *KafkaNativeACL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaNativeACLList) DeepCopyInto(out *KafkaNativeACLList) {
+func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaNativeACL, len(*in))
+		*out = make([]Database, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLList.
-func (in *KafkaNativeACLList) DeepCopy() *KafkaNativeACLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
+func (in *DatabaseList) DeepCopy() *DatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaNativeACLList)
+	out := new(DatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaNativeACLList) DeepCopyObject() runtime.Object {
+func (in *DatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1509,23 +1575,28 @@ func (in *KafkaNativeACLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaNativeACLSpec) DeepCopyInto(out *KafkaNativeACLSpec) {
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLSpec.
-func (in *KafkaNativeACLSpec) DeepCopy() *KafkaNativeACLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaNativeACLSpec)
+	out := new(DatabaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaNativeACLStatus) DeepCopyInto(out *KafkaNativeACLStatus) {
+func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1536,18 +1607,33 @@ func (in *KafkaNativeACLStatus) DeepCopyInto(out *KafkaNativeACLStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLStatus.
-func (in *KafkaNativeACLStatus) DeepCopy() *KafkaNativeACLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
+func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaNativeACLStatus)
+	out := new(DatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaQuota) DeepCopyInto(out *KafkaQuota) {
+func (in *DestinationEndpointReference) DeepCopyInto(out *DestinationEndpointReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationEndpointReference.
+func (in *DestinationEndpointReference) DeepCopy() *DestinationEndpointReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationEndpointReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Flink) DeepCopyInto(out *Flink) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1555,18 +1641,18 @@ func (in *KafkaQuota) DeepCopyInto(out *KafkaQuota) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuota.
-func (in *KafkaQuota) DeepCopy() *KafkaQuota {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flink.
+func (in *Flink) DeepCopy() *Flink {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaQuota)
+	out := new(Flink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaQuota) DeepCopyObject() runtime.Object {
+func (in *Flink) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1574,31 +1660,26 @@ func (in *KafkaQuota) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaQuotaList) DeepCopyInto(out *KafkaQuotaList) {
+func (in *FlinkApplication) DeepCopyInto(out *FlinkApplication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KafkaQuota, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaList.
-func (in *KafkaQuotaList) DeepCopy() *KafkaQuotaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplication.
+func (in *FlinkApplication) DeepCopy() *FlinkApplication {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaQuotaList)
+	out := new(FlinkApplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaQuotaList) DeepCopyObject() runtime.Object {
+func (in *FlinkApplication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1606,111 +1687,140 @@ func (in *KafkaQuotaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaQuotaSpec) DeepCopyInto(out *KafkaQuotaSpec) {
+func (in *FlinkApplicationDeployment) DeepCopyInto(out *FlinkApplicationDeployment) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.ConsumerByteRate != nil {
-		in, out := &in.ConsumerByteRate, &out.ConsumerByteRate
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ProducerByteRate != nil {
-		in, out := &in.ProducerByteRate, &out.ProducerByteRate
-		*out = new(int64)
-		**out = **in
-	}
-	if in.RequestPercentage != nil {
-		in, out := &in.RequestPercentage, &out.RequestPercentage
-		*out = new(float64)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaSpec.
-func (in *KafkaQuotaSpec) DeepCopy() *KafkaQuotaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeployment.
+func (in *FlinkApplicationDeployment) DeepCopy() *FlinkApplicationDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaQuotaSpec)
+	out := new(FlinkApplicationDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaQuotaStatus) DeepCopyInto(out *KafkaQuotaStatus) {
+func (in *FlinkApplicationDeploymentList) DeepCopyInto(out *FlinkApplicationDeploymentList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FlinkApplicationDeployment, len(*in))
+		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaStatus.
-func (in *KafkaQuotaStatus) DeepCopy() *KafkaQuotaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentList.
+func (in *FlinkApplicationDeploymentList) DeepCopy() *FlinkApplicationDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaQuotaStatus)
+	out := new(FlinkApplicationDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+func (in *FlinkApplicationDeploymentSpec) DeepCopyInto(out *FlinkApplicationDeploymentSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	out.ApplicationRef = in.ApplicationRef
+	if in.RestartEnabled != nil {
+		in, out := &in.RestartEnabled, &out.RestartEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestartFromSavepoint != nil {
+		in, out := &in.RestartFromSavepoint, &out.RestartFromSavepoint
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
-func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentSpec.
+func (in *FlinkApplicationDeploymentSpec) DeepCopy() *FlinkApplicationDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchema)
+	out := new(FlinkApplicationDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchema) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeploymentStatus) DeepCopyInto(out *FlinkApplicationDeploymentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	return nil
+	out.FlinkJobStatus = in.FlinkJobStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentStatus.
+func (in *FlinkApplicationDeploymentStatus) DeepCopy() *FlinkApplicationDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+func (in *FlinkApplicationList) DeepCopyInto(out *FlinkApplicationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaSchema, len(*in))
+		*out = make([]FlinkApplication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
-func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationList.
+func (in *FlinkApplicationList) DeepCopy() *FlinkApplicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaList)
+	out := new(FlinkApplicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+func (in *FlinkApplicationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1718,7 +1828,70 @@ func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaRegistryACL) DeepCopyInto(out *KafkaSchemaRegistryACL) {
+func (in *FlinkApplicationSpec) DeepCopyInto(out *FlinkApplicationSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]FlinkApplicationTable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FlinkApplicationTable, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationSpec.
+func (in *FlinkApplicationSpec) DeepCopy() *FlinkApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationStatus) DeepCopyInto(out *FlinkApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationStatus.
+func (in *FlinkApplicationStatus) DeepCopy() *FlinkApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationTable) DeepCopyInto(out *FlinkApplicationTable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationTable.
+func (in *FlinkApplicationTable) DeepCopy() *FlinkApplicationTable {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJarApplication) DeepCopyInto(out *FlinkJarApplication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1726,18 +1899,18 @@ func (in *KafkaSchemaRegistryACL) DeepCopyInto(out *KafkaSchemaRegistryACL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACL.
-func (in *KafkaSchemaRegistryACL) DeepCopy() *KafkaSchemaRegistryACL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplication.
+func (in *FlinkJarApplication) DeepCopy() *FlinkJarApplication {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaRegistryACL)
+	out := new(FlinkJarApplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchemaRegistryACL) DeepCopyObject() runtime.Object {
+func (in *FlinkJarApplication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1745,31 +1918,77 @@ func (in *KafkaSchemaRegistryACL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaRegistryACLList) DeepCopyInto(out *KafkaSchemaRegistryACLList) {
+func (in *FlinkJarApplicationDeployment) DeepCopyInto(out *FlinkJarApplicationDeployment) {
+	*out = *in
+	if in.ProgramArgs != nil {
+		in, out := &in.ProgramArgs, &out.ProgramArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestartEnabled != nil {
+		in, out := &in.RestartEnabled, &out.RestartEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestartFromSavepoint != nil {
+		in, out := &in.RestartFromSavepoint, &out.RestartFromSavepoint
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationDeployment.
+func (in *FlinkJarApplicationDeployment) DeepCopy() *FlinkJarApplicationDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkJarApplicationDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJarApplicationDeploymentStatus) DeepCopyInto(out *FlinkJarApplicationDeploymentStatus) {
+	*out = *in
+	out.FlinkJobStatus = in.FlinkJobStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationDeploymentStatus.
+func (in *FlinkJarApplicationDeploymentStatus) DeepCopy() *FlinkJarApplicationDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkJarApplicationDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJarApplicationList) DeepCopyInto(out *FlinkJarApplicationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaSchemaRegistryACL, len(*in))
+		*out = make([]FlinkJarApplication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLList.
-func (in *KafkaSchemaRegistryACLList) DeepCopy() *KafkaSchemaRegistryACLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationList.
+func (in *FlinkJarApplicationList) DeepCopy() *FlinkJarApplicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaRegistryACLList)
+	out := new(FlinkJarApplicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchemaRegistryACLList) DeepCopyObject() runtime.Object {
+func (in *FlinkJarApplicationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1777,23 +1996,35 @@ func (in *KafkaSchemaRegistryACLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaRegistryACLSpec) DeepCopyInto(out *KafkaSchemaRegistryACLSpec) {
+func (in *FlinkJarApplicationSpec) DeepCopyInto(out *FlinkJarApplicationSpec) {
 	*out = *in
 	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]FlinkJarApplicationVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(FlinkJarApplicationDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLSpec.
-func (in *KafkaSchemaRegistryACLSpec) DeepCopy() *KafkaSchemaRegistryACLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationSpec.
+func (in *FlinkJarApplicationSpec) DeepCopy() *FlinkJarApplicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaRegistryACLSpec)
+	out := new(FlinkJarApplicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaRegistryACLStatus) DeepCopyInto(out *KafkaSchemaRegistryACLStatus) {
+func (in *FlinkJarApplicationStatus) DeepCopyInto(out *FlinkJarApplicationStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1802,277 +2033,3713 @@ func (in *KafkaSchemaRegistryACLStatus) DeepCopyInto(out *KafkaSchemaRegistryACL
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]FlinkJarApplicationVersionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(FlinkJarApplicationDeploymentStatus)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLStatus.
-func (in *KafkaSchemaRegistryACLStatus) DeepCopy() *KafkaSchemaRegistryACLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationStatus.
+func (in *FlinkJarApplicationStatus) DeepCopy() *FlinkJarApplicationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaRegistryACLStatus)
+	out := new(FlinkJarApplicationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+func (in *FlinkJarApplicationVersion) DeepCopyInto(out *FlinkJarApplicationVersion) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.References != nil {
-		in, out := &in.References, &out.References
-		*out = make([]SchemaReference, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Source.DeepCopyInto(&out.Source)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
-func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationVersion.
+func (in *FlinkJarApplicationVersion) DeepCopy() *FlinkJarApplicationVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaSpec)
+	out := new(FlinkJarApplicationVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+func (in *FlinkJarApplicationVersionStatus) DeepCopyInto(out *FlinkJarApplicationVersionStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
-func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarApplicationVersionStatus.
+func (in *FlinkJarApplicationVersionStatus) DeepCopy() *FlinkJarApplicationVersionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaStatus)
+	out := new(FlinkJarApplicationVersionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+func (in *FlinkJarConfigMapKeyRef) DeepCopyInto(out *FlinkJarConfigMapKeyRef) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.Karapace != nil {
-		in, out := &in.Karapace, &out.Karapace
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka.KafkaUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
-func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarConfigMapKeyRef.
+func (in *FlinkJarConfigMapKeyRef) DeepCopy() *FlinkJarConfigMapKeyRef {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSpec)
+	out := new(FlinkJarConfigMapKeyRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+func (in *FlinkJarFileSource) DeepCopyInto(out *FlinkJarFileSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
-func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarFileSource.
+func (in *FlinkJarFileSource) DeepCopy() *FlinkJarFileSource {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopic)
+	out := new(FlinkJarFileSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopic) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJarPVCSource) DeepCopyInto(out *FlinkJarPVCSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarPVCSource.
+func (in *FlinkJarPVCSource) DeepCopy() *FlinkJarPVCSource {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(FlinkJarPVCSource)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicConfig) DeepCopyInto(out *KafkaTopicConfig) {
+func (in *FlinkJarSource) DeepCopyInto(out *FlinkJarSource) {
 	*out = *in
-	if in.DeleteRetentionMs != nil {
-		in, out := &in.DeleteRetentionMs, &out.DeleteRetentionMs
-		*out = new(int)
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(FlinkJarConfigMapKeyRef)
 		**out = **in
 	}
-	if in.FileDeleteDelayMs != nil {
-		in, out := &in.FileDeleteDelayMs, &out.FileDeleteDelayMs
-		*out = new(int)
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FlinkJarFileSource)
 		**out = **in
 	}
-	if in.FlushMessages != nil {
-		in, out := &in.FlushMessages, &out.FlushMessages
-		*out = new(int)
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(FlinkJarPVCSource)
 		**out = **in
 	}
-	if in.FlushMs != nil {
-		in, out := &in.FlushMs, &out.FlushMs
-		*out = new(int)
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(FlinkJarURLSource)
 		**out = **in
 	}
-	if in.IndexIntervalBytes != nil {
-		in, out := &in.IndexIntervalBytes, &out.IndexIntervalBytes
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarSource.
+func (in *FlinkJarSource) DeepCopy() *FlinkJarSource {
+	if in == nil {
+		return nil
 	}
-	if in.DisklessEnable != nil {
-		in, out := &in.DisklessEnable, &out.DisklessEnable
-		*out = new(bool)
-		**out = **in
+	out := new(FlinkJarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJarURLSource) DeepCopyInto(out *FlinkJarURLSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJarURLSource.
+func (in *FlinkJarURLSource) DeepCopy() *FlinkJarURLSource {
+	if in == nil {
+		return nil
 	}
-	if in.LocalRetentionBytes != nil {
+	out := new(FlinkJarURLSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkJobStatus) DeepCopyInto(out *FlinkJobStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkJobStatus.
+func (in *FlinkJobStatus) DeepCopy() *FlinkJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkList) DeepCopyInto(out *FlinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Flink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkList.
+func (in *FlinkList) DeepCopy() *FlinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkSpec) DeepCopyInto(out *FlinkSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(flink.FlinkUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkSpec.
+func (in *FlinkSpec) DeepCopy() *FlinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPrivateLink) DeepCopyInto(out *GCPPrivateLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPrivateLink.
+func (in *GCPPrivateLink) DeepCopy() *GCPPrivateLink {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPrivateLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPPrivateLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPrivateLinkConnection) DeepCopyInto(out *GCPPrivateLinkConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPrivateLinkConnection.
+func (in *GCPPrivateLinkConnection) DeepCopy() *GCPPrivateLinkConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPrivateLinkConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPrivateLinkList) DeepCopyInto(out *GCPPrivateLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPPrivateLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPrivateLinkList.
+func (in *GCPPrivateLinkList) DeepCopy() *GCPPrivateLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPrivateLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPPrivateLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPrivateLinkSpec) DeepCopyInto(out *GCPPrivateLinkSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]GCPPrivateLinkConnection, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPrivateLinkSpec.
+func (in *GCPPrivateLinkSpec) DeepCopy() *GCPPrivateLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPrivateLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPrivateLinkStatus) DeepCopyInto(out *GCPPrivateLinkStatus) {
+	*out = *in
+	in.PrivateLinkStatus.DeepCopyInto(&out.PrivateLinkStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPrivateLinkStatus.
+func (in *GCPPrivateLinkStatus) DeepCopy() *GCPPrivateLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPrivateLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnection) DeepCopyInto(out *GCPVPCPeeringConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnection.
+func (in *GCPVPCPeeringConnection) DeepCopy() *GCPVPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPVPCPeeringConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionList) DeepCopyInto(out *GCPVPCPeeringConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPVPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionList.
+func (in *GCPVPCPeeringConnectionList) DeepCopy() *GCPVPCPeeringConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPVPCPeeringConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionSpec) DeepCopyInto(out *GCPVPCPeeringConnectionSpec) {
+	*out = *in
+	in.VPCPeeringConnectionSpec.DeepCopyInto(&out.VPCPeeringConnectionSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionSpec.
+func (in *GCPVPCPeeringConnectionSpec) DeepCopy() *GCPVPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionStatus) DeepCopyInto(out *GCPVPCPeeringConnectionStatus) {
+	*out = *in
+	in.VPCPeeringConnectionStatus.DeepCopyInto(&out.VPCPeeringConnectionStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionStatus.
+func (in *GCPVPCPeeringConnectionStatus) DeepCopy() *GCPVPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Grafana) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Grafana, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
+func (in *GrafanaList) DeepCopy() *GrafanaList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrafanaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(grafana.GrafanaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
+func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSecretSink) DeepCopyInto(out *HTTPSecretSink) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSecretSink.
+func (in *HTTPSecretSink) DeepCopy() *HTTPSecretSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSecretSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grantee) DeepCopyInto(out *Grantee) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grantee.
+func (in *Grantee) DeepCopy() *Grantee {
+	if in == nil {
+		return nil
+	}
+	out := new(Grantee)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grants) DeepCopyInto(out *Grants) {
+	*out = *in
+	if in.PrivilegeGrants != nil {
+		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
+		*out = make([]PrivilegeGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleGrants != nil {
+		in, out := &in.RoleGrants, &out.RoleGrants
+		*out = make([]RoleGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grants.
+func (in *Grants) DeepCopy() *Grants {
+	if in == nil {
+		return nil
+	}
+	out := new(Grants)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kafka) DeepCopyInto(out *Kafka) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kafka) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
+func (in *KafkaACL) DeepCopy() *KafkaACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
+func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
+func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
+func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
+func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnect, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
+func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+	*out = *in
+	in.BaseServiceFields.DeepCopyInto(&out.BaseServiceFields)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka_connect.KafkaConnectUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
+func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
+func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
+func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
+func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
+func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.PluginStatus = in.PluginStatus
+	out.TasksStatus = in.TasksStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
+func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
+func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorTasksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Kafka, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
+func (in *KafkaList) DeepCopy() *KafkaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker) DeepCopyInto(out *KafkaMirrorMaker) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker.
+func (in *KafkaMirrorMaker) DeepCopy() *KafkaMirrorMaker {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMaker) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerList) DeepCopyInto(out *KafkaMirrorMakerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaMirrorMaker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerList.
+func (in *KafkaMirrorMakerList) DeepCopy() *KafkaMirrorMakerList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMakerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerReplicationFlow) DeepCopyInto(out *KafkaMirrorMakerReplicationFlow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerReplicationFlow.
+func (in *KafkaMirrorMakerReplicationFlow) DeepCopy() *KafkaMirrorMakerReplicationFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerReplicationFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMakerReplicationFlow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerReplicationFlowList) DeepCopyInto(out *KafkaMirrorMakerReplicationFlowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaMirrorMakerReplicationFlow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerReplicationFlowList.
+func (in *KafkaMirrorMakerReplicationFlowList) DeepCopy() *KafkaMirrorMakerReplicationFlowList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerReplicationFlowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMakerReplicationFlowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerReplicationFlowSpec) DeepCopyInto(out *KafkaMirrorMakerReplicationFlowSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopicsBlacklist != nil {
+		in, out := &in.TopicsBlacklist, &out.TopicsBlacklist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncGroupOffsetsEnabled != nil {
+		in, out := &in.SyncGroupOffsetsEnabled, &out.SyncGroupOffsetsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SyncGroupOffsetsIntervalSeconds != nil {
+		in, out := &in.SyncGroupOffsetsIntervalSeconds, &out.SyncGroupOffsetsIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.EmitHeartbeatsEnabled != nil {
+		in, out := &in.EmitHeartbeatsEnabled, &out.EmitHeartbeatsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmitBackwardHeartbeatsEnabled != nil {
+		in, out := &in.EmitBackwardHeartbeatsEnabled, &out.EmitBackwardHeartbeatsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerReplicationFlowSpec.
+func (in *KafkaMirrorMakerReplicationFlowSpec) DeepCopy() *KafkaMirrorMakerReplicationFlowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerReplicationFlowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerReplicationFlowStatus) DeepCopyInto(out *KafkaMirrorMakerReplicationFlowStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerReplicationFlowStatus.
+func (in *KafkaMirrorMakerReplicationFlowStatus) DeepCopy() *KafkaMirrorMakerReplicationFlowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerReplicationFlowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerSpec) DeepCopyInto(out *KafkaMirrorMakerSpec) {
+	*out = *in
+	in.BaseServiceFields.DeepCopyInto(&out.BaseServiceFields)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka_mirrormaker.KafkaMirrormakerUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerSpec.
+func (in *KafkaMirrorMakerSpec) DeepCopy() *KafkaMirrorMakerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACL) DeepCopyInto(out *KafkaNativeACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACL.
+func (in *KafkaNativeACL) DeepCopy() *KafkaNativeACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNativeACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLList) DeepCopyInto(out *KafkaNativeACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaNativeACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLList.
+func (in *KafkaNativeACLList) DeepCopy() *KafkaNativeACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNativeACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLSpec) DeepCopyInto(out *KafkaNativeACLSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLSpec.
+func (in *KafkaNativeACLSpec) DeepCopy() *KafkaNativeACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLStatus) DeepCopyInto(out *KafkaNativeACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLStatus.
+func (in *KafkaNativeACLStatus) DeepCopy() *KafkaNativeACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaQuota) DeepCopyInto(out *KafkaQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuota.
+func (in *KafkaQuota) DeepCopy() *KafkaQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaQuotaList) DeepCopyInto(out *KafkaQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaList.
+func (in *KafkaQuotaList) DeepCopy() *KafkaQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaQuotaSpec) DeepCopyInto(out *KafkaQuotaSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.ConsumerByteRate != nil {
+		in, out := &in.ConsumerByteRate, &out.ConsumerByteRate
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProducerByteRate != nil {
+		in, out := &in.ProducerByteRate, &out.ProducerByteRate
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RequestPercentage != nil {
+		in, out := &in.RequestPercentage, &out.RequestPercentage
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaSpec.
+func (in *KafkaQuotaSpec) DeepCopy() *KafkaQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaQuotaStatus) DeepCopyInto(out *KafkaQuotaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaQuotaStatus.
+func (in *KafkaQuotaStatus) DeepCopy() *KafkaQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
+func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
+func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaRegistryACL) DeepCopyInto(out *KafkaSchemaRegistryACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACL.
+func (in *KafkaSchemaRegistryACL) DeepCopy() *KafkaSchemaRegistryACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaRegistryACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchemaRegistryACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaRegistryACLList) DeepCopyInto(out *KafkaSchemaRegistryACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSchemaRegistryACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLList.
+func (in *KafkaSchemaRegistryACLList) DeepCopy() *KafkaSchemaRegistryACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaRegistryACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchemaRegistryACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaRegistryACLSpec) DeepCopyInto(out *KafkaSchemaRegistryACLSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLSpec.
+func (in *KafkaSchemaRegistryACLSpec) DeepCopy() *KafkaSchemaRegistryACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaRegistryACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaRegistryACLStatus) DeepCopyInto(out *KafkaSchemaRegistryACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryACLStatus.
+func (in *KafkaSchemaRegistryACLStatus) DeepCopy() *KafkaSchemaRegistryACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaRegistryACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]SchemaReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
+func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
+func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.Karapace != nil {
+		in, out := &in.Karapace, &out.Karapace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka.KafkaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
+func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicConfig) DeepCopyInto(out *KafkaTopicConfig) {
+	*out = *in
+	if in.DeleteRetentionMs != nil {
+		in, out := &in.DeleteRetentionMs, &out.DeleteRetentionMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.FileDeleteDelayMs != nil {
+		in, out := &in.FileDeleteDelayMs, &out.FileDeleteDelayMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlushMessages != nil {
+		in, out := &in.FlushMessages, &out.FlushMessages
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlushMs != nil {
+		in, out := &in.FlushMs, &out.FlushMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.IndexIntervalBytes != nil {
+		in, out := &in.IndexIntervalBytes, &out.IndexIntervalBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.DisklessEnable != nil {
+		in, out := &in.DisklessEnable, &out.DisklessEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LocalRetentionBytes != nil {
 		in, out := &in.LocalRetentionBytes, &out.LocalRetentionBytes
 		*out = new(int)
 		**out = **in
 	}
-	if in.LocalRetentionMs != nil {
-		in, out := &in.LocalRetentionMs, &out.LocalRetentionMs
-		*out = new(int)
-		**out = **in
+	if in.LocalRetentionMs != nil {
+		in, out := &in.LocalRetentionMs, &out.LocalRetentionMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxCompactionLagMs != nil {
+		in, out := &in.MaxCompactionLagMs, &out.MaxCompactionLagMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxMessageBytes != nil {
+		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.MessageDownconversionEnable != nil {
+		in, out := &in.MessageDownconversionEnable, &out.MessageDownconversionEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MessageTimestampDifferenceMaxMs != nil {
+		in, out := &in.MessageTimestampDifferenceMaxMs, &out.MessageTimestampDifferenceMaxMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinCleanableDirtyRatio != nil {
+		in, out := &in.MinCleanableDirtyRatio, &out.MinCleanableDirtyRatio
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinCompactionLagMs != nil {
+		in, out := &in.MinCompactionLagMs, &out.MinCompactionLagMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinInsyncReplicas != nil {
+		in, out := &in.MinInsyncReplicas, &out.MinInsyncReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.Preallocate != nil {
+		in, out := &in.Preallocate, &out.Preallocate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteStorageEnable != nil {
+		in, out := &in.RemoteStorageEnable, &out.RemoteStorageEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetentionBytes != nil {
+		in, out := &in.RetentionBytes, &out.RetentionBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetentionMs != nil {
+		in, out := &in.RetentionMs, &out.RetentionMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SegmentBytes != nil {
+		in, out := &in.SegmentBytes, &out.SegmentBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.SegmentIndexBytes != nil {
+		in, out := &in.SegmentIndexBytes, &out.SegmentIndexBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.SegmentJitterMs != nil {
+		in, out := &in.SegmentJitterMs, &out.SegmentJitterMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SegmentMs != nil {
+		in, out := &in.SegmentMs, &out.SegmentMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.UncleanLeaderElectionEnable != nil {
+		in, out := &in.UncleanLeaderElectionEnable, &out.UncleanLeaderElectionEnable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConfig.
+func (in *KafkaTopicConfig) DeepCopy() *KafkaTopicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
+func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]KafkaTopicTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(KafkaTopicConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
+func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
+func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicTag) DeepCopyInto(out *KafkaTopicTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicTag.
+func (in *KafkaTopicTag) DeepCopy() *KafkaTopicTag {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalFlinkApplicationRef) DeepCopyInto(out *LocalFlinkApplicationRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalFlinkApplicationRef.
+func (in *LocalFlinkApplicationRef) DeepCopy() *LocalFlinkApplicationRef {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalFlinkApplicationRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalKafkaSchemaRef) DeepCopyInto(out *LocalKafkaSchemaRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalKafkaSchemaRef.
+func (in *LocalKafkaSchemaRef) DeepCopy() *LocalKafkaSchemaRef {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalKafkaSchemaRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSecretSource) DeepCopyInto(out *MigrationSecretSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSecretSource.
+func (in *MigrationSecretSource) DeepCopy() *MigrationSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQL) DeepCopyInto(out *MySQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
+func (in *MySQL) DeepCopy() *MySQL {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrant) DeepCopyInto(out *MySQLGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrant.
+func (in *MySQLGrant) DeepCopy() *MySQLGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrantList) DeepCopyInto(out *MySQLGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrantList.
+func (in *MySQLGrantList) DeepCopy() *MySQLGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrantSpec) DeepCopyInto(out *MySQLGrantSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	in.MySQLGrants.DeepCopyInto(&out.MySQLGrants)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrantSpec.
+func (in *MySQLGrantSpec) DeepCopy() *MySQLGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrantStatus) DeepCopyInto(out *MySQLGrantStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(MySQLGrants)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrantStatus.
+func (in *MySQLGrantStatus) DeepCopy() *MySQLGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrants) DeepCopyInto(out *MySQLGrants) {
+	*out = *in
+	if in.PrivilegeGrants != nil {
+		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
+		*out = make([]MySQLPrivilegeGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrants.
+func (in *MySQLGrants) DeepCopy() *MySQLGrants {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrants)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
+func (in *MySQLList) DeepCopy() *MySQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLPrivilegeGrant) DeepCopyInto(out *MySQLPrivilegeGrant) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLPrivilegeGrant.
+func (in *MySQLPrivilegeGrant) DeepCopy() *MySQLPrivilegeGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLPrivilegeGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.ServiceForkFields.DeepCopyInto(&out.ServiceForkFields)
+	if in.MigrationSecretSource != nil {
+		in, out := &in.MigrationSecretSource, &out.MigrationSecretSource
+		*out = new(MigrationSecretSource)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(mysql.MysqlUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
+func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
+func (in *OpenSearch) DeepCopy() *OpenSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfig) DeepCopyInto(out *OpenSearchACLConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfig.
+func (in *OpenSearchACLConfig) DeepCopy() *OpenSearchACLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchACLConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfigACL) DeepCopyInto(out *OpenSearchACLConfigACL) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]OpenSearchACLConfigRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigACL.
+func (in *OpenSearchACLConfigACL) DeepCopy() *OpenSearchACLConfigACL {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfigACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfigList) DeepCopyInto(out *OpenSearchACLConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchACLConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigList.
+func (in *OpenSearchACLConfigList) DeepCopy() *OpenSearchACLConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchACLConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfigRule) DeepCopyInto(out *OpenSearchACLConfigRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigRule.
+func (in *OpenSearchACLConfigRule) DeepCopy() *OpenSearchACLConfigRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfigRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfigSpec) DeepCopyInto(out *OpenSearchACLConfigSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Acls != nil {
+		in, out := &in.Acls, &out.Acls
+		*out = make([]OpenSearchACLConfigACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigSpec.
+func (in *OpenSearchACLConfigSpec) DeepCopy() *OpenSearchACLConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLConfigStatus) DeepCopyInto(out *OpenSearchACLConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigStatus.
+func (in *OpenSearchACLConfigStatus) DeepCopy() *OpenSearchACLConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchISMPolicy) DeepCopyInto(out *OpenSearchISMPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchISMPolicy.
+func (in *OpenSearchISMPolicy) DeepCopy() *OpenSearchISMPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchISMPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchISMPolicyList) DeepCopyInto(out *OpenSearchISMPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchISMPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchISMPolicyList.
+func (in *OpenSearchISMPolicyList) DeepCopy() *OpenSearchISMPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchISMPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchISMPolicySpec) DeepCopyInto(out *OpenSearchISMPolicySpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchISMPolicySpec.
+func (in *OpenSearchISMPolicySpec) DeepCopy() *OpenSearchISMPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchISMPolicyStatus) DeepCopyInto(out *OpenSearchISMPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchISMPolicyStatus.
+func (in *OpenSearchISMPolicyStatus) DeepCopy() *OpenSearchISMPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchIndexTemplate) DeepCopyInto(out *OpenSearchIndexTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchIndexTemplate.
+func (in *OpenSearchIndexTemplate) DeepCopy() *OpenSearchIndexTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchIndexTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchIndexTemplateList) DeepCopyInto(out *OpenSearchIndexTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchIndexTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchIndexTemplateList.
+func (in *OpenSearchIndexTemplateList) DeepCopy() *OpenSearchIndexTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchIndexTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchIndexTemplateSpec) DeepCopyInto(out *OpenSearchIndexTemplateSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchIndexTemplateSpec.
+func (in *OpenSearchIndexTemplateSpec) DeepCopy() *OpenSearchIndexTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchIndexTemplateStatus) DeepCopyInto(out *OpenSearchIndexTemplateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchIndexTemplateStatus.
+func (in *OpenSearchIndexTemplateStatus) DeepCopy() *OpenSearchIndexTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
+func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRole) DeepCopyInto(out *OpenSearchRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRole.
+func (in *OpenSearchRole) DeepCopy() *OpenSearchRole {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleIndexPermission) DeepCopyInto(out *OpenSearchRoleIndexPermission) {
+	*out = *in
+	if in.IndexPatterns != nil {
+		in, out := &in.IndexPatterns, &out.IndexPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedActions != nil {
+		in, out := &in.AllowedActions, &out.AllowedActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FLS != nil {
+		in, out := &in.FLS, &out.FLS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaskedFields != nil {
+		in, out := &in.MaskedFields, &out.MaskedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleIndexPermission.
+func (in *OpenSearchRoleIndexPermission) DeepCopy() *OpenSearchRoleIndexPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleIndexPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleList) DeepCopyInto(out *OpenSearchRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleList.
+func (in *OpenSearchRoleList) DeepCopy() *OpenSearchRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleMapping) DeepCopyInto(out *OpenSearchRoleMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleMapping.
+func (in *OpenSearchRoleMapping) DeepCopy() *OpenSearchRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchRoleMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleMappingList) DeepCopyInto(out *OpenSearchRoleMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleMappingList.
+func (in *OpenSearchRoleMappingList) DeepCopy() *OpenSearchRoleMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchRoleMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleMappingSpec) DeepCopyInto(out *OpenSearchRoleMappingSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	out.SecurityConfigRef = in.SecurityConfigRef
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendRoles != nil {
+		in, out := &in.BackendRoles, &out.BackendRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleMappingSpec.
+func (in *OpenSearchRoleMappingSpec) DeepCopy() *OpenSearchRoleMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleMappingStatus) DeepCopyInto(out *OpenSearchRoleMappingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleMappingStatus.
+func (in *OpenSearchRoleMappingStatus) DeepCopy() *OpenSearchRoleMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleSpec) DeepCopyInto(out *OpenSearchRoleSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	out.SecurityConfigRef = in.SecurityConfigRef
+	if in.ClusterPermissions != nil {
+		in, out := &in.ClusterPermissions, &out.ClusterPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IndexPermissions != nil {
+		in, out := &in.IndexPermissions, &out.IndexPermissions
+		*out = make([]OpenSearchRoleIndexPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TenantPermissions != nil {
+		in, out := &in.TenantPermissions, &out.TenantPermissions
+		*out = make([]OpenSearchRoleTenantPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleSpec.
+func (in *OpenSearchRoleSpec) DeepCopy() *OpenSearchRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleStatus) DeepCopyInto(out *OpenSearchRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleStatus.
+func (in *OpenSearchRoleStatus) DeepCopy() *OpenSearchRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchRoleTenantPermission) DeepCopyInto(out *OpenSearchRoleTenantPermission) {
+	*out = *in
+	if in.TenantPatterns != nil {
+		in, out := &in.TenantPatterns, &out.TenantPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedActions != nil {
+		in, out := &in.AllowedActions, &out.AllowedActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchRoleTenantPermission.
+func (in *OpenSearchRoleTenantPermission) DeepCopy() *OpenSearchRoleTenantPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchRoleTenantPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSecurityAdminPasswordSource) DeepCopyInto(out *OpenSearchSecurityAdminPasswordSource) {
+	*out = *in
+	out.ConnInfoSecretSource = in.ConnInfoSecretSource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSecurityAdminPasswordSource.
+func (in *OpenSearchSecurityAdminPasswordSource) DeepCopy() *OpenSearchSecurityAdminPasswordSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSecurityAdminPasswordSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSecurityConfig) DeepCopyInto(out *OpenSearchSecurityConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSecurityConfig.
+func (in *OpenSearchSecurityConfig) DeepCopy() *OpenSearchSecurityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSecurityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSecurityConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSecurityConfigList) DeepCopyInto(out *OpenSearchSecurityConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchSecurityConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSecurityConfigList.
+func (in *OpenSearchSecurityConfigList) DeepCopy() *OpenSearchSecurityConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSecurityConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSecurityConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSecurityConfigSpec) DeepCopyInto(out *OpenSearchSecurityConfigSpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	out.AdminPasswordSecretSource = in.AdminPasswordSecretSource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSecurityConfigSpec.
+func (in *OpenSearchSecurityConfigSpec) DeepCopy() *OpenSearchSecurityConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSecurityConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSecurityConfigStatus) DeepCopyInto(out *OpenSearchSecurityConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSecurityConfigStatus.
+func (in *OpenSearchSecurityConfigStatus) DeepCopy() *OpenSearchSecurityConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSecurityConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepository) DeepCopyInto(out *OpenSearchSnapshotRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepository.
+func (in *OpenSearchSnapshotRepository) DeepCopy() *OpenSearchSnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopyInto(out *OpenSearchSnapshotRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchSnapshotRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositoryList.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopy() *OpenSearchSnapshotRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositorySpec) DeepCopyInto(out *OpenSearchSnapshotRepositorySpec) {
+	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositorySpec.
+func (in *OpenSearchSnapshotRepositorySpec) DeepCopy() *OpenSearchSnapshotRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositoryStatus) DeepCopyInto(out *OpenSearchSnapshotRepositoryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositoryStatus.
+func (in *OpenSearchSnapshotRepositoryStatus) DeepCopy() *OpenSearchSnapshotRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.ServiceForkFields.DeepCopyInto(&out.ServiceForkFields)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(opensearch.OpensearchUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
+func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUser) DeepCopyInto(out *OrganizationApplicationUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUser.
+func (in *OrganizationApplicationUser) DeepCopy() *OrganizationApplicationUser {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationApplicationUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserList) DeepCopyInto(out *OrganizationApplicationUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationApplicationUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserList.
+func (in *OrganizationApplicationUserList) DeepCopy() *OrganizationApplicationUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationApplicationUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserSpec) DeepCopyInto(out *OrganizationApplicationUserSpec) {
+	*out = *in
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserSpec.
+func (in *OrganizationApplicationUserSpec) DeepCopy() *OrganizationApplicationUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserStatus) DeepCopyInto(out *OrganizationApplicationUserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserStatus.
+func (in *OrganizationApplicationUserStatus) DeepCopy() *OrganizationApplicationUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserToken) DeepCopyInto(out *OrganizationApplicationUserToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserToken.
+func (in *OrganizationApplicationUserToken) DeepCopy() *OrganizationApplicationUserToken {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationApplicationUserToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserTokenList) DeepCopyInto(out *OrganizationApplicationUserTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationApplicationUserToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserTokenList.
+func (in *OrganizationApplicationUserTokenList) DeepCopy() *OrganizationApplicationUserTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationApplicationUserTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserTokenSpec) DeepCopyInto(out *OrganizationApplicationUserTokenSpec) {
+	*out = *in
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	if in.ApplicationUserRef != nil {
+		in, out := &in.ApplicationUserRef, &out.ApplicationUserRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.MaxAgeSeconds != nil {
+		in, out := &in.MaxAgeSeconds, &out.MaxAgeSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAllowlist != nil {
+		in, out := &in.IPAllowlist, &out.IPAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(TokenRotation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserTokenSpec.
+func (in *OrganizationApplicationUserTokenSpec) DeepCopy() *OrganizationApplicationUserTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationApplicationUserTokenStatus) DeepCopyInto(out *OrganizationApplicationUserTokenStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreviousTokenPrefixes != nil {
+		in, out := &in.PreviousTokenPrefixes, &out.PreviousTokenPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRotatedAt != nil {
+		in, out := &in.LastRotatedAt, &out.LastRotatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationApplicationUserTokenStatus.
+func (in *OrganizationApplicationUserTokenStatus) DeepCopy() *OrganizationApplicationUserTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationApplicationUserTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationGroupMember) DeepCopyInto(out *OrganizationGroupMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationGroupMember.
+func (in *OrganizationGroupMember) DeepCopy() *OrganizationGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationGroupMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationGroupMemberList) DeepCopyInto(out *OrganizationGroupMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationGroupMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationGroupMemberList.
+func (in *OrganizationGroupMemberList) DeepCopy() *OrganizationGroupMemberList {
+	if in == nil {
+		return nil
 	}
-	if in.MaxCompactionLagMs != nil {
-		in, out := &in.MaxCompactionLagMs, &out.MaxCompactionLagMs
-		*out = new(int)
-		**out = **in
+	out := new(OrganizationGroupMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationGroupMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MaxMessageBytes != nil {
-		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
-		*out = new(int)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationGroupMemberSpec) DeepCopyInto(out *OrganizationGroupMemberSpec) {
+	*out = *in
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+	if in.UserGroupRef != nil {
+		in, out := &in.UserGroupRef, &out.UserGroupRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
-	if in.MessageDownconversionEnable != nil {
-		in, out := &in.MessageDownconversionEnable, &out.MessageDownconversionEnable
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationGroupMemberSpec.
+func (in *OrganizationGroupMemberSpec) DeepCopy() *OrganizationGroupMemberSpec {
+	if in == nil {
+		return nil
 	}
-	if in.MessageTimestampDifferenceMaxMs != nil {
-		in, out := &in.MessageTimestampDifferenceMaxMs, &out.MessageTimestampDifferenceMaxMs
-		*out = new(int)
-		**out = **in
+	out := new(OrganizationGroupMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationGroupMemberStatus) DeepCopyInto(out *OrganizationGroupMemberStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MinCleanableDirtyRatio != nil {
-		in, out := &in.MinCleanableDirtyRatio, &out.MinCleanableDirtyRatio
-		*out = new(float64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationGroupMemberStatus.
+func (in *OrganizationGroupMemberStatus) DeepCopy() *OrganizationGroupMemberStatus {
+	if in == nil {
+		return nil
 	}
-	if in.MinCompactionLagMs != nil {
-		in, out := &in.MinCompactionLagMs, &out.MinCompactionLagMs
-		*out = new(int)
-		**out = **in
+	out := new(OrganizationGroupMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermission) DeepCopyInto(out *OrganizationPermission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermission.
+func (in *OrganizationPermission) DeepCopy() *OrganizationPermission {
+	if in == nil {
+		return nil
 	}
-	if in.MinInsyncReplicas != nil {
-		in, out := &in.MinInsyncReplicas, &out.MinInsyncReplicas
-		*out = new(int)
-		**out = **in
+	out := new(OrganizationPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPermission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Preallocate != nil {
-		in, out := &in.Preallocate, &out.Preallocate
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionAppliedBinding) DeepCopyInto(out *OrganizationPermissionAppliedBinding) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.RemoteStorageEnable != nil {
-		in, out := &in.RemoteStorageEnable, &out.RemoteStorageEnable
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionAppliedBinding.
+func (in *OrganizationPermissionAppliedBinding) DeepCopy() *OrganizationPermissionAppliedBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionAppliedBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionBinding) DeepCopyInto(out *OrganizationPermissionBinding) {
+	*out = *in
+	if in.UserGroupRef != nil {
+		in, out := &in.UserGroupRef, &out.UserGroupRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
-	if in.RetentionBytes != nil {
-		in, out := &in.RetentionBytes, &out.RetentionBytes
-		*out = new(int)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionBinding.
+func (in *OrganizationPermissionBinding) DeepCopy() *OrganizationPermissionBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionList) DeepCopyInto(out *OrganizationPermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionList.
+func (in *OrganizationPermissionList) DeepCopy() *OrganizationPermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionSpec) DeepCopyInto(out *OrganizationPermissionSpec) {
+	*out = *in
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]OrganizationPermissionBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionSpec.
+func (in *OrganizationPermissionSpec) DeepCopy() *OrganizationPermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionStatus) DeepCopyInto(out *OrganizationPermissionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedPermissions != nil {
+		in, out := &in.AppliedPermissions, &out.AppliedPermissions
+		*out = make([]OrganizationPermissionAppliedBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionStatus.
+func (in *OrganizationPermissionStatus) DeepCopy() *OrganizationPermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProject) DeepCopyInto(out *OrganizationProject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProject.
+func (in *OrganizationProject) DeepCopy() *OrganizationProject {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationProject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProjectList) DeepCopyInto(out *OrganizationProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationProject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectList.
+func (in *OrganizationProjectList) DeepCopy() *OrganizationProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProjectSpec) DeepCopyInto(out *OrganizationProjectSpec) {
+	*out = *in
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	if in.BillingGroupRef != nil {
+		in, out := &in.BillingGroupRef, &out.BillingGroupRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
-	if in.RetentionMs != nil {
-		in, out := &in.RetentionMs, &out.RetentionMs
+	if in.BasePort != nil {
+		in, out := &in.BasePort, &out.BasePort
 		*out = new(int)
 		**out = **in
 	}
-	if in.SegmentBytes != nil {
-		in, out := &in.SegmentBytes, &out.SegmentBytes
-		*out = new(int)
-		**out = **in
+	if in.TechnicalEmails != nil {
+		in, out := &in.TechnicalEmails, &out.TechnicalEmails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SegmentIndexBytes != nil {
-		in, out := &in.SegmentIndexBytes, &out.SegmentIndexBytes
-		*out = new(int)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.SegmentJitterMs != nil {
-		in, out := &in.SegmentJitterMs, &out.SegmentJitterMs
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectSpec.
+func (in *OrganizationProjectSpec) DeepCopy() *OrganizationProjectSpec {
+	if in == nil {
+		return nil
 	}
-	if in.SegmentMs != nil {
-		in, out := &in.SegmentMs, &out.SegmentMs
-		*out = new(int)
-		**out = **in
+	out := new(OrganizationProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProjectStatus) DeepCopyInto(out *OrganizationProjectStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.UncleanLeaderElectionEnable != nil {
-		in, out := &in.UncleanLeaderElectionEnable, &out.UncleanLeaderElectionEnable
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectStatus.
+func (in *OrganizationProjectStatus) DeepCopy() *OrganizationProjectStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(OrganizationProjectStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConfig.
-func (in *KafkaTopicConfig) DeepCopy() *KafkaTopicConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserGroup) DeepCopyInto(out *OrganizationUserGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserGroup.
+func (in *OrganizationUserGroup) DeepCopy() *OrganizationUserGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicConfig)
+	out := new(OrganizationUserGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationUserGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+func (in *OrganizationUserGroupList) DeepCopyInto(out *OrganizationUserGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaTopic, len(*in))
+		*out = make([]OrganizationUserGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
-func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserGroupList.
+func (in *OrganizationUserGroupList) DeepCopy() *OrganizationUserGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicList)
+	out := new(OrganizationUserGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
+func (in *OrganizationUserGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2080,38 +5747,23 @@ func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+func (in *OrganizationUserGroupSpec) DeepCopyInto(out *OrganizationUserGroupSpec) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]KafkaTopicTag, len(*in))
-		copy(*out, *in)
-	}
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = new(KafkaTopicConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TerminationProtection != nil {
-		in, out := &in.TerminationProtection, &out.TerminationProtection
-		*out = new(bool)
-		**out = **in
-	}
+	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
-func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserGroupSpec.
+func (in *OrganizationUserGroupSpec) DeepCopy() *OrganizationUserGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicSpec)
+	out := new(OrganizationUserGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+func (in *OrganizationUserGroupStatus) DeepCopyInto(out *OrganizationUserGroupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2122,63 +5774,110 @@ func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
-func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserGroupStatus.
+func (in *OrganizationUserGroupStatus) DeepCopy() *OrganizationUserGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicStatus)
+	out := new(OrganizationUserGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicTag) DeepCopyInto(out *KafkaTopicTag) {
+func (in *PasswordRotation) DeepCopyInto(out *PasswordRotation) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(PasswordRotationWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepPreviousFor != nil {
+		in, out := &in.KeepPreviousFor, &out.KeepPreviousFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotation.
+func (in *PasswordRotation) DeepCopy() *PasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationStatus) DeepCopyInto(out *PasswordRotationStatus) {
 	*out = *in
+	if in.LastRotatedAt != nil {
+		in, out := &in.LastRotatedAt, &out.LastRotatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicTag.
-func (in *KafkaTopicTag) DeepCopy() *KafkaTopicTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationStatus.
+func (in *PasswordRotationStatus) DeepCopy() *PasswordRotationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicTag)
+	out := new(PasswordRotationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalKafkaSchemaRef) DeepCopyInto(out *LocalKafkaSchemaRef) {
+func (in *PasswordRotationWindow) DeepCopyInto(out *PasswordRotationWindow) {
 	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalKafkaSchemaRef.
-func (in *LocalKafkaSchemaRef) DeepCopy() *LocalKafkaSchemaRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationWindow.
+func (in *PasswordRotationWindow) DeepCopy() *PasswordRotationWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalKafkaSchemaRef)
+	out := new(PasswordRotationWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrationSecretSource) DeepCopyInto(out *MigrationSecretSource) {
+func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSecretSource.
-func (in *MigrationSecretSource) DeepCopy() *MigrationSecretSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
+func (in *PostgreSQL) DeepCopy() *PostgreSQL {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrationSecretSource)
+	out := new(PostgreSQL)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQL) DeepCopyInto(out *MySQL) {
+func (in *PostgreSQLExtension) DeepCopyInto(out *PostgreSQLExtension) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2186,18 +5885,18 @@ func (in *MySQL) DeepCopyInto(out *MySQL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
-func (in *MySQL) DeepCopy() *MySQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLExtension.
+func (in *PostgreSQLExtension) DeepCopy() *PostgreSQLExtension {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQL)
+	out := new(PostgreSQLExtension)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQL) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLExtension) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2205,31 +5904,31 @@ func (in *MySQL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+func (in *PostgreSQLExtensionList) DeepCopyInto(out *PostgreSQLExtensionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MySQL, len(*in))
+		*out = make([]PostgreSQLExtension, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
-func (in *MySQLList) DeepCopy() *MySQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLExtensionList.
+func (in *PostgreSQLExtensionList) DeepCopy() *PostgreSQLExtensionList {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLList)
+	out := new(PostgreSQLExtensionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLExtensionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2237,60 +5936,45 @@ func (in *MySQLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+func (in *PostgreSQLExtensionSpec) DeepCopyInto(out *PostgreSQLExtensionSpec) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.MigrationSecretSource != nil {
-		in, out := &in.MigrationSecretSource, &out.MigrationSecretSource
-		*out = new(MigrationSecretSource)
-		**out = **in
-	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(mysql.MysqlUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
-func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLExtensionSpec.
+func (in *PostgreSQLExtensionSpec) DeepCopy() *PostgreSQLExtensionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSpec)
+	out := new(PostgreSQLExtensionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+func (in *PostgreSQLExtensionStatus) DeepCopyInto(out *PostgreSQLExtensionStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
-func (in *OpenSearch) DeepCopy() *OpenSearch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLExtensionStatus.
+func (in *PostgreSQLExtensionStatus) DeepCopy() *PostgreSQLExtensionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearch)
+	out := new(PostgreSQLExtensionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearch) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfig) DeepCopyInto(out *OpenSearchACLConfig) {
+func (in *PostgreSQLGrant) DeepCopyInto(out *PostgreSQLGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2298,18 +5982,18 @@ func (in *OpenSearchACLConfig) DeepCopyInto(out *OpenSearchACLConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfig.
-func (in *OpenSearchACLConfig) DeepCopy() *OpenSearchACLConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLGrant.
+func (in *PostgreSQLGrant) DeepCopy() *PostgreSQLGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchACLConfig)
+	out := new(PostgreSQLGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearchACLConfig) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2317,51 +6001,31 @@ func (in *OpenSearchACLConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfigACL) DeepCopyInto(out *OpenSearchACLConfigACL) {
-	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]OpenSearchACLConfigRule, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigACL.
-func (in *OpenSearchACLConfigACL) DeepCopy() *OpenSearchACLConfigACL {
-	if in == nil {
-		return nil
-	}
-	out := new(OpenSearchACLConfigACL)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfigList) DeepCopyInto(out *OpenSearchACLConfigList) {
+func (in *PostgreSQLGrantList) DeepCopyInto(out *PostgreSQLGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenSearchACLConfig, len(*in))
+		*out = make([]PostgreSQLGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigList.
-func (in *OpenSearchACLConfigList) DeepCopy() *OpenSearchACLConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLGrantList.
+func (in *PostgreSQLGrantList) DeepCopy() *PostgreSQLGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchACLConfigList)
+	out := new(PostgreSQLGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearchACLConfigList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2369,91 +6033,104 @@ func (in *OpenSearchACLConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfigRule) DeepCopyInto(out *OpenSearchACLConfigRule) {
+func (in *PostgreSQLGrantSpec) DeepCopyInto(out *PostgreSQLGrantSpec) {
 	*out = *in
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
+	in.PostgreSQLGrants.DeepCopyInto(&out.PostgreSQLGrants)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigRule.
-func (in *OpenSearchACLConfigRule) DeepCopy() *OpenSearchACLConfigRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLGrantSpec.
+func (in *PostgreSQLGrantSpec) DeepCopy() *PostgreSQLGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchACLConfigRule)
+	out := new(PostgreSQLGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfigSpec) DeepCopyInto(out *OpenSearchACLConfigSpec) {
+func (in *PostgreSQLGrantStatus) DeepCopyInto(out *PostgreSQLGrantStatus) {
 	*out = *in
-	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
-	if in.Acls != nil {
-		in, out := &in.Acls, &out.Acls
-		*out = make([]OpenSearchACLConfigACL, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(PostgreSQLGrants)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigSpec.
-func (in *OpenSearchACLConfigSpec) DeepCopy() *OpenSearchACLConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLGrantStatus.
+func (in *PostgreSQLGrantStatus) DeepCopy() *PostgreSQLGrantStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchACLConfigSpec)
+	out := new(PostgreSQLGrantStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchACLConfigStatus) DeepCopyInto(out *OpenSearchACLConfigStatus) {
+func (in *PostgreSQLGrants) DeepCopyInto(out *PostgreSQLGrants) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.PrivilegeGrants != nil {
+		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
+		*out = make([]PostgreSQLPrivilegeGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleGrants != nil {
+		in, out := &in.RoleGrants, &out.RoleGrants
+		*out = make([]PostgreSQLRoleGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLConfigStatus.
-func (in *OpenSearchACLConfigStatus) DeepCopy() *OpenSearchACLConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLGrants.
+func (in *PostgreSQLGrants) DeepCopy() *PostgreSQLGrants {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchACLConfigStatus)
+	out := new(PostgreSQLGrants)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenSearch, len(*in))
+		*out = make([]PostgreSQL, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
-func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
+func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchList)
+	out := new(PostgreSQLList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2461,28 +6138,62 @@ func (in *OpenSearchList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+func (in *PostgreSQLPrivilegeGrant) DeepCopyInto(out *PostgreSQLPrivilegeGrant) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(opensearch.OpensearchUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Grantees != nil {
+		in, out := &in.Grantees, &out.Grantees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
-func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLPrivilegeGrant.
+func (in *PostgreSQLPrivilegeGrant) DeepCopy() *PostgreSQLPrivilegeGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchSpec)
+	out := new(PostgreSQLPrivilegeGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationProject) DeepCopyInto(out *OrganizationProject) {
+func (in *PostgreSQLRoleGrant) DeepCopyInto(out *PostgreSQLRoleGrant) {
+	*out = *in
+	if in.Grantees != nil {
+		in, out := &in.Grantees, &out.Grantees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLRoleGrant.
+func (in *PostgreSQLRoleGrant) DeepCopy() *PostgreSQLRoleGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLRoleGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLSchema) DeepCopyInto(out *PostgreSQLSchema) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2490,18 +6201,18 @@ func (in *OrganizationProject) DeepCopyInto(out *OrganizationProject) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProject.
-func (in *OrganizationProject) DeepCopy() *OrganizationProject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSchema.
+func (in *PostgreSQLSchema) DeepCopy() *PostgreSQLSchema {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationProject)
+	out := new(PostgreSQLSchema)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationProject) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLSchema) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2509,31 +6220,31 @@ func (in *OrganizationProject) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationProjectList) DeepCopyInto(out *OrganizationProjectList) {
+func (in *PostgreSQLSchemaList) DeepCopyInto(out *PostgreSQLSchemaList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OrganizationProject, len(*in))
+		*out = make([]PostgreSQLSchema, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectList.
-func (in *OrganizationProjectList) DeepCopy() *OrganizationProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSchemaList.
+func (in *PostgreSQLSchemaList) DeepCopy() *PostgreSQLSchemaList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationProjectList)
+	out := new(PostgreSQLSchemaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationProjectList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLSchemaList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2541,41 +6252,23 @@ func (in *OrganizationProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationProjectSpec) DeepCopyInto(out *OrganizationProjectSpec) {
+func (in *PostgreSQLSchemaSpec) DeepCopyInto(out *PostgreSQLSchemaSpec) {
 	*out = *in
-	in.AuthSecretRefField.DeepCopyInto(&out.AuthSecretRefField)
-	in.SecretFields.DeepCopyInto(&out.SecretFields)
-	if in.BasePort != nil {
-		in, out := &in.BasePort, &out.BasePort
-		*out = new(int)
-		**out = **in
-	}
-	if in.TechnicalEmails != nil {
-		in, out := &in.TechnicalEmails, &out.TechnicalEmails
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	in.ServiceDependant.DeepCopyInto(&out.ServiceDependant)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectSpec.
-func (in *OrganizationProjectSpec) DeepCopy() *OrganizationProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSchemaSpec.
+func (in *PostgreSQLSchemaSpec) DeepCopy() *PostgreSQLSchemaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationProjectSpec)
+	out := new(PostgreSQLSchemaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationProjectStatus) DeepCopyInto(out *OrganizationProjectStatus) {
+func (in *PostgreSQLSchemaStatus) DeepCopyInto(out *PostgreSQLSchemaStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2586,97 +6279,81 @@ func (in *OrganizationProjectStatus) DeepCopyInto(out *OrganizationProjectStatus
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProjectStatus.
-func (in *OrganizationProjectStatus) DeepCopy() *OrganizationProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSchemaStatus.
+func (in *PostgreSQLSchemaStatus) DeepCopy() *PostgreSQLSchemaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationProjectStatus)
+	out := new(PostgreSQLSchemaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.ServiceForkFields.DeepCopyInto(&out.ServiceForkFields)
+	if in.MigrationSecretSource != nil {
+		in, out := &in.MigrationSecretSource, &out.MigrationSecretSource
+		*out = new(MigrationSecretSource)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(pg.PgUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
-func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
+func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQL)
+	out := new(PostgreSQLSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQL) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+func (in *PrivateLinkConnectionStatus) DeepCopyInto(out *PrivateLinkConnectionStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PostgreSQL, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
-func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkConnectionStatus.
+func (in *PrivateLinkConnectionStatus) DeepCopy() *PrivateLinkConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLList)
+	out := new(PrivateLinkConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+func (in *PrivateLinkStatus) DeepCopyInto(out *PrivateLinkStatus) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.MigrationSecretSource != nil {
-		in, out := &in.MigrationSecretSource, &out.MigrationSecretSource
-		*out = new(MigrationSecretSource)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(pg.PgUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]PrivateLinkConnectionStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
-func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkStatus.
+func (in *PrivateLinkStatus) DeepCopy() *PrivateLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLSpec)
+	out := new(PrivateLinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2812,6 +6489,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BillingGroupRef != nil {
+		in, out := &in.BillingGroupRef, &out.BillingGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.TechnicalEmails != nil {
 		in, out := &in.TechnicalEmails, &out.TechnicalEmails
 		*out = make([]string, len(*in))
@@ -3035,69 +6717,131 @@ func (in *SchemaReference) DeepCopy() *SchemaReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretFields) DeepCopyInto(out *SecretFields) {
 	*out = *in
-	if in.ConnInfoSecretTargetDisabled != nil {
-		in, out := &in.ConnInfoSecretTargetDisabled, &out.ConnInfoSecretTargetDisabled
-		*out = new(bool)
-		**out = **in
-	}
-	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.ConnInfoSecretTargetDisabled != nil {
+		in, out := &in.ConnInfoSecretTargetDisabled, &out.ConnInfoSecretTargetDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretFields.
+func (in *SecretFields) DeepCopy() *SecretFields {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
+	*out = *in
+	in.BaseServiceFields.DeepCopyInto(&out.BaseServiceFields)
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCommonSpec.
+func (in *ServiceCommonSpec) DeepCopy() *ServiceCommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceDependant) DeepCopyInto(out *ServiceDependant) {
+	*out = *in
+	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
+	out.ServiceField = in.ServiceField
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDependant.
+func (in *ServiceDependant) DeepCopy() *ServiceDependant {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDependant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceField) DeepCopyInto(out *ServiceField) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretFields.
-func (in *SecretFields) DeepCopy() *SecretFields {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceField.
+func (in *ServiceField) DeepCopy() *ServiceField {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretFields)
+	out := new(ServiceField)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
+func (in *ServiceForkFields) DeepCopyInto(out *ServiceForkFields) {
 	*out = *in
-	in.BaseServiceFields.DeepCopyInto(&out.BaseServiceFields)
-	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	if in.ForkFrom != nil {
+		in, out := &in.ForkFrom, &out.ForkFrom
+		*out = new(ServiceForkSource)
+		**out = **in
+	}
+	if in.RecoveryTargetTime != nil {
+		in, out := &in.RecoveryTargetTime, &out.RecoveryTargetTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCommonSpec.
-func (in *ServiceCommonSpec) DeepCopy() *ServiceCommonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceForkFields.
+func (in *ServiceForkFields) DeepCopy() *ServiceForkFields {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCommonSpec)
+	out := new(ServiceForkFields)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceDependant) DeepCopyInto(out *ServiceDependant) {
+func (in *ServiceForkSource) DeepCopyInto(out *ServiceForkSource) {
 	*out = *in
-	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
-	out.ServiceField = in.ServiceField
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDependant.
-func (in *ServiceDependant) DeepCopy() *ServiceDependant {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceForkSource.
+func (in *ServiceForkSource) DeepCopy() *ServiceForkSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceDependant)
+	out := new(ServiceForkSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceField) DeepCopyInto(out *ServiceField) {
+func (in *ServiceForkStatus) DeepCopyInto(out *ServiceForkStatus) {
 	*out = *in
+	if in.RecoveryTargetTime != nil {
+		in, out := &in.RecoveryTargetTime, &out.RecoveryTargetTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BackupTime != nil {
+		in, out := &in.BackupTime, &out.BackupTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceField.
-func (in *ServiceField) DeepCopy() *ServiceField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceForkStatus.
+func (in *ServiceForkStatus) DeepCopy() *ServiceForkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceField)
+	out := new(ServiceForkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3389,7 +7133,7 @@ func (in *ServiceIntegrationSpec) DeepCopyInto(out *ServiceIntegrationSpec) {
 	}
 	if in.KafkaMirrormakerUserConfig != nil {
 		in, out := &in.KafkaMirrormakerUserConfig, &out.KafkaMirrormakerUserConfig
-		*out = new(kafka_mirrormaker.KafkaMirrormakerUserConfig)
+		*out = new(integrationkafka_mirrormaker.KafkaMirrormakerUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LogsUserConfig != nil {
@@ -3446,6 +7190,16 @@ func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StaticIPs != nil {
+		in, out := &in.StaticIPs, &out.StaticIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForkedFrom != nil {
+		in, out := &in.ForkedFrom, &out.ForkedFrom
+		*out = new(ServiceForkStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStatus.
@@ -3577,6 +7331,11 @@ func (in *ServiceUserSpec) DeepCopyInto(out *ServiceUserSpec) {
 		*out = new(ConnInfoSecretSource)
 		**out = **in
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(PasswordRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AccessControl != nil {
 		in, out := &in.AccessControl, &out.AccessControl
 		*out = new(ServiceUserAccessControl)
@@ -3604,6 +7363,7 @@ func (in *ServiceUserStatus) DeepCopyInto(out *ServiceUserStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.PasswordRotationStatus.DeepCopyInto(&out.PasswordRotationStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserStatus.
@@ -3616,6 +7376,231 @@ func (in *ServiceUserStatus) DeepCopy() *ServiceUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIP) DeepCopyInto(out *StaticIP) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIP.
+func (in *StaticIP) DeepCopy() *StaticIP {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticIP) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPList) DeepCopyInto(out *StaticIPList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StaticIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPList.
+func (in *StaticIPList) DeepCopy() *StaticIPList {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticIPList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPSpec) DeepCopyInto(out *StaticIPSpec) {
+	*out = *in
+	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPSpec.
+func (in *StaticIPSpec) DeepCopy() *StaticIPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPStatus) DeepCopyInto(out *StaticIPStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPStatus.
+func (in *StaticIPStatus) DeepCopy() *StaticIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(PasswordRotationWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinOverlap != nil {
+		in, out := &in.MinOverlap, &out.MinOverlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRotation.
+func (in *TokenRotation) DeepCopy() *TokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachment) DeepCopyInto(out *TransitGatewayVPCAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachment.
+func (in *TransitGatewayVPCAttachment) DeepCopy() *TransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyInto(out *TransitGatewayVPCAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayVPCAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentList.
+func (in *TransitGatewayVPCAttachmentList) DeepCopy() *TransitGatewayVPCAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopyInto(out *TransitGatewayVPCAttachmentSpec) {
+	*out = *in
+	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
+	out.ProjectVPCRef = in.ProjectVPCRef
+	if in.UserPeerNetworkCIDRs != nil {
+		in, out := &in.UserPeerNetworkCIDRs, &out.UserPeerNetworkCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentSpec.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopy() *TransitGatewayVPCAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentStatus) {
+	*out = *in
+	in.VPCPeeringConnectionStatus.DeepCopyInto(&out.VPCPeeringConnectionStatus)
+	if in.UserPeerNetworkCIDRs != nil {
+		in, out := &in.UserPeerNetworkCIDRs, &out.UserPeerNetworkCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentStatus.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopy() *TransitGatewayVPCAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradePipelineStep) DeepCopyInto(out *UpgradePipelineStep) {
 	*out = *in
@@ -3742,6 +7727,46 @@ func (in *UpgradePipelineStepStatus) DeepCopy() *UpgradePipelineStepStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionSpec) DeepCopyInto(out *VPCPeeringConnectionSpec) {
+	*out = *in
+	in.ProjectDependant.DeepCopyInto(&out.ProjectDependant)
+	in.SecretFields.DeepCopyInto(&out.SecretFields)
+	out.ProjectVPCRef = in.ProjectVPCRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionSpec.
+func (in *VPCPeeringConnectionSpec) DeepCopy() *VPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionStatus.
+func (in *VPCPeeringConnectionStatus) DeepCopy() *VPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Valkey) DeepCopyInto(out *Valkey) {
 	*out = *in
@@ -3805,6 +7830,7 @@ func (in *ValkeyList) DeepCopyObject() runtime.Object {
 func (in *ValkeySpec) DeepCopyInto(out *ValkeySpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.ServiceForkFields.DeepCopyInto(&out.ServiceForkFields)
 	if in.UserConfig != nil {
 		in, out := &in.UserConfig, &out.UserConfig
 		*out = new(valkey.ValkeyUserConfig)
@@ -3821,3 +7847,19 @@ func (in *ValkeySpec) DeepCopy() *ValkeySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretSink) DeepCopyInto(out *VaultSecretSink) {
+	*out = *in
+	out.TokenSecretRef = in.TokenSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretSink.
+func (in *VaultSecretSink) DeepCopy() *VaultSecretSink {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretSink)
+	in.DeepCopyInto(out)
+	return out
+}
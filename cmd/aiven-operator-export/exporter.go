@@ -0,0 +1,529 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	avngen "github.com/aiven/go-client-codegen"
+)
+
+type exportOptions struct {
+	Project        string
+	Namespace      string
+	AdoptionPolicy string
+}
+
+// serviceKinds maps Aiven service types to the operator kinds.
+var serviceKinds = map[string]string{
+	"clickhouse":        "Clickhouse",
+	"flink":             "Flink",
+	"grafana":           "Grafana",
+	"kafka":             "Kafka",
+	"kafka_connect":     "KafkaConnect",
+	"kafka_mirrormaker": "KafkaMirrorMaker",
+	"mysql":             "MySQL",
+	"opensearch":        "OpenSearch",
+	"pg":                "PostgreSQL",
+	"valkey":            "Valkey",
+}
+
+// integrationConfigFields maps integration types to the ServiceIntegration field holding their user config.
+var integrationConfigFields = map[string]string{
+	"autoscaler":                      "autoscaler",
+	"clickhouse_kafka":                "clickhouseKafka",
+	"clickhouse_postgresql":           "clickhousePostgresql",
+	"datadog":                         "datadog",
+	"external_aws_cloudwatch_metrics": "externalAWSCloudwatchMetrics",
+	"kafka_connect":                   "kafkaConnect",
+	"kafka_logs":                      "kafkaLogs",
+	"kafka_mirrormaker":               "kafkaMirrormaker",
+	"logs":                            "logs",
+	"metrics":                         "metrics",
+}
+
+// endpointConfigFields maps endpoint types to the ServiceIntegrationEndpoint field holding their user config.
+var endpointConfigFields = map[string]string{
+	"autoscaler":                      "autoscaler",
+	"datadog":                         "datadog",
+	"external_aws_cloudwatch_logs":    "externalAWSCloudwatchLogs",
+	"external_aws_cloudwatch_metrics": "externalAWSCloudwatchMetrics",
+	"external_elasticsearch_logs":     "externalElasticsearchLogs",
+	"external_google_cloud_bigquery":  "externalGoogleCloudBigquery",
+	"external_google_cloud_logging":   "externalGoogleCloudLogging",
+	"external_kafka":                  "externalKafka",
+	"external_opensearch_logs":        "externalOpensearchLogs",
+	"external_postgresql":             "externalPostgresql",
+	"external_schema_registry":        "externalSchemaRegistry",
+	"jolokia":                         "jolokia",
+	"prometheus":                      "prometheus",
+	"rsyslog":                         "rsyslog",
+}
+
+// kindOrder is the order of kinds in the output, dependencies first.
+var kindOrder = []string{
+	"ServiceIntegrationEndpoint",
+	"Clickhouse", "Flink", "Grafana", "Kafka", "KafkaConnect", "KafkaMirrorMaker", "MySQL", "OpenSearch", "PostgreSQL", "Valkey",
+	"ServiceIntegration",
+	"ServiceUser",
+	"KafkaTopic", "KafkaACL", "KafkaSchema", "KafkaConnector",
+}
+
+// defaultUserConfig lists user config values Aiven reports even when they were never set.
+// They are omitted, so the exported spec contains only what was configured.
+var defaultUserConfig = map[string]any{
+	"ip_filter": []any{map[string]any{"network": "0.0.0.0/0"}},
+}
+
+// primaryUserType is the type of the built-in admin user, which is created with the service.
+const primaryUserType = "primary"
+
+// exporter walks an Aiven project with the same client calls the controllers use
+// and turns the resources into manifests.
+type exporter struct {
+	client avngen.Client
+	opts   exportOptions
+}
+
+func newExporter(client avngen.Client, opts exportOptions) *exporter {
+	return &exporter{client: client, opts: opts}
+}
+
+func (e *exporter) export(ctx context.Context) ([]manifest, error) {
+	var result []manifest
+
+	endpoints, err := e.exportEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, endpoints...)
+
+	list, err := e.client.ServiceList(ctx, e.opts.Project)
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+
+	services, err := toMaps(list)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range services {
+		manifests, err := e.exportService(ctx, s)
+		if err != nil {
+			return nil, fmt.Errorf("exporting service %q: %w", str(s, "service_name"), err)
+		}
+		result = append(result, manifests...)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		ki, kj := slices.Index(kindOrder, result[i].kind()), slices.Index(kindOrder, result[j].kind())
+		if ki != kj {
+			return ki < kj
+		}
+		return result[i].name() < result[j].name()
+	})
+	return result, nil
+}
+
+func (e *exporter) annotations() map[string]string {
+	if e.opts.AdoptionPolicy == "" {
+		return nil
+	}
+	return map[string]string{"controllers.aiven.io/adoption-policy": e.opts.AdoptionPolicy}
+}
+
+func (e *exporter) newManifest(kind, name string, spec map[string]any) (manifest, error) {
+	spec["project"] = e.opts.Project
+	return newManifest(kind, name, e.opts.Namespace, e.annotations(), spec)
+}
+
+func (e *exporter) exportService(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	kind, ok := serviceKinds[str(s, "service_type")]
+	if !ok {
+		log.Printf("skipping service %q: service type %q is not supported", serviceName, str(s, "service_type"))
+		return nil, nil
+	}
+
+	userConfig, _ := s["user_config"].(map[string]any)
+	spec := map[string]any{
+		"plan":            s["plan"],
+		"cloudName":       s["cloud_name"],
+		"projectVpcId":    s["project_vpc_id"],
+		"tags":            s["tags"],
+		"technicalEmails": s["tech_emails"],
+		"userConfig":      withoutDefaults(userConfig),
+	}
+	if s["termination_protection"] == true {
+		spec["terminationProtection"] = true
+	}
+
+	svc, err := e.newManifest(kind, serviceName, spec)
+	if err != nil {
+		return nil, err
+	}
+	result := []manifest{svc}
+
+	children := []func(context.Context, map[string]any) ([]manifest, error){
+		e.exportServiceUsers,
+		e.exportServiceIntegrations,
+	}
+	switch kind {
+	case "Kafka":
+		children = append(children, e.exportKafkaTopics, e.exportKafkaACLs)
+		if userConfig["schema_registry"] == true {
+			children = append(children, e.exportKafkaSchemas)
+		}
+		if userConfig["kafka_connect"] == true {
+			children = append(children, e.exportKafkaConnectors)
+		}
+	case "KafkaConnect":
+		children = append(children, e.exportKafkaConnectors)
+	}
+
+	for _, export := range children {
+		manifests, err := export(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, manifests...)
+	}
+	return result, nil
+}
+
+// exportServiceUsers reads each user with ServiceUserGet, like the ServiceUser controller does:
+// the users listed with the service don't hold all their fields.
+func (e *exporter) exportServiceUsers(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	users, _ := s["users"].([]any)
+
+	var result []manifest
+	for _, item := range users {
+		listed, _ := item.(map[string]any)
+		if str(listed, "type") == primaryUserType {
+			continue
+		}
+
+		username := str(listed, "username")
+		out, err := e.client.ServiceUserGet(ctx, e.opts.Project, serviceName, username)
+		if err != nil {
+			return nil, fmt.Errorf("getting service user %q: %w", username, err)
+		}
+
+		u, err := toMap(out)
+		if err != nil {
+			return nil, err
+		}
+
+		name := objectName(serviceName, username)
+		spec := map[string]any{
+			"serviceName":    serviceName,
+			"authentication": u["authentication"],
+		}
+		if name != username {
+			spec["username"] = username
+		}
+
+		m, err := e.newManifest("ServiceUser", name, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) exportServiceIntegrations(_ context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	integrations, _ := s["service_integrations"].([]any)
+
+	var result []manifest
+	for _, item := range integrations {
+		i, _ := item.(map[string]any)
+		// Each integration is listed by both services, export it from the source only.
+		if str(i, "source_service") != serviceName || !e.inProject(str(i, "source_project")) {
+			continue
+		}
+
+		integrationType := str(i, "integration_type")
+		spec := map[string]any{
+			"integrationType":        integrationType,
+			"sourceServiceName":      i["source_service"],
+			"sourceEndpointID":       i["source_endpoint_id"],
+			"destinationServiceName": i["dest_service"],
+			"destinationEndpointId":  i["dest_endpoint_id"],
+		}
+		if !e.inProject(str(i, "dest_project")) {
+			spec["destinationProjectName"] = i["dest_project"]
+		}
+		if field, ok := integrationConfigFields[integrationType]; ok {
+			spec[field] = i["user_config"]
+		}
+
+		dest := str(i, "dest_service")
+		if dest == "" {
+			dest = str(i, "dest_endpoint")
+		}
+		m, err := e.newManifest("ServiceIntegration", objectName(serviceName, integrationType, dest), spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) inProject(project string) bool {
+	return project == "" || project == e.opts.Project
+}
+
+func (e *exporter) exportEndpoints(ctx context.Context) ([]manifest, error) {
+	list, err := e.client.ServiceIntegrationEndpointList(ctx, e.opts.Project)
+	if err != nil {
+		return nil, fmt.Errorf("listing service integration endpoints: %w", err)
+	}
+
+	endpoints, err := toMaps(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []manifest
+	for _, ep := range endpoints {
+		endpointType := str(ep, "endpoint_type")
+		spec := map[string]any{
+			"endpointType": endpointType,
+			"endpointName": ep["endpoint_name"],
+		}
+		if field, ok := endpointConfigFields[endpointType]; ok {
+			spec[field] = ep["user_config"]
+		}
+
+		m, err := e.newManifest("ServiceIntegrationEndpoint", objectName(str(ep, "endpoint_name")), spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) exportKafkaTopics(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	list, err := e.client.ServiceKafkaTopicList(ctx, e.opts.Project, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing kafka topics: %w", err)
+	}
+
+	topics, err := toMaps(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []manifest
+	for _, t := range topics {
+		topicName := str(t, "topic_name")
+		name := objectName(topicName)
+		spec := map[string]any{
+			"serviceName": serviceName,
+			"partitions":  t["partitions"],
+			"replication": t["replication"],
+			"tags":        t["tags"],
+		}
+		if name != topicName {
+			spec["topicName"] = topicName
+		}
+
+		m, err := e.newManifest("KafkaTopic", name, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) exportKafkaACLs(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	list, err := e.client.ServiceKafkaAclList(ctx, e.opts.Project, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing kafka acls: %w", err)
+	}
+
+	acls, err := toMaps(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []manifest
+	for _, a := range acls {
+		// The default ACL grants avnadmin access to all topics and is created with the service.
+		if str(a, "id") == "default" {
+			continue
+		}
+
+		spec := map[string]any{
+			"serviceName": serviceName,
+			"permission":  a["permission"],
+			"topic":       a["topic"],
+			"username":    a["username"],
+		}
+		name := objectName(serviceName, str(a, "username"), str(a, "topic"), str(a, "permission"))
+		m, err := e.newManifest("KafkaACL", name, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) exportKafkaSchemas(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	subjects, err := e.client.ServiceSchemaRegistrySubjects(ctx, e.opts.Project, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing schema registry subjects: %w", err)
+	}
+
+	var result []manifest
+	for _, subject := range subjects {
+		versions, err := e.client.ServiceSchemaRegistrySubjectVersionsGet(ctx, e.opts.Project, serviceName, subject)
+		if err != nil {
+			return nil, fmt.Errorf("listing versions of subject %q: %w", subject, err)
+		}
+		if len(versions) == 0 {
+			continue
+		}
+
+		// Only the latest version is managed by KafkaSchema.
+		out, err := e.client.ServiceSchemaRegistrySubjectVersionGet(ctx, e.opts.Project, serviceName, subject, slices.Max(versions))
+		if err != nil {
+			return nil, fmt.Errorf("getting subject %q: %w", subject, err)
+		}
+
+		version, err := toMap(out)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := map[string]any{
+			"serviceName": serviceName,
+			"subjectName": subject,
+			"schema":      version["schema"],
+		}
+		// AVRO is the default schema type.
+		if schemaType := str(version, "schemaType"); schemaType != "AVRO" {
+			spec["schemaType"] = schemaType
+		}
+
+		m, err := e.newManifest("KafkaSchema", objectName(serviceName, subject), spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (e *exporter) exportKafkaConnectors(ctx context.Context, s map[string]any) ([]manifest, error) {
+	serviceName := str(s, "service_name")
+	list, err := e.client.ServiceKafkaConnectList(ctx, e.opts.Project, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing kafka connectors: %w", err)
+	}
+
+	var result []manifest
+	for _, c := range list.Connectors {
+		// KafkaConnector uses metadata.name as the connector name.
+		if objectName(c.Name) != c.Name {
+			log.Printf("skipping kafka connector %q: the name is not a valid Kubernetes object name", c.Name)
+			continue
+		}
+
+		config := make(map[string]string, len(c.Config))
+		for k, v := range c.Config {
+			config[k] = v
+		}
+		connectorClass := config["connector.class"]
+		delete(config, "connector.class")
+		delete(config, "name")
+
+		spec := map[string]any{
+			"serviceName":    serviceName,
+			"connectorClass": connectorClass,
+			"userConfig":     config,
+		}
+		m, err := e.newManifest("KafkaConnector", c.Name, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// withoutDefaults returns a copy of the user config without the values Aiven reports by default.
+func withoutDefaults(userConfig map[string]any) map[string]any {
+	result := make(map[string]any, len(userConfig))
+	for k, v := range userConfig {
+		if k == "ip_filter" {
+			v = normalizeIPFilter(v)
+		}
+		if d, ok := defaultUserConfig[k]; ok && jsonEqual(d, v) {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// normalizeIPFilter converts the short form of ip_filter, a list of networks, to the object form the CRDs use.
+func normalizeIPFilter(v any) any {
+	list, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	result := make([]any, 0, len(list))
+	for _, item := range list {
+		if network, ok := item.(string); ok {
+			item = map[string]any{"network": network}
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func jsonEqual(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+// toMap converts a client response to its JSON form, the field names match the Aiven API.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	return m, json.Unmarshal(b, &m)
+}
+
+func toMaps(v any) ([]map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m []map[string]any
+	return m, json.Unmarshal(b, &m)
+}
+
+func str(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafka"
+	"github.com/aiven/go-client-codegen/handler/kafkaconnect"
+	"github.com/aiven/go-client-codegen/handler/kafkaschemaregistry"
+	"github.com/aiven/go-client-codegen/handler/kafkatopic"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const testProject = "test-project"
+
+// loadFixture decodes a recorded Aiven API response from testdata into the client type.
+func loadFixture[T any](t *testing.T, name string) T {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	var v T
+	require.NoError(t, json.Unmarshal(b, &v))
+	return v
+}
+
+func newRecordedClient(t *testing.T) avngen.Client {
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceIntegrationEndpointList(mock.Anything, testProject).
+		Return(loadFixture[[]service.ServiceIntegrationEndpointOut](t, "endpoints.json"), nil).Once()
+	avn.EXPECT().
+		ServiceList(mock.Anything, testProject).
+		Return(loadFixture[[]service.ServiceOut](t, "services.json"), nil).Once()
+	avn.EXPECT().
+		ServiceUserGet(mock.Anything, testProject, "kafka-1", "Orders_App").
+		Return(new(loadFixture[service.ServiceUserGetOut](t, "service_user.json")), nil).Once()
+	avn.EXPECT().
+		ServiceKafkaTopicList(mock.Anything, testProject, "kafka-1").
+		Return(loadFixture[[]kafkatopic.TopicOut](t, "topics.json"), nil).Once()
+	avn.EXPECT().
+		ServiceKafkaAclList(mock.Anything, testProject, "kafka-1").
+		Return(loadFixture[[]kafka.AclOut](t, "acls.json"), nil).Once()
+	avn.EXPECT().
+		ServiceSchemaRegistrySubjects(mock.Anything, testProject, "kafka-1").
+		Return([]string{"orders-value"}, nil).Once()
+	avn.EXPECT().
+		ServiceSchemaRegistrySubjectVersionsGet(mock.Anything, testProject, "kafka-1", "orders-value").
+		Return([]int{1, 2}, nil).Once()
+	avn.EXPECT().
+		ServiceSchemaRegistrySubjectVersionGet(mock.Anything, testProject, "kafka-1", "orders-value", 2).
+		Return(new(loadFixture[kafkaschemaregistry.ServiceSchemaRegistrySubjectVersionGetOut](t, "schema_version.json")), nil).Once()
+	return avn
+}
+
+func findManifest(t *testing.T, manifests []manifest, kind, name string) manifest {
+	t.Helper()
+	for _, m := range manifests {
+		if m.kind() == kind && m.name() == name {
+			return m
+		}
+	}
+	require.Failf(t, "manifest not found", "%s %q", kind, name)
+	return nil
+}
+
+func spec(m manifest) map[string]any {
+	return m["spec"].(map[string]any)
+}
+
+func TestExporter_export(t *testing.T) {
+	e := newExporter(newRecordedClient(t), exportOptions{
+		Project:        testProject,
+		Namespace:      "aiven",
+		AdoptionPolicy: "AdoptIfMatches",
+	})
+
+	manifests, err := e.export(t.Context())
+	require.NoError(t, err)
+
+	var kinds []string
+	for _, m := range manifests {
+		kinds = append(kinds, m.kind()+"/"+m.name())
+	}
+	assert.Equal(t, []string{
+		"ServiceIntegrationEndpoint/prometheus",
+		"Kafka/kafka-1",
+		"PostgreSQL/pg-1",
+		"ServiceIntegration/kafka-1-kafka-logs-kafka-1",
+		"ServiceUser/kafka-1-orders-app",
+		"KafkaTopic/orders-events",
+		"KafkaTopic/orders.v1",
+		"KafkaACL/kafka-1-orders-app-orders.v1-read",
+		"KafkaSchema/kafka-1-orders-value",
+	}, kinds, "unsupported services, the primary user and the default ACL are skipped")
+
+	t.Run("Service omits defaults", func(t *testing.T) {
+		kafkaSvc := findManifest(t, manifests, "Kafka", "kafka-1")
+		assert.Equal(t, "aiven.io/v1alpha1", kafkaSvc["apiVersion"])
+		assert.Equal(t, map[string]any{
+			"name":        "kafka-1",
+			"namespace":   "aiven",
+			"annotations": map[string]any{"controllers.aiven.io/adoption-policy": "AdoptIfMatches"},
+		}, kafkaSvc["metadata"])
+		assert.Equal(t, map[string]any{
+			"project":               testProject,
+			"plan":                  "business-4",
+			"cloudName":             "google-europe-west1",
+			"terminationProtection": true,
+			"userConfig": map[string]any{
+				"kafka_version":   "3.8",
+				"schema_registry": true,
+			},
+		}, spec(kafkaSvc))
+		assert.NotContains(t, kafkaSvc, "status")
+
+		pg := findManifest(t, manifests, "PostgreSQL", "pg-1")
+		assert.Equal(t, map[string]any{
+			"ip_filter":  []any{map[string]any{"network": "10.0.0.0/8"}},
+			"pg_version": "16",
+		}, spec(pg)["userConfig"], "non-default ip_filter is kept in the object form")
+		assert.NotContains(t, spec(pg), "terminationProtection")
+	})
+
+	t.Run("Names are converted to valid object names", func(t *testing.T) {
+		user := findManifest(t, manifests, "ServiceUser", "kafka-1-orders-app")
+		assert.Equal(t, "Orders_App", spec(user)["username"])
+		assert.Equal(t, "kafka-1", spec(user)["serviceName"])
+		assert.Equal(t, "caching_sha2_password", spec(user)["authentication"], "the user is read with ServiceUserGet")
+
+		topic := findManifest(t, manifests, "KafkaTopic", "orders-events")
+		assert.Equal(t, "Orders_Events", spec(topic)["topicName"])
+
+		topic = findManifest(t, manifests, "KafkaTopic", "orders.v1")
+		assert.NotContains(t, spec(topic), "topicName")
+		assert.Equal(t, map[string]any{
+			"project":     testProject,
+			"serviceName": "kafka-1",
+			"partitions":  float64(3),
+			"replication": float64(2),
+			"tags":        []any{map[string]any{"key": "team", "value": "core"}},
+		}, spec(topic))
+	})
+
+	t.Run("Integration config goes to the typed field", func(t *testing.T) {
+		si := findManifest(t, manifests, "ServiceIntegration", "kafka-1-kafka-logs-kafka-1")
+		assert.Equal(t, "kafka_logs", spec(si)["integrationType"])
+		assert.Equal(t, map[string]any{"kafka_topic": "logs"}, spec(si)["kafkaLogs"])
+
+		ep := findManifest(t, manifests, "ServiceIntegrationEndpoint", "prometheus")
+		assert.Equal(t, map[string]any{"basic_auth_username": "metrics"}, spec(ep)["prometheus"])
+	})
+
+	t.Run("Schema is exported from the latest version", func(t *testing.T) {
+		schema := findManifest(t, manifests, "KafkaSchema", "kafka-1-orders-value")
+		assert.Equal(t, "orders-value", spec(schema)["subjectName"])
+		assert.JSONEq(t, `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`, spec(schema)["schema"].(string))
+	})
+
+	t.Run("Manifests decode into the CRD types", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeManifests(&buf, manifests))
+
+		docs := bytes.Split(buf.Bytes(), []byte("---\n"))
+		require.Len(t, docs, len(manifests))
+
+		topic := &v1alpha1.KafkaTopic{}
+		require.NoError(t, yaml.UnmarshalStrict(docs[6], topic))
+		assert.Equal(t, "orders.v1", topic.GetTopicName())
+		assert.Equal(t, 3, topic.Spec.Partitions)
+	})
+}
+
+func TestExporter_exportKafkaConnectors(t *testing.T) {
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceKafkaConnectList(mock.Anything, testProject, "connect-1").
+		Return(&kafkaconnect.ServiceKafkaConnectListOut{
+			Connectors: []kafkaconnect.ConnectorOut{
+				{
+					Name: "jdbc-sink",
+					Config: map[string]string{
+						"name":            "jdbc-sink",
+						"connector.class": "io.aiven.connect.jdbc.JdbcSinkConnector",
+						"topics":          "orders.v1",
+					},
+				},
+				{Name: "Invalid_Name", Config: map[string]string{"connector.class": "Foo"}},
+			},
+		}, nil).Once()
+
+	e := newExporter(avn, exportOptions{Project: testProject, Namespace: "default"})
+	manifests, err := e.exportKafkaConnectors(t.Context(), map[string]any{"service_name": "connect-1"})
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+
+	assert.Equal(t, map[string]any{"name": "jdbc-sink", "namespace": "default"}, manifests[0]["metadata"])
+	assert.Equal(t, map[string]any{
+		"project":        testProject,
+		"serviceName":    "connect-1",
+		"connectorClass": "io.aiven.connect.jdbc.JdbcSinkConnector",
+		"userConfig":     map[string]any{"topics": "orders.v1"},
+	}, spec(manifests[0]))
+}
+
+func TestObjectName(t *testing.T) {
+	assert.Equal(t, "orders-v2", objectName("Orders_V2"))
+	assert.Equal(t, "kafka-1-orders.v1", objectName("kafka-1", "orders.v1"))
+	assert.Equal(t, "a-b", objectName("_a__b_"))
+}
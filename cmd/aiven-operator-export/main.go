@@ -0,0 +1,87 @@
+// Command aiven-operator-export generates Aiven Operator manifests from the resources of a live Aiven project.
+//
+// Usage:
+//
+//	AIVEN_TOKEN=... aiven-operator-export -project my-project -namespace aiven > manifests.yaml
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/kelseyhightower/envconfig"
+)
+
+type exportConfig struct {
+	Token        string `envconfig:"AIVEN_TOKEN" required:"true"`
+	DebugLogging bool   `envconfig:"ENABLE_DEBUG_LOGGING"`
+}
+
+func main() {
+	var opts exportOptions
+	var output string
+	flag.StringVar(&opts.Project, "project", "", "Aiven project to export (required)")
+	flag.StringVar(&opts.Namespace, "namespace", "default", "Kubernetes namespace of the generated resources")
+	flag.StringVar(&opts.AdoptionPolicy, "adoption-policy", "AdoptIfMatches",
+		"Value of the controllers.aiven.io/adoption-policy annotation on the generated resources, empty to omit it")
+	flag.StringVar(&output, "output", "-", "File to write the manifests to, - for stdout")
+	flag.Parse()
+
+	if opts.Project == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(opts, output); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(opts exportOptions, output string) error {
+	cfg := new(exportConfig)
+	if err := envconfig.Process("", cfg); err != nil {
+		return fmt.Errorf("error processing environment variables: %w", err)
+	}
+
+	client, err := avngen.NewClient(avngen.TokenOpt(cfg.Token), avngen.DebugOpt(cfg.DebugLogging))
+	if err != nil {
+		return fmt.Errorf("error creating aiven client: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	manifests, err := newExporter(client, opts).export(ctx)
+	if err != nil {
+		return fmt.Errorf("error exporting project %q: %w", opts.Project, err)
+	}
+
+	if output == "-" {
+		err = writeManifests(os.Stdout, manifests)
+	} else {
+		err = writeManifestsFile(output, manifests)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing manifests: %w", err)
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "exported %d resources from project %q\n", len(manifests), opts.Project)
+	return nil
+}
+
+// writeManifestsFile writes the manifests to the file, reporting the error of closing it as well.
+func writeManifestsFile(name string, manifests []manifest) (err error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+	return writeManifests(f, manifests)
+}
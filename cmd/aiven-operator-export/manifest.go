@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// manifest is a Kubernetes object in its unstructured form, ready to be written as YAML.
+type manifest map[string]any
+
+func (m manifest) kind() string {
+	return m["kind"].(string)
+}
+
+func (m manifest) name() string {
+	return m["metadata"].(map[string]any)["name"].(string)
+}
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+}
+
+// newManifest builds a manifest of the given kind and passes it through the typed CRD object,
+// so fields the CRD doesn't know about are dropped, and then removes empty values.
+func newManifest(kind, name, namespace string, annotations map[string]string, spec map[string]any) (manifest, error) {
+	obj, err := scheme.New(v1alpha1.GroupVersion.WithKind(kind))
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{"name": name, "namespace": namespace}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+
+	raw, err := json.Marshal(map[string]any{
+		"apiVersion": v1alpha1.GroupVersion.String(),
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, fmt.Errorf("%s %q doesn't match the CRD: %w", kind, name, err)
+	}
+
+	raw, err = json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	m := make(manifest)
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	// Status and server-set metadata are not part of a manifest.
+	delete(m, "status")
+	delete(m["metadata"].(map[string]any), "creationTimestamp")
+	return pruneEmpty(m).(map[string]any), nil
+}
+
+// pruneEmpty removes empty strings, nil values, empty maps and empty slices, recursively.
+// Zero numbers and false booleans are kept: they can be set explicitly and differ from the defaults.
+func pruneEmpty(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if item = pruneEmpty(item); isEmpty(item) {
+				delete(v, k)
+			} else {
+				v[k] = item
+			}
+		}
+		return v
+	case manifest:
+		return pruneEmpty(map[string]any(v))
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			if item = pruneEmpty(item); !isEmpty(item) {
+				result = append(result, item)
+			}
+		}
+		return result
+	}
+	return v
+}
+
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+var (
+	invalidNameChars  = regexp.MustCompile(`[^a-z0-9.-]+`)
+	invalidNameBounds = regexp.MustCompile(`^[^a-z0-9]+|[^a-z0-9]+$`)
+)
+
+// maxNameLength is the maximum length of a DNS subdomain name, which most Kubernetes objects use.
+const maxNameLength = 253
+
+// objectName turns an Aiven name into a valid Kubernetes object name, e.g. "Orders_V2" becomes "orders-v2".
+func objectName(parts ...string) string {
+	name := strings.ToLower(strings.Join(parts, "-"))
+	name = invalidNameChars.ReplaceAllString(name, "-")
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+	}
+	return invalidNameBounds.ReplaceAllString(name, "")
+}
+
+// writeManifests writes the manifests as a multi-document YAML stream.
+func writeManifests(w io.Writer, manifests []manifest) error {
+	for i, m := range manifests {
+		b, err := yaml.Marshal(map[string]any(m))
+		if err != nil {
+			return fmt.Errorf("marshaling %s %q: %w", m.kind(), m.name(), err)
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
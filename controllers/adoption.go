@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	eventAdoptedAtAiven       = "AdoptedAtAiven"
+	eventUnableToAdoptAtAiven = "UnableToAdoptAtAiven"
+)
+
+var adoptionPolicies = []string{adoptionPolicyAdopt, adoptionPolicyAdoptIfMatches, adoptionPolicyFail}
+
+var errAdoptionRefused = errors.New("refusing to adopt the resource that already exists at Aiven")
+
+// ParseAdoptionPolicies parses a comma-separated list of Kind=Policy pairs, e.g. "KafkaTopic=Fail,Kafka=AdoptIfMatches".
+func ParseAdoptionPolicies(s string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		kind, policy, ok := strings.Cut(pair, "=")
+		if !ok || kind == "" {
+			return nil, fmt.Errorf("invalid adoption policy %q, expected Kind=Policy", pair)
+		}
+		if err := ValidateAdoptionPolicy(policy); err != nil {
+			return nil, err
+		}
+		result[kind] = policy
+	}
+	return result, nil
+}
+
+// ValidateAdoptionPolicy returns an error when policy is not one of Adopt, AdoptIfMatches or Fail.
+func ValidateAdoptionPolicy(policy string) error {
+	if !slices.Contains(adoptionPolicies, policy) {
+		return fmt.Errorf("invalid adoption policy %q, allowed values are %s", policy, strings.Join(adoptionPolicies, ", "))
+	}
+	return nil
+}
+
+// getAdoptionPolicy returns the adoption policy of the object.
+// The annotation wins over the operator-wide default for the kind, which falls back to Adopt,
+// the behavior before adoption policies were introduced.
+func getAdoptionPolicy(o v1alpha1.AivenManagedObject, operatorDefault string) (string, error) {
+	policy := o.GetAnnotations()[adoptionPolicyAnnotation]
+	if policy == "" {
+		policy = operatorDefault
+	}
+	if policy == "" {
+		return adoptionPolicyAdopt, nil
+	}
+	return policy, ValidateAdoptionPolicy(policy)
+}
+
+// needsAdoption reports whether an existing remote resource is seen for the first time,
+// i.e. it wasn't created by the operator and hasn't been adopted yet.
+func needsAdoption(o v1alpha1.AivenManagedObject) bool {
+	_, created := o.GetAnnotations()[createdAtAivenAnnotation]
+	return !created && !wasEverApplied(o) && !hasIsRunningAnnotation(o) && meta.FindStatusCondition(*o.Conditions(), conditionTypeAdopted) == nil
+}
+
+// markCreatedAtAiven records that the operator created the remote resource, see needsAdoption.
+func markCreatedAtAiven(o v1alpha1.AivenManagedObject) {
+	metav1.SetMetaDataAnnotation(o.GetObjectMeta(), createdAtAivenAnnotation, "true")
+}
+
+// checkAdoption returns errAdoptionRefused when the policy doesn't allow adopting the remote resource.
+func checkAdoption(policy string, diff []FieldDiff, compared bool) error {
+	switch policy {
+	case adoptionPolicyFail:
+		return fmt.Errorf("%w: adoption policy is %s", errAdoptionRefused, adoptionPolicyFail)
+	case adoptionPolicyAdoptIfMatches:
+		if !compared {
+			return fmt.Errorf("%w: this resource kind doesn't support comparing the remote state, use the %s policy to overwrite it", errAdoptionRefused, adoptionPolicyAdopt)
+		}
+		if len(diff) > 0 {
+			return fmt.Errorf("%w: the remote state differs from the spec, use the %s policy to overwrite it: %s",
+				errAdoptionRefused, adoptionPolicyAdopt, plannedAction{Action: plannedActionUpdate, Diff: diff}.diffString())
+		}
+	}
+	return nil
+}
+
+// adoptResource applies the adoption policy to the remote resource that already exists at Aiven.
+// On success the Adopted condition is set, its LastTransitionTime is the adoption time.
+func adoptResource(ctx context.Context, rec record.EventRecorder, o v1alpha1.AivenManagedObject, operatorDefault string, diff []FieldDiff, compared bool) error {
+	policy, err := getAdoptionPolicy(o, operatorDefault)
+	if err == nil {
+		err = checkAdoption(policy, diff, compared)
+	}
+	if err != nil {
+		rec.Event(o, corev1.EventTypeWarning, eventUnableToAdoptAtAiven, err.Error())
+		meta.SetStatusCondition(o.Conditions(), getErrorCondition(errConditionAdoption, err))
+		return err
+	}
+
+	logr.FromContextOrDiscard(ctx).Info("adopting the resource that already exists at Aiven", "policy", policy)
+	rec.Event(o, corev1.EventTypeNormal, eventAdoptedAtAiven, "the resource already existed at aiven and was adopted")
+	meta.SetStatusCondition(o.Conditions(), metav1.Condition{
+		Type:    conditionTypeAdopted,
+		Status:  metav1.ConditionTrue,
+		Reason:  policy,
+		Message: "The resource already existed at Aiven and was adopted",
+	})
+	return nil
+}
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestParseAdoptionPolicies(t *testing.T) {
+	got, err := ParseAdoptionPolicies("KafkaTopic=Fail, PostgreSQL=AdoptIfMatches,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"KafkaTopic": "Fail", "PostgreSQL": "AdoptIfMatches"}, got)
+
+	_, err = ParseAdoptionPolicies("KafkaTopic")
+	assert.ErrorContains(t, err, "expected Kind=Policy")
+
+	_, err = ParseAdoptionPolicies("KafkaTopic=Overwrite")
+	assert.ErrorContains(t, err, `invalid adoption policy "Overwrite"`)
+}
+
+func TestGetAdoptionPolicy(t *testing.T) {
+	obj := &v1alpha1.KafkaTopic{}
+	policy, err := getAdoptionPolicy(obj, "")
+	require.NoError(t, err)
+	assert.Equal(t, adoptionPolicyAdopt, policy)
+
+	policy, err = getAdoptionPolicy(obj, adoptionPolicyFail)
+	require.NoError(t, err)
+	assert.Equal(t, adoptionPolicyFail, policy)
+
+	metav1.SetMetaDataAnnotation(&obj.ObjectMeta, adoptionPolicyAnnotation, adoptionPolicyAdoptIfMatches)
+	policy, err = getAdoptionPolicy(obj, adoptionPolicyFail)
+	require.NoError(t, err)
+	assert.Equal(t, adoptionPolicyAdoptIfMatches, policy)
+
+	metav1.SetMetaDataAnnotation(&obj.ObjectMeta, adoptionPolicyAnnotation, "adopt")
+	_, err = getAdoptionPolicy(obj, "")
+	assert.Error(t, err)
+}
+
+func TestCheckAdoption(t *testing.T) {
+	diff := []FieldDiff{{Path: "spec.partitions", Desired: "3", Actual: "1"}}
+	cases := []struct {
+		name     string
+		policy   string
+		diff     []FieldDiff
+		compared bool
+		expected string
+	}{
+		{name: "Adopt overwrites differences", policy: adoptionPolicyAdopt, diff: diff},
+		{name: "AdoptIfMatches adopts matching resource", policy: adoptionPolicyAdoptIfMatches, compared: true},
+		{
+			name:     "AdoptIfMatches refuses differing resource",
+			policy:   adoptionPolicyAdoptIfMatches,
+			diff:     diff,
+			compared: true,
+			expected: `the remote state differs from the spec, use the Adopt policy to overwrite it: spec.partitions: "1" -> "3"`,
+		},
+		{
+			name:     "AdoptIfMatches refuses resource that wasn't compared",
+			policy:   adoptionPolicyAdoptIfMatches,
+			expected: "doesn't support comparing the remote state",
+		},
+		{name: "Fail refuses", policy: adoptionPolicyFail, compared: true, expected: "adoption policy is Fail"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkAdoption(tc.policy, tc.diff, tc.compared)
+			if tc.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, errAdoptionRefused)
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestAdoptResource(t *testing.T) {
+	t.Run("Sets Adopted condition", func(t *testing.T) {
+		obj := &v1alpha1.KafkaTopic{}
+		recorder := record.NewFakeRecorder(10)
+		require.True(t, needsAdoption(obj))
+
+		require.NoError(t, adoptResource(t.Context(), recorder, obj, "", nil, false))
+		cond := meta.FindStatusCondition(obj.Status.Conditions, conditionTypeAdopted)
+		require.NotNil(t, cond)
+		assert.Equal(t, adoptionPolicyAdopt, cond.Reason)
+		assert.False(t, needsAdoption(obj))
+		assert.Equal(t, []string{"Normal AdoptedAtAiven the resource already existed at aiven and was adopted"}, recorderEvents(recorder))
+	})
+
+	t.Run("Sets Error condition when refused", func(t *testing.T) {
+		obj := &v1alpha1.KafkaTopic{}
+		recorder := record.NewFakeRecorder(10)
+
+		err := adoptResource(t.Context(), recorder, obj, adoptionPolicyFail, nil, false)
+		require.ErrorIs(t, err, errAdoptionRefused)
+		cond := meta.FindStatusCondition(obj.Status.Conditions, ConditionTypeError)
+		require.NotNil(t, cond)
+		assert.Equal(t, string(errConditionAdoption), cond.Reason)
+		assert.Nil(t, meta.FindStatusCondition(obj.Status.Conditions, conditionTypeAdopted))
+	})
+
+	t.Run("Skips objects created by the operator", func(t *testing.T) {
+		obj := &v1alpha1.KafkaTopic{}
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, processedGenerationAnnotation, "1")
+		assert.False(t, needsAdoption(obj))
+	})
+
+	t.Run("Skips objects created by the operator before the generation was processed", func(t *testing.T) {
+		obj := &v1alpha1.KafkaTopic{}
+		markCreatedAtAiven(obj)
+		assert.False(t, needsAdoption(obj))
+	})
+}
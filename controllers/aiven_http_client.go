@@ -0,0 +1,358 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+)
+
+// AivenAPIOptions configures the HTTP client shared by all Aiven clients of the operator.
+type AivenAPIOptions struct {
+	// RateLimit is the number of requests per second allowed per token, unlimited when zero.
+	RateLimit float64
+
+	// Burst is the number of requests per token that can be sent at once.
+	Burst int
+
+	// CacheTTL is how long the responses of ServiceGet, ServiceKafkaTopicList and ServiceUserGet are reused.
+	// The cache is disabled when zero.
+	CacheTTL time.Duration
+}
+
+var defaultAivenAPIOptions = AivenAPIOptions{
+	RateLimit: 10,
+	Burst:     20,
+	CacheTTL:  5 * time.Second,
+}
+
+var (
+	aivenHTTPClientOnce sync.Once
+	aivenHTTPClient     *http.Client
+)
+
+// configureAivenHTTPClient builds the HTTP client shared by all Aiven clients.
+// Only the first call has effect: the limiters and the cache must be shared by all reconcilers.
+func configureAivenHTTPClient(opts AivenAPIOptions) {
+	aivenHTTPClientOnce.Do(func() {
+		aivenHTTPClient = newAivenHTTPClient(opts)
+	})
+}
+
+func getAivenHTTPClient() *http.Client {
+	configureAivenHTTPClient(defaultAivenAPIOptions)
+	return aivenHTTPClient
+}
+
+// newAivenHTTPClient returns the HTTP client for avngen.
+// Requests go through the cache, then the per-token rate limiter, and then are recorded in the metrics.
+// Like the default avngen client, it retries on connection errors, 429 and 5xx responses.
+func newAivenHTTPClient(opts AivenAPIOptions) *http.Client {
+	c := retryablehttp.NewClient()
+	c.Logger = nil
+
+	// Return the last response when retries are exhausted, so avngen turns a 429 into an avngen.Error.
+	c.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	var transport http.RoundTripper = &metricsTransport{next: c.HTTPClient.Transport}
+	transport = newRateLimitTransport(transport, opts.RateLimit, opts.Burst)
+	if opts.CacheTTL > 0 {
+		transport = newCacheTransport(transport, opts.CacheTTL)
+	}
+	c.HTTPClient.Transport = transport
+	return c.StandardClient()
+}
+
+// tokenKey identifies the token of the request without keeping the token itself.
+func tokenKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(sum[:])
+}
+
+// rateLimitTransport limits the requests per token, because Aiven applies its rate limits per token.
+// When Aiven responds with 429, requests with the same token are paused until Retry-After passes.
+type rateLimitTransport struct {
+	next  http.RoundTripper
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*tokenLimiter
+}
+
+type tokenLimiter struct {
+	limiter *rate.Limiter
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRateLimitTransport(next http.RoundTripper, limit float64, burst int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		next:     next,
+		limit:    rate.Inf,
+		burst:    burst,
+		limiters: make(map[string]*tokenLimiter),
+	}
+	if limit > 0 {
+		t.limit = rate.Limit(limit)
+	}
+	if t.burst <= 0 {
+		t.burst = 1
+	}
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := t.tokenLimiter(tokenKey(req))
+	if err := l.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	rsp, err := t.next.RoundTrip(req)
+	if err == nil && rsp.StatusCode == http.StatusTooManyRequests {
+		l.pause(parseRetryAfter(rsp.Header.Get("Retry-After"), time.Now()))
+	}
+	return rsp, err
+}
+
+func (t *rateLimitTransport) tokenLimiter(key string) *tokenLimiter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	l, ok := t.limiters[key]
+	if !ok {
+		l = &tokenLimiter{limiter: rate.NewLimiter(t.limit, t.burst)}
+		t.limiters[key] = l
+	}
+	return l
+}
+
+func (l *tokenLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return l.limiter.Wait(ctx)
+}
+
+func (l *tokenLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(v); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// cacheablePath matches the paths of ServiceGet, ServiceKafkaTopicList and ServiceUserGet.
+// Hundreds of objects can read the same service, e.g. every KafkaTopic lists the topics of its service.
+var cacheablePath = regexp.MustCompile(`^/v1/project/[^/]+/service/[^/]+(/topic|/user/[^/]+)?$`)
+
+// maxCacheEntries is the cache size after which expired entries are removed.
+const maxCacheEntries = 1024
+
+// cacheTransport is a read-through cache for idempotent GET requests.
+// Concurrent requests for the same URL and token share one request to Aiven.
+// Any other request invalidates the cached responses of the service it changes,
+// including the reads in flight, so a response sent before the change is not cached.
+type cacheTransport struct {
+	next  http.RoundTripper
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+
+	// reads, the GET requests in flight by cache key
+	reads map[string]*pendingRead
+}
+
+type pendingRead struct {
+	path        string
+	invalidated bool
+}
+
+type cachedResponse struct {
+	path       string
+	expires    time.Time
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func newCacheTransport(next http.RoundTripper, ttl time.Duration) *cacheTransport {
+	return &cacheTransport{
+		next:    next,
+		ttl:     ttl,
+		entries: make(map[string]*cachedResponse),
+		reads:   make(map[string]*pendingRead),
+	}
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := req.URL.EscapedPath()
+	if req.Method != http.MethodGet {
+		rsp, err := t.next.RoundTrip(req)
+		t.invalidate(path)
+		return rsp, err
+	}
+
+	if !cacheablePath.MatchString(path) {
+		return t.next.RoundTrip(req)
+	}
+
+	key := tokenKey(req) + " " + req.URL.String()
+	if cached := t.get(key); cached != nil {
+		return cached.response(req), nil
+	}
+
+	v, err, _ := t.group.Do(key, func() (any, error) {
+		// The previous call for the key may have finished between the lookup and Do.
+		if cached := t.get(key); cached != nil {
+			return cached, nil
+		}
+
+		var store *cachedResponse
+		t.startRead(key, path)
+		defer func() { t.finishRead(key, store) }()
+
+		rsp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer rsp.Body.Close()
+
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		cached := &cachedResponse{
+			path:       path,
+			expires:    time.Now().Add(t.ttl),
+			statusCode: rsp.StatusCode,
+			header:     rsp.Header,
+			body:       body,
+		}
+		if rsp.StatusCode == http.StatusOK {
+			store = cached
+		}
+		return cached, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*cachedResponse).response(req), nil
+}
+
+func (t *cacheTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cached, ok := t.entries[key]
+	if !ok || time.Now().After(cached.expires) {
+		return nil
+	}
+	return cached
+}
+
+func (t *cacheTransport) startRead(key, path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reads[key] = &pendingRead{path: path}
+}
+
+// finishRead caches the response, unless the service was changed while the request was in flight.
+func (t *cacheTransport) finishRead(key string, cached *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	read := t.reads[key]
+	delete(t.reads, key)
+	if cached == nil || read == nil || read.invalidated {
+		return
+	}
+
+	if len(t.entries) >= maxCacheEntries {
+		now := time.Now()
+		for k, v := range t.entries {
+			if now.After(v.expires) {
+				delete(t.entries, k)
+			}
+		}
+	}
+	t.entries[key] = cached
+}
+
+// invalidate removes the cached responses of the service the request path belongs to,
+// e.g. creating "/v1/project/foo/service/bar/topic" invalidates everything under "/v1/project/foo/service/bar".
+// Paths above the service level, like creating a service, invalidate the whole project.
+func (t *cacheTransport) invalidate(path string) {
+	segments := strings.Split(path, "/")
+	prefix := strings.Join(segments[:min(len(segments), 6)], "/")
+
+	matches := func(path string) bool {
+		return path == prefix || strings.HasPrefix(path, prefix+"/")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for k, v := range t.entries {
+		if matches(v.path) {
+			delete(t.entries, k)
+		}
+	}
+	for _, v := range t.reads {
+		if matches(v.path) {
+			v.invalidated = true
+		}
+	}
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(c.statusCode) + " " + http.StatusText(c.statusCode),
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doRequest(t *testing.T, c *http.Client, method, url, token string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), method, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "aivenv1 "+token)
+
+	rsp, err := c.Do(req)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	return rsp.StatusCode, string(body)
+}
+
+func TestCacheTransport(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := hits.Add(1)
+		if r.URL.Path == "/v1/project/foo/service/bar/user/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = io.WriteString(w, r.URL.Path+" "+string(rune('0'+n)))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+	topics := srv.URL + "/v1/project/foo/service/bar/topic"
+
+	t.Run("Concurrent reads share one request", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				code, body := doRequest(t, c, http.MethodGet, topics, "token")
+				assert.Equal(t, http.StatusOK, code)
+				assert.Equal(t, "/v1/project/foo/service/bar/topic 1", body)
+			})
+		}
+		wg.Wait()
+		assert.EqualValues(t, 1, hits.Load())
+
+		_, body := doRequest(t, c, http.MethodGet, topics, "token")
+		assert.Equal(t, "/v1/project/foo/service/bar/topic 1", body, "the response is cached")
+		assert.EqualValues(t, 1, hits.Load())
+	})
+
+	t.Run("Tokens don't share responses", func(t *testing.T) {
+		_, body := doRequest(t, c, http.MethodGet, topics, "another-token")
+		assert.Equal(t, "/v1/project/foo/service/bar/topic 2", body)
+	})
+
+	t.Run("Writes invalidate the service", func(t *testing.T) {
+		doRequest(t, c, http.MethodPost, srv.URL+"/v1/project/foo/service/bar/topic", "token")
+		_, body := doRequest(t, c, http.MethodGet, topics, "token")
+		assert.Equal(t, "/v1/project/foo/service/bar/topic 4", body)
+	})
+
+	t.Run("Errors and other paths are not cached", func(t *testing.T) {
+		before := hits.Load()
+		for range 2 {
+			code, _ := doRequest(t, c, http.MethodGet, srv.URL+"/v1/project/foo/service/bar/user/missing", "token")
+			assert.Equal(t, http.StatusNotFound, code)
+			doRequest(t, c, http.MethodGet, srv.URL+"/v1/project/foo/service/bar/acl", "token")
+		}
+		assert.Equal(t, before+4, hits.Load())
+	})
+}
+
+func TestCacheTransportInvalidatesReadsInFlight(t *testing.T) {
+	var hits atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			return
+		}
+		if hits.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		_, _ = io.WriteString(w, "topics "+string(rune('0'+hits.Load())))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+	topics := srv.URL + "/v1/project/foo/service/bar/topic"
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		_, body := doRequest(t, c, http.MethodGet, topics, "token")
+		assert.Equal(t, "topics 1", body)
+	})
+
+	// The topic is created while the list is read, so the list must not be cached
+	<-started
+	doRequest(t, c, http.MethodPost, topics, "token")
+	close(release)
+	wg.Wait()
+
+	_, body := doRequest(t, c, http.MethodGet, topics, "token")
+	assert.Equal(t, "topics 2", body)
+	assert.EqualValues(t, 2, hits.Load())
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0, 0)}
+
+	code, _ := doRequest(t, c, http.MethodGet, srv.URL, "token")
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	start := time.Now()
+	code, _ = doRequest(t, c, http.MethodGet, srv.URL, "another-token")
+	require.Equal(t, http.StatusOK, code)
+	assert.Less(t, time.Since(start), 500*time.Millisecond, "other tokens are not paused")
+
+	start = time.Now()
+	code, _ = doRequest(t, c, http.MethodGet, srv.URL, "token")
+	require.Equal(t, http.StatusOK, code)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond, "the token is paused until Retry-After passes")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/privatelink"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=awsprivatelinks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=awsprivatelinks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=awsprivatelinks/finalizers,verbs=get;create;update
+
+// AWSPrivateLinkController reconciles an AWSPrivateLink object.
+// AWS accepts the VPC endpoints of the allowed principals, so there are no connections to approve.
+type AWSPrivateLinkController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newAWSPrivateLinkReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.AWSPrivateLink] {
+			return &AWSPrivateLinkController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *AWSPrivateLinkController) Observe(ctx context.Context, pl *v1alpha1.AWSPrivateLink) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, pl.Spec.Project, pl.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.avnGen.ServicePrivatelinkAWSGet(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if isNotFound(err) {
+		return Observation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot get AWS privatelink: %w", err)
+	}
+
+	pl.Status.AWSServiceID = fromAnyPointer(out.AwsServiceId)
+	pl.Status.AWSServiceName = fromAnyPointer(out.AwsServiceName)
+	setPrivateLinkState(pl, &pl.Status.PrivateLinkStatus, string(out.State))
+
+	if pl.Status.State == privateLinkStateActive {
+		connections, err := r.avnGen.ServicePrivatelinkAWSConnectionList(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+		if err != nil {
+			return Observation{}, fmt.Errorf("cannot list AWS privatelink connections: %w", err)
+		}
+
+		pl.Status.Connections = make([]v1alpha1.PrivateLinkConnectionStatus, 0, len(connections))
+		for _, c := range connections {
+			pl.Status.Connections = append(pl.Status.Connections, v1alpha1.PrivateLinkConnectionStatus{
+				ID:         fromAnyPointer(c.PrivatelinkConnectionId),
+				EndpointID: c.VpcEndpointId,
+				State:      string(c.State),
+				DNSName:    c.DnsName,
+			})
+		}
+	}
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(pl) && sameStringSet(pl.Spec.Principals, out.Principals),
+	}, nil
+}
+
+func (r *AWSPrivateLinkController) Create(ctx context.Context, pl *v1alpha1.AWSPrivateLink) (CreateResult, error) {
+	delete(pl.GetAnnotations(), instanceIsRunningAnnotation)
+
+	out, err := r.avnGen.ServicePrivatelinkAWSCreate(ctx, pl.Spec.Project, pl.Spec.ServiceName, &privatelink.ServicePrivatelinkAwscreateIn{
+		Principals: slices.Clone(pl.Spec.Principals),
+	})
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create AWS privatelink: %w", err)
+	}
+
+	setPrivateLinkCreated(&pl.Status.PrivateLinkStatus, string(out.State))
+	return CreateResult{}, nil
+}
+
+func (r *AWSPrivateLinkController) Update(ctx context.Context, pl *v1alpha1.AWSPrivateLink) (UpdateResult, error) {
+	_, err := r.avnGen.ServicePrivatelinkAWSUpdate(ctx, pl.Spec.Project, pl.Spec.ServiceName, &privatelink.ServicePrivatelinkAwsupdateIn{
+		Principals: slices.Clone(pl.Spec.Principals),
+	})
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("cannot update AWS privatelink principals: %w", err)
+	}
+	return UpdateResult{}, nil
+}
+
+func (r *AWSPrivateLinkController) Delete(ctx context.Context, pl *v1alpha1.AWSPrivateLink) error {
+	_, err := r.avnGen.ServicePrivatelinkAWSDelete(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=awsvpcpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=awsvpcpeeringconnections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=awsvpcpeeringconnections/finalizers,verbs=get;create;update
+
+func newAWSVPCPeeringConnectionReconciler(c Controller) reconcilerType {
+	return newVPCPeeringConnectionReconciler(c, awsVPCPeer)
+}
+
+var awsVPCPeer = vpcPeer[*v1alpha1.AWSVPCPeeringConnection]{
+	createIn: func(obj *v1alpha1.AWSVPCPeeringConnection) *vpc.VpcPeeringConnectionCreateIn {
+		return &vpc.VpcPeeringConnectionCreateIn{
+			PeerCloudAccount: obj.Spec.AWSAccountID,
+			PeerVpc:          obj.Spec.AWSVPCID,
+			PeerRegion:       NilIfZero(obj.Spec.AWSVPCRegion),
+		}
+	},
+	delete: func(ctx context.Context, avnGen avngen.Client, obj *v1alpha1.AWSVPCPeeringConnection, projectVPCID string) error {
+		_, err := avnGen.VpcPeeringConnectionWithRegionDelete(ctx, obj.Spec.Project, projectVPCID, obj.Spec.AWSAccountID, obj.Spec.AWSVPCID, obj.Spec.AWSVPCRegion)
+		return err
+	},
+	setStatus: func(obj *v1alpha1.AWSVPCPeeringConnection, stateInfo map[string]string) SecretDetails {
+		obj.Status.AWSVPCPeeringConnectionID = stateInfo["aws_vpc_peering_connection_id"]
+		return SecretDetails{
+			"AWS_VPC_PEERING_CONNECTION_ID": obj.Status.AWSVPCPeeringConnectionID,
+			"AWS_ACCOUNT_ID":                obj.Spec.AWSAccountID,
+			"AWS_VPC_ID":                    obj.Spec.AWSVPCID,
+			"AWS_VPC_REGION":                obj.Spec.AWSVPCRegion,
+		}
+	},
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/privatelink"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=azureprivatelinks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=azureprivatelinks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=azureprivatelinks/finalizers,verbs=get;create;update
+
+// AzurePrivateLinkController reconciles an AzurePrivateLink object.
+type AzurePrivateLinkController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newAzurePrivateLinkReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.AzurePrivateLink] {
+			return &AzurePrivateLinkController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *AzurePrivateLinkController) Observe(ctx context.Context, pl *v1alpha1.AzurePrivateLink) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, pl.Spec.Project, pl.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.avnGen.ServicePrivatelinkAzureGet(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if isNotFound(err) {
+		return Observation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot get Azure privatelink: %w", err)
+	}
+
+	pl.Status.AzureServiceAlias = fromAnyPointer(out.AzureServiceAlias)
+	pl.Status.AzureServiceID = fromAnyPointer(out.AzureServiceId)
+	pl.Status.Message = fromAnyPointer(out.Message)
+	setPrivateLinkState(pl, &pl.Status.PrivateLinkStatus, string(out.State))
+
+	if pl.Status.State == privateLinkStateActive {
+		connections, err := r.avnGen.ServicePrivatelinkAzureConnectionList(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+		if err != nil {
+			return Observation{}, fmt.Errorf("cannot list Azure privatelink connections: %w", err)
+		}
+
+		pl.Status.Connections = make([]v1alpha1.PrivateLinkConnectionStatus, 0, len(connections))
+		for _, c := range connections {
+			pl.Status.Connections = append(pl.Status.Connections, v1alpha1.PrivateLinkConnectionStatus{
+				ID:            fromAnyPointer(c.PrivatelinkConnectionId),
+				EndpointID:    c.PrivateEndpointId,
+				State:         string(c.State),
+				UserIPAddress: c.UserIpAddress,
+			})
+		}
+	}
+
+	return Observation{
+		ResourceExists: true,
+		ResourceUpToDate: hasLatestGeneration(pl) &&
+			sameStringSet(pl.Spec.UserSubscriptionIDs, out.UserSubscriptionIds) &&
+			!azurePrivateLinkHasPendingConnections(pl),
+	}, nil
+}
+
+func (r *AzurePrivateLinkController) Create(ctx context.Context, pl *v1alpha1.AzurePrivateLink) (CreateResult, error) {
+	delete(pl.GetAnnotations(), instanceIsRunningAnnotation)
+
+	out, err := r.avnGen.ServicePrivatelinkAzureCreate(ctx, pl.Spec.Project, pl.Spec.ServiceName, &privatelink.ServicePrivatelinkAzureCreateIn{
+		UserSubscriptionIds: slices.Clone(pl.Spec.UserSubscriptionIDs),
+	})
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create Azure privatelink: %w", err)
+	}
+
+	setPrivateLinkCreated(&pl.Status.PrivateLinkStatus, string(out.State))
+	return CreateResult{}, nil
+}
+
+// Update sets the subscription IDs, approves the pending connections and then sets their IP addresses.
+// Approved connections get their IP address on the next reconciliation.
+func (r *AzurePrivateLinkController) Update(ctx context.Context, pl *v1alpha1.AzurePrivateLink) (UpdateResult, error) {
+	_, err := r.avnGen.ServicePrivatelinkAzureUpdate(ctx, pl.Spec.Project, pl.Spec.ServiceName, &privatelink.ServicePrivatelinkAzureUpdateIn{
+		UserSubscriptionIds: slices.Clone(pl.Spec.UserSubscriptionIDs),
+	})
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("cannot update Azure privatelink subscription IDs: %w", err)
+	}
+
+	for _, c := range pl.Spec.Connections {
+		conn := findPrivateLinkConnection(pl.Status.Connections, c.PrivateEndpointID)
+		switch {
+		case conn == nil:
+			// The private endpoint hasn't connected yet.
+		case conn.State == privateLinkConnectionStatePendingUserApproval:
+			_, err := r.avnGen.ServicePrivatelinkAzureConnectionApproval(ctx, pl.Spec.Project, pl.Spec.ServiceName, conn.ID)
+			if err != nil {
+				return UpdateResult{}, fmt.Errorf("cannot approve Azure privatelink connection %q: %w", conn.ID, err)
+			}
+		case c.UserIPAddress != "" && c.UserIPAddress != conn.UserIPAddress:
+			_, err := r.avnGen.ServicePrivatelinkAzureConnectionUpdate(ctx, pl.Spec.Project, pl.Spec.ServiceName, conn.ID, &privatelink.ServicePrivatelinkAzureConnectionUpdateIn{
+				UserIpAddress: c.UserIPAddress,
+			})
+			if err != nil {
+				return UpdateResult{}, fmt.Errorf("cannot update Azure privatelink connection %q: %w", conn.ID, err)
+			}
+		}
+	}
+	return UpdateResult{}, nil
+}
+
+func (r *AzurePrivateLinkController) Delete(ctx context.Context, pl *v1alpha1.AzurePrivateLink) error {
+	_, err := r.avnGen.ServicePrivatelinkAzureDelete(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// azurePrivateLinkHasPendingConnections returns true when a connection of the spec waits for approval or its IP address.
+func azurePrivateLinkHasPendingConnections(pl *v1alpha1.AzurePrivateLink) bool {
+	for _, c := range pl.Spec.Connections {
+		conn := findPrivateLinkConnection(pl.Status.Connections, c.PrivateEndpointID)
+		if conn == nil {
+			continue
+		}
+		if conn.State == privateLinkConnectionStatePendingUserApproval || c.UserIPAddress != "" && c.UserIPAddress != conn.UserIPAddress {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=azurevpcpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=azurevpcpeeringconnections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=azurevpcpeeringconnections/finalizers,verbs=get;create;update
+
+func newAzureVPCPeeringConnectionReconciler(c Controller) reconcilerType {
+	return newVPCPeeringConnectionReconciler(c, azureVPCPeer)
+}
+
+var azureVPCPeer = vpcPeer[*v1alpha1.AzureVPCPeeringConnection]{
+	createIn: func(obj *v1alpha1.AzureVPCPeeringConnection) *vpc.VpcPeeringConnectionCreateIn {
+		return &vpc.VpcPeeringConnectionCreateIn{
+			PeerCloudAccount:  obj.Spec.AzureSubscriptionID,
+			PeerVpc:           obj.Spec.VnetName,
+			PeerResourceGroup: NilIfZero(obj.Spec.PeerResourceGroup),
+			PeerAzureAppId:    NilIfZero(obj.Spec.PeerAzureAppID),
+			PeerAzureTenantId: NilIfZero(obj.Spec.PeerAzureTenantID),
+		}
+	},
+	delete: func(ctx context.Context, avnGen avngen.Client, obj *v1alpha1.AzureVPCPeeringConnection, projectVPCID string) error {
+		_, err := avnGen.VpcPeeringConnectionWithResourceGroupDelete(ctx, obj.Spec.Project, projectVPCID, obj.Spec.AzureSubscriptionID, obj.Spec.PeerResourceGroup, obj.Spec.VnetName)
+		return err
+	},
+	setStatus: func(obj *v1alpha1.AzureVPCPeeringConnection, stateInfo map[string]string) SecretDetails {
+		obj.Status.AivenTenantID = stateInfo["to-tenant-id"]
+		obj.Status.AivenNetworkID = stateInfo["to-network-id"]
+		return SecretDetails{
+			"AIVEN_TENANT_ID":       obj.Status.AivenTenantID,
+			"AIVEN_NETWORK_ID":      obj.Status.AivenNetworkID,
+			"AZURE_SUBSCRIPTION_ID": obj.Spec.AzureSubscriptionID,
+			"PEER_RESOURCE_GROUP":   obj.Spec.PeerResourceGroup,
+			"VNET_NAME":             obj.Spec.VnetName,
+		}
+	},
+}
@@ -36,7 +36,7 @@ const (
 // formatIntBaseDecimal it is a base to format int64 to string
 const formatIntBaseDecimal = 10
 
-var errNoTokenProvided = fmt.Errorf("no Aiven API token available: authSecretRef is not set and no DEFAULT_AIVEN_TOKEN configured")
+var errNoTokenProvided = fmt.Errorf("no Aiven API token available: neither credentialsRef nor authSecretRef is set and no DEFAULT_AIVEN_TOKEN configured")
 
 type (
 	// Controller reconciles the Aiven objects
@@ -47,9 +47,12 @@ type (
 		Scheme          *runtime.Scheme
 		Recorder        record.EventRecorder
 		DefaultToken    string
+		TokenProvider   TokenProvider
 		KubeVersion     string
 		OperatorVersion string
 		PollInterval    time.Duration
+		DryRun          bool
+		AdoptionPolicy  string
 		newAivenClient  func(token, kubeVersion, operatorVersion string) (avngen.Client, error)
 	}
 
@@ -79,11 +82,19 @@ type (
 
 		GetRefs() []*v1alpha1.ResourceReferenceObject
 	}
+
+	// planner is implemented by Handlers that can compare the spec with Aiven, for dry-run mode and adoption.
+	planner interface {
+		// plan returns the action createOrUpdate would take, with the fields it would change.
+		// It must compare every field createOrUpdate manages, so AdoptIfMatches doesn't adopt a resource that differs.
+		plan(ctx context.Context, avnGen avngen.Client, obj client.Object, refs []client.Object) (plannedAction, error)
+	}
 )
 
 const (
 	// Lifecycle event types we expose to the user
 	eventUnableToGetAuthSecret              = "UnableToGetAuthSecret"
+	eventUnableToGetToken                   = "UnableToGetToken"
 	eventUnableToCreateClient               = "UnableToCreateClient"
 	eventReconciliationStarted              = "ReconcilationStarted"
 	eventTryingToDeleteAtAiven              = "TryingToDeleteAtAiven"
@@ -105,6 +116,7 @@ const (
 	eventUnableToSyncConnectionSecret       = "UnableToSyncConnectionSecret"
 	eventConnInfoSecretCreationDisabled     = "ConnInfoSecretCreationDisabled"
 	eventCannotPublishConnectionDetails     = "CannotPublishConnectionDetails"
+	eventThrottledByAiven                   = "ThrottledByAiven"
 )
 
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
@@ -113,31 +125,48 @@ const (
 
 func (c *Controller) reconcileInstance(ctx context.Context, req ctrl.Request, h Handlers, o v1alpha1.AivenManagedObject) (ctrl.Result, error) {
 	if err := c.Get(ctx, req.NamespacedName, o); err != nil {
+		if apierrors.IsNotFound(err) {
+			objectConditions.forget(objectKind(o), req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	instanceLogger := setupLogger(c.Log, o)
 
-	var token string
+	var token, fingerprint string
 	var clientAuthSecret *corev1.Secret
 
-	if len(c.DefaultToken) > 0 {
+	switch {
+	case c.TokenProvider != nil:
+		t, err := c.providerToken(ctx, o)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		token = t
+		fingerprint = tokenFingerprint([]byte(t))
+	case len(c.DefaultToken) > 0:
 		token = c.DefaultToken
-	} else if auth := o.AuthSecretRef(); auth != nil {
-		clientAuthSecret = &corev1.Secret{}
-		if err := c.Get(ctx, types.NamespacedName{Name: auth.Name, Namespace: req.Namespace}, clientAuthSecret); err != nil {
-			c.Recorder.Eventf(o, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
-			return ctrl.Result{}, fmt.Errorf("cannot get secret %q: %w", auth.Name, err)
+		fingerprint = tokenFingerprint([]byte(c.DefaultToken))
+	default:
+		secret, key, err := c.resolveAuthSecret(ctx, o)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
-		token = string(clientAuthSecret.Data[auth.Key])
-	} else {
-		return ctrl.Result{}, errNoTokenProvided
+		if secret == nil {
+			return ctrl.Result{}, errNoTokenProvided
+		}
+		clientAuthSecret = secret
+		token = string(secret.Data[key])
+		fingerprint = tokenFingerprint(secret.Data[key])
 	}
 
 	newClient := c.newAivenClient
 	if newClient == nil {
 		newClient = NewAivenGeneratedClient
 	}
+	if c.TokenProvider != nil {
+		newClient = newTokenProviderClientFunc(c.TokenProvider)
+	}
 	avnGen, err := newClient(token, c.KubeVersion, c.OperatorVersion)
 	if err != nil {
 		c.Recorder.Event(o, corev1.EventTypeWarning, eventUnableToCreateClient, err.Error())
@@ -145,18 +174,26 @@ func (c *Controller) reconcileInstance(ctx context.Context, req ctrl.Request, h
 	}
 
 	helper := instanceReconcilerHelper{
-		avnGen: avnGen,
-		k8s:    c.Client,
-		h:      h,
-		log:    instanceLogger,
-		s:      clientAuthSecret,
-		rec:    c.Recorder,
+		avnGen:         avnGen,
+		k8s:            c.Client,
+		h:              h,
+		log:            instanceLogger,
+		s:              clientAuthSecret,
+		fingerprint:    fingerprint,
+		rec:            c.Recorder,
+		dryRun:         isDryRun(o, c.DryRun),
+		adoptionPolicy: c.AdoptionPolicy,
 	}
 
 	requeue, err := helper.reconcile(ctx, o)
+	objectConditions.observe(o)
 	result := ctrl.Result{Requeue: requeue}
-	if requeue {
+	switch {
+	case requeue:
 		result.RequeueAfter = requeueTimeout
+	case helper.planned && err == nil:
+		// Plans again on the next poll, so the drift at Aiven shows up in the Planned condition
+		result.RequeueAfter = c.PollInterval
 	}
 	return result, err
 }
@@ -175,11 +212,23 @@ type instanceReconcilerHelper struct {
 	// s, secret that contains the aiven token for the instance
 	s *corev1.Secret
 
+	// fingerprint, fingerprint of the token in s
+	fingerprint string
+
 	// log, logger setup with structured fields for the instance
 	log logr.Logger
 
 	// rec, recorder to record events for the object
 	rec record.EventRecorder
+
+	// dryRun, plan changes instead of applying them at Aiven
+	dryRun bool
+
+	// adoptionPolicy, operator-wide adoption policy for the kind, can be overridden by the annotation
+	adoptionPolicy string
+
+	// planned, the changes were planned in dry-run mode instead of being applied
+	planned bool
 }
 
 func (i *instanceReconcilerHelper) reconcile(ctx context.Context, o v1alpha1.AivenManagedObject) (bool, error) {
@@ -200,7 +249,9 @@ func (i *instanceReconcilerHelper) reconcile(ctx context.Context, o v1alpha1.Aiv
 			// Persist NotReady before dependency gates can requeue, so dependants do not keep trusting a stale Ready marker.
 			delete(o.GetAnnotations(), instanceIsRunningAnnotation)
 			setConnectionSecretPublishPendingCondition(o)
-		} else if !hasPendingMigration(o) {
+		} else if !hasPendingMigration(o) && !authTokenChanged(o, i.fingerprint) {
+			// A rotated token is checked with a full reconcile before it is marked as used
+			i.detectDrift(ctx, o)
 			return false, nil
 		}
 	}
@@ -210,6 +261,17 @@ func (i *instanceReconcilerHelper) reconcile(ctx context.Context, o v1alpha1.Aiv
 	// to save conditions and other data.
 	// So we don't exit on error.
 	requeue, err := i.reconcileInstance(ctx, o)
+	if isThrottled(err) {
+		i.log.Info("throttled by Aiven API, requeue", "error", err)
+		markThrottled(i.rec, o, err)
+		requeue, err = true, nil
+	} else if err == nil {
+		meta.RemoveStatusCondition(o.Conditions(), conditionTypeThrottled)
+		if !requeue {
+			markAuthToken(o, i.fingerprint)
+		}
+	}
+
 	if equality.Semantic.DeepEqual(orig, o) {
 		return requeue, err
 	}
@@ -289,6 +351,17 @@ func (i *instanceReconcilerHelper) reconcileInstance(ctx context.Context, o v1al
 	}
 
 	if !hasLatestGeneration(o) {
+		if i.dryRun {
+			return false, i.planCreateOrUpdate(ctx, o, refs)
+		}
+
+		meta.RemoveStatusCondition(o.Conditions(), conditionTypePlanned)
+		if needsAdoption(o) {
+			if err := i.adoptInstance(ctx, o, refs); err != nil {
+				return false, err
+			}
+		}
+
 		i.rec.Event(o, corev1.EventTypeNormal, eventCreateOrUpdatedAtAiven, "about to create instance at aiven")
 		requeue, err = i.createOrUpdateInstance(ctx, o, refs)
 		if err != nil {
@@ -343,6 +416,7 @@ func (i *instanceReconcilerHelper) checkPreconditions(ctx context.Context, o cli
 	if len(refs) > 0 {
 		for _, r := range refs {
 			if !IsReadyToUse(r) {
+				recordPreconditionWait(o)
 				i.log.Info("references are in progress")
 				return true, nil
 			}
@@ -357,6 +431,7 @@ func (i *instanceReconcilerHelper) checkPreconditions(ctx context.Context, o cli
 	}
 
 	if !check {
+		recordPreconditionWait(o)
 		i.rec.Event(o, corev1.EventTypeNormal, eventPreconditionsNotMet, "preconditions are not met, requeue")
 		i.log.Info("preconditions are not met, requeue")
 		return true, nil
@@ -420,6 +495,12 @@ func (i *instanceReconcilerHelper) finalize(ctx context.Context, o v1alpha1.Aive
 		}
 	}
 
+	if deletionPolicy == deletionPolicyDelete && i.dryRun {
+		// Keep the finalizer: the object stays in deletion until dry-run mode is turned off.
+		recordPlannedAction(logr.NewContext(ctx, i.log), i.rec, o, plannedAction{Action: plannedActionDelete})
+		return false, i.k8s.Status().Update(ctx, o)
+	}
+
 	if deletionPolicy == deletionPolicyDelete {
 		i.rec.Event(o, corev1.EventTypeNormal, eventTryingToDeleteAtAiven, "trying to delete instance at aiven")
 		finalised, err = i.h.delete(ctx, i.avnGen, o)
@@ -483,8 +564,77 @@ func (i *instanceReconcilerHelper) finalize(ctx context.Context, o v1alpha1.Aive
 func (i *instanceReconcilerHelper) isInvalidTokenError(err error) bool {
 	// When an instance was created but pointing to an invalid API token
 	// and no generation was ever processed, allow deleting such instance
-	msg := err.Error()
-	return strings.Contains(msg, "Invalid token") || strings.Contains(msg, "Missing (expired) db token")
+	return isInvalidTokenMessage(err.Error())
+}
+
+// planCreateOrUpdate records what createOrUpdateInstance would do, without calling the handler.
+// The processed generation is left untouched, so the change is applied once dry-run mode is turned off.
+func (i *instanceReconcilerHelper) planCreateOrUpdate(ctx context.Context, o v1alpha1.AivenManagedObject, refs []client.Object) error {
+	i.planned = true
+	plan := plannedAction{Action: plannedActionUpdate}
+	if !wasEverApplied(o) {
+		plan.Action = plannedActionCreate
+	}
+
+	if p, ok := i.h.(planner); ok {
+		var err error
+		plan, err = p.plan(ctx, i.avnGen, o, refs)
+		if err != nil {
+			return fmt.Errorf("unable to plan changes: %w", err)
+		}
+	}
+
+	recordPlannedAction(logr.NewContext(ctx, i.log), i.rec, o, plan)
+	return nil
+}
+
+// detectDrift records the drift of a running instance whose spec hasn't changed since it was applied.
+// The handler must be a planner to compare the instance with Aiven.
+// The spec is applied only when it changes, so the drift is reported but not reverted.
+func (i *instanceReconcilerHelper) detectDrift(ctx context.Context, o v1alpha1.AivenManagedObject) {
+	p, ok := i.h.(planner)
+	if !ok || !hasLatestGeneration(o) {
+		return
+	}
+
+	refs, err := i.getObjectRefs(ctx, o)
+	if err == nil {
+		var plan plannedAction
+		plan, err = p.plan(logr.NewContext(ctx, i.log), i.avnGen, o, refs)
+		if err == nil && plan.Action == plannedActionUpdate {
+			i.log.Info("instance at Aiven doesn't match the spec", "diff", plan.diffString())
+			recordDriftDetection(o)
+		}
+	}
+	if err != nil {
+		i.log.Info("unable to compare the instance with Aiven", "error", err)
+	}
+}
+
+// adoptInstance applies the adoption policy when the instance already exists at Aiven.
+// The handler must be a planner to tell whether the instance exists, so other handlers only support the Adopt policy.
+func (i *instanceReconcilerHelper) adoptInstance(ctx context.Context, o v1alpha1.AivenManagedObject, refs []client.Object) error {
+	ctx = logr.NewContext(ctx, i.log)
+	p, ok := i.h.(planner)
+	if !ok {
+		policy, err := getAdoptionPolicy(o, i.adoptionPolicy)
+		if err == nil && policy != adoptionPolicyAdopt {
+			err = fmt.Errorf("adoption policy %s is not supported by this resource kind", policy)
+		}
+		if err != nil {
+			meta.SetStatusCondition(o.Conditions(), getErrorCondition(errConditionAdoption, err))
+		}
+		return err
+	}
+
+	plan, err := p.plan(ctx, i.avnGen, o, refs)
+	if err != nil {
+		return fmt.Errorf("unable to compare the instance with Aiven: %w", err)
+	}
+	if plan.Action == plannedActionCreate {
+		return nil
+	}
+	return adoptResource(ctx, i.rec, o, i.adoptionPolicy, plan.Diff, true)
 }
 
 func (i *instanceReconcilerHelper) createOrUpdateInstance(ctx context.Context, o v1alpha1.AivenManagedObject, refs []client.Object) (bool, error) {
@@ -578,6 +728,11 @@ func (i *instanceReconcilerHelper) needsConnectionSecretPublish(ctx context.Cont
 		return true
 	}
 
+	// External sinks are not read back, the publish error is the only signal.
+	if !usesKubernetesSecretSink(withSecret) {
+		return false
+	}
+
 	secret := &corev1.Secret{}
 	if err := i.k8s.Get(ctx, types.NamespacedName{Name: connectionSecretName(withSecret), Namespace: withSecret.GetNamespace()}, secret); err != nil {
 		if !apierrors.IsNotFound(err) {
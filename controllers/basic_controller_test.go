@@ -3,6 +3,11 @@ package controllers
 import (
 	"testing"
 
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,3 +69,182 @@ func TestInstanceReconcilerHelper_reconcile(t *testing.T) {
 		require.Nil(t, meta.FindStatusCondition(got.Status.Conditions, ConditionTypeError))
 	})
 }
+
+func TestInstanceReconcilerHelper_adoptInstance(t *testing.T) {
+	newHelper := func(t *testing.T, avn avngen.Client, policy string) *instanceReconcilerHelper {
+		return &instanceReconcilerHelper{
+			avnGen:         avn,
+			h:              &genericServiceHandler{fabric: newPostgreSQLAdapterFactory(nil), log: logr.Discard()},
+			log:            logr.Discard(),
+			rec:            record.NewFakeRecorder(10),
+			adoptionPolicy: policy,
+		}
+	}
+
+	t.Run("Nothing to adopt when the service doesn't exist", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(nil, newAivenError(404, "not found")).Once()
+
+		require.NoError(t, newHelper(t, avn, adoptionPolicyFail).adoptInstance(t.Context(), pg, nil))
+		require.Nil(t, meta.FindStatusCondition(pg.Status.Conditions, conditionTypeAdopted))
+	})
+
+	t.Run("Adopts matching service", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: pg.Spec.Plan}, nil).Once()
+
+		require.NoError(t, newHelper(t, avn, adoptionPolicyAdoptIfMatches).adoptInstance(t.Context(), pg, nil))
+		adopted := meta.FindStatusCondition(pg.Status.Conditions, conditionTypeAdopted)
+		require.NotNil(t, adopted)
+		require.Equal(t, adoptionPolicyAdoptIfMatches, adopted.Reason)
+	})
+
+	t.Run("Refuses to adopt service with a different plan", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: "business-4"}, nil).Once()
+
+		err := newHelper(t, avn, adoptionPolicyAdoptIfMatches).adoptInstance(t.Context(), pg, nil)
+		require.ErrorIs(t, err, errAdoptionRefused)
+		require.ErrorContains(t, err, `spec.plan: "business-4" -> "hobby"`)
+		require.Nil(t, meta.FindStatusCondition(pg.Status.Conditions, conditionTypeAdopted))
+		require.Equal(t, string(errConditionAdoption), meta.FindStatusCondition(pg.Status.Conditions, ConditionTypeError).Reason)
+	})
+
+	t.Run("Refuses to adopt service with different fields outside the user config", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+		pg.Spec.MaintenanceWindowDow = "monday"
+		pg.Spec.Tags = map[string]string{"env": "prod"}
+		pg.Spec.TechnicalEmails = []v1alpha1.ServiceTechEmail{{Email: "ops@example.com"}}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{
+				Plan:        pg.Spec.Plan,
+				Maintenance: &service.MaintenanceOut{Dow: "sunday", Time: "10:00:00"},
+				Tags:        map[string]string{"env": "dev"},
+			}, nil).Once()
+
+		err := newHelper(t, avn, adoptionPolicyAdoptIfMatches).adoptInstance(t.Context(), pg, nil)
+		require.ErrorIs(t, err, errAdoptionRefused)
+		require.ErrorContains(t, err, `spec.maintenanceWindowDow: "sunday" -> "monday"`)
+		require.ErrorContains(t, err, "spec.tags")
+		require.ErrorContains(t, err, "spec.technicalEmails")
+		require.Nil(t, meta.FindStatusCondition(pg.Status.Conditions, conditionTypeAdopted))
+	})
+}
+
+func TestInstanceReconcilerHelper_detectDrift(t *testing.T) {
+	newRunningPostgreSQL := func(t *testing.T) *v1alpha1.PostgreSQL {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+		pg.Generation = 1
+		metav1.SetMetaDataAnnotation(&pg.ObjectMeta, processedGenerationAnnotation, "1")
+		return pg
+	}
+	newHelper := func(avn avngen.Client) *instanceReconcilerHelper {
+		return &instanceReconcilerHelper{
+			avnGen: avn,
+			h:      &genericServiceHandler{fabric: newPostgreSQLAdapterFactory(nil), log: logr.Discard()},
+			log:    logr.Discard(),
+			rec:    record.NewFakeRecorder(10),
+		}
+	}
+	drifts := func() float64 {
+		return testutil.ToFloat64(driftDetectionsTotal.WithLabelValues("PostgreSQL"))
+	}
+
+	t.Run("Records the drift of a service changed outside the operator", func(t *testing.T) {
+		pg := newRunningPostgreSQL(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: "business-4"}, nil).Once()
+
+		before := drifts()
+		newHelper(avn).detectDrift(t.Context(), pg)
+		require.Equal(t, before+1, drifts())
+	})
+
+	t.Run("No drift when the service matches the spec", func(t *testing.T) {
+		pg := newRunningPostgreSQL(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: pg.Spec.Plan}, nil).Once()
+
+		before := drifts()
+		newHelper(avn).detectDrift(t.Context(), pg)
+		require.Equal(t, before, drifts())
+	})
+
+	t.Run("Changed spec isn't a drift", func(t *testing.T) {
+		pg := newRunningPostgreSQL(t)
+		pg.Generation = 2
+
+		before := drifts()
+		newHelper(avngen.NewMockClient(t)).detectDrift(t.Context(), pg)
+		require.Equal(t, before, drifts())
+	})
+}
+
+func TestInstanceReconcilerHelper_planCreateOrUpdate(t *testing.T) {
+	newHelper := func(avn avngen.Client) *instanceReconcilerHelper {
+		return &instanceReconcilerHelper{
+			avnGen: avn,
+			h:      &genericServiceHandler{fabric: newPostgreSQLAdapterFactory(nil), log: logr.Discard()},
+			log:    logr.Discard(),
+			rec:    record.NewFakeRecorder(10),
+			dryRun: true,
+		}
+	}
+
+	t.Run("Reports no changes when the service matches the spec", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: pg.Spec.Plan}, nil).Once()
+
+		helper := newHelper(avn)
+		require.NoError(t, helper.planCreateOrUpdate(t.Context(), pg, nil))
+		require.True(t, helper.planned)
+
+		planned := meta.FindStatusCondition(pg.Status.Conditions, conditionTypePlanned)
+		require.NotNil(t, planned)
+		require.Equal(t, metav1.ConditionFalse, planned.Status)
+		require.Equal(t, string(plannedActionNone), planned.Reason)
+	})
+
+	t.Run("Plans an update when the service differs", func(t *testing.T) {
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, pg.Spec.Project, pg.Name, mock.Anything).
+			Return(&service.ServiceGetOut{Plan: "business-4"}, nil).Once()
+
+		helper := newHelper(avn)
+		require.NoError(t, helper.planCreateOrUpdate(t.Context(), pg, nil))
+
+		planned := meta.FindStatusCondition(pg.Status.Conditions, conditionTypePlanned)
+		require.NotNil(t, planned)
+		require.Equal(t, metav1.ConditionTrue, planned.Status)
+		require.Equal(t, string(plannedActionUpdate), planned.Reason)
+		require.Contains(t, planned.Message, `spec.plan: "business-4" -> "hobby"`)
+	})
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/billinggroup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newBillingGroupReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.BillingGroup] {
+			return &BillingGroupController{
+				Client: c.Client,
+				avnGen: avnGen,
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups/finalizers,verbs=get;create;update
+
+// BillingGroupController reconciles a BillingGroup object.
+type BillingGroupController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *BillingGroupController) Observe(ctx context.Context, cr *v1alpha1.BillingGroup) (Observation, error) {
+	if cr.Status.BillingGroupID == "" {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	got, err := r.avnGen.BillingGroupGet(ctx, cr.Status.BillingGroupID)
+	if err != nil {
+		if isNotFound(err) {
+			cr.Status.BillingGroupID = ""
+			return Observation{ResourceExists: false}, nil
+		}
+		return Observation{}, fmt.Errorf("getting billing group: %w", err)
+	}
+
+	cr.Status.PaymentMethod = string(got.PaymentMethod)
+	upToDate := hasLatestGeneration(cr) && billingGroupMatchesSpec(got, cr)
+	if upToDate {
+		markInstanceRunning(cr)
+	}
+
+	return Observation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *BillingGroupController) Create(ctx context.Context, cr *v1alpha1.BillingGroup) (CreateResult, error) {
+	accountID, err := r.getAccountID(ctx, cr.Spec.OrganizationID)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	cardID, err := getLongCardID(ctx, r.avnGen, cr.Spec.CardID)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("getting long card id: %w", err)
+	}
+
+	addressLines := append([]string{}, cr.Spec.AddressLines...)
+	billingEmails := billingGroupEmails(cr.Spec.BillingEmails)
+	out, err := r.avnGen.BillingGroupCreate(ctx, &billinggroup.BillingGroupCreateIn{
+		AccountId:        &accountID,
+		BillingGroupName: cr.Spec.Name,
+		AddressLines:     &addressLines,
+		BillingCurrency:  cr.Spec.BillingCurrency,
+		BillingEmails:    &billingEmails,
+		BillingExtraText: NilIfZero(cr.Spec.BillingExtraText),
+		CardId:           cardID,
+		City:             NilIfZero(cr.Spec.City),
+		Company:          NilIfZero(cr.Spec.Company),
+		CountryCode:      NilIfZero(cr.Spec.CountryCode),
+		State:            NilIfZero(cr.Spec.State),
+		VatId:            NilIfZero(cr.Spec.VatID),
+		ZipCode:          NilIfZero(cr.Spec.ZipCode),
+	})
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("creating billing group: %w", err)
+	}
+
+	cr.Status.BillingGroupID = out.BillingGroupId
+	cr.Status.PaymentMethod = string(out.PaymentMethod)
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{}, nil
+}
+
+func (r *BillingGroupController) Update(ctx context.Context, cr *v1alpha1.BillingGroup) (UpdateResult, error) {
+	cardID, err := getLongCardID(ctx, r.avnGen, cr.Spec.CardID)
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("getting long card id: %w", err)
+	}
+
+	// Sends every field, so the values changed outside Kubernetes are cleared
+	addressLines := append([]string{}, cr.Spec.AddressLines...)
+	billingEmails := billingGroupEmails(cr.Spec.BillingEmails)
+	out, err := r.avnGen.BillingGroupUpdate(ctx, cr.Status.BillingGroupID, &billinggroup.BillingGroupUpdateIn{
+		BillingGroupName: &cr.Spec.Name,
+		AddressLines:     &addressLines,
+		BillingCurrency:  cr.Spec.BillingCurrency,
+		BillingEmails:    &billingEmails,
+		BillingExtraText: &cr.Spec.BillingExtraText,
+		CardId:           cardID,
+		City:             &cr.Spec.City,
+		Company:          &cr.Spec.Company,
+		CountryCode:      NilIfZero(cr.Spec.CountryCode),
+		State:            &cr.Spec.State,
+		VatId:            &cr.Spec.VatID,
+		ZipCode:          &cr.Spec.ZipCode,
+	})
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("updating billing group: %w", err)
+	}
+
+	cr.Status.PaymentMethod = string(out.PaymentMethod)
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return UpdateResult{}, nil
+}
+
+func (r *BillingGroupController) Delete(ctx context.Context, cr *v1alpha1.BillingGroup) error {
+	if cr.Status.BillingGroupID == "" {
+		return nil
+	}
+
+	// Aiven doesn't delete a billing group with projects,
+	// waits for the projects that reference it to move to another billing group or be deleted
+	names, err := r.billingGroupProjects(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("%w: the billing group is used by %s", v1alpha1.ErrDeleteDependencies, strings.Join(names, ", "))
+	}
+
+	err = r.avnGen.BillingGroupDelete(ctx, cr.Status.BillingGroupID)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting billing group: %w", err)
+	}
+	return nil
+}
+
+// billingGroupProjects returns the projects in Kubernetes that reference the billing group or use its ID
+func (r *BillingGroupController) billingGroupProjects(ctx context.Context, cr *v1alpha1.BillingGroup) ([]string, error) {
+	key := client.ObjectKeyFromObject(cr)
+	uses := func(obj client.Object, id string, ref *v1alpha1.ResourceReference) bool {
+		if id != "" {
+			return id == cr.Status.BillingGroupID
+		}
+		return ref != nil && ref.BillingGroup(obj.GetNamespace()).NamespacedName == key
+	}
+
+	var names []string
+	projects := &v1alpha1.ProjectList{}
+	if err := r.List(ctx, projects); err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+	for i := range projects.Items {
+		p := &projects.Items[i]
+		if uses(p, p.Spec.BillingGroupID, p.Spec.BillingGroupRef) {
+			names = append(names, "Project "+client.ObjectKeyFromObject(p).String())
+		}
+	}
+
+	orgProjects := &v1alpha1.OrganizationProjectList{}
+	if err := r.List(ctx, orgProjects); err != nil {
+		return nil, fmt.Errorf("listing organization projects: %w", err)
+	}
+	for i := range orgProjects.Items {
+		p := &orgProjects.Items[i]
+		if uses(p, p.Spec.BillingGroupID, p.Spec.BillingGroupRef) {
+			names = append(names, "OrganizationProject "+client.ObjectKeyFromObject(p).String())
+		}
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// getAccountID converts an organization ID to its account ID form.
+func (r *BillingGroupController) getAccountID(ctx context.Context, organizationID string) (string, error) {
+	org, err := r.avnGen.OrganizationGet(ctx, organizationID)
+	if err != nil {
+		return "", fmt.Errorf("converting organization ID %q to account ID: %w", organizationID, err)
+	}
+	return org.AccountId, nil
+}
+
+// billingGroupMatchesSpec reports whether the remote billing group matches the spec.
+// The card is compared only when set, because Aiven can replace it with the organization default.
+func billingGroupMatchesSpec(got *billinggroup.BillingGroupGetOut, cr *v1alpha1.BillingGroup) bool {
+	gotEmails := make([]string, len(got.BillingEmails))
+	for i, e := range got.BillingEmails {
+		gotEmails[i] = e.Email
+	}
+
+	return got.BillingGroupName == cr.Spec.Name &&
+		(cr.Spec.CardID == "" || cr.Spec.CardID == got.CardInfo.CardId || cr.Spec.CardID == got.CardInfo.Last4) &&
+		(cr.Spec.BillingCurrency == "" || cr.Spec.BillingCurrency == got.BillingCurrency) &&
+		(cr.Spec.CountryCode == "" || cr.Spec.CountryCode == got.CountryCode) &&
+		got.BillingExtraText == cr.Spec.BillingExtraText &&
+		got.City == cr.Spec.City &&
+		got.Company == cr.Spec.Company &&
+		got.State == cr.Spec.State &&
+		got.VatId == cr.Spec.VatID &&
+		got.ZipCode == cr.Spec.ZipCode &&
+		cmp.Equal(cr.Spec.AddressLines, got.AddressLines, cmpopts.EquateEmpty()) &&
+		cmp.Equal(normalizeTechEmails(cr.Spec.BillingEmails), normalizeTechEmails(gotEmails), cmpopts.EquateEmpty())
+}
+
+// billingGroupEmails always returns a non-nil slice of billing emails.
+func billingGroupEmails(emails []string) []billinggroup.BillingEmailIn {
+	billingEmails := make([]billinggroup.BillingEmailIn, len(emails))
+	for i, v := range emails {
+		billingEmails[i] = billinggroup.BillingEmailIn{Email: v}
+	}
+	return billingEmails
+}
+
+// getBillingGroupID returns the ID of the referenced BillingGroup.
+func getBillingGroupID(ctx context.Context, c client.Reader, namespace string, ref *v1alpha1.ResourceReference) (string, error) {
+	key := ref.BillingGroup(namespace).NamespacedName
+
+	group := &v1alpha1.BillingGroup{}
+	if err := c.Get(ctx, key, group); err != nil {
+		return "", fmt.Errorf("cannot get BillingGroup %q: %w", key, err)
+	}
+	if group.Status.BillingGroupID == "" {
+		return "", fmt.Errorf("%w: BillingGroup %q is not created yet", errPreconditionNotMet, key)
+	}
+	return group.Status.BillingGroupID, nil
+}
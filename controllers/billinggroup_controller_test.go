@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/billinggroup"
+	"github.com/aiven/go-client-codegen/handler/organization"
+	"github.com/aiven/go-client-codegen/handler/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestBillingGroupController(t *testing.T) {
+	t.Parallel()
+
+	const (
+		billingGroupID = "bg1a2b3c4d5e6"
+		accountID      = "a1a2b3c4d5e6"
+	)
+
+	newClient := func(t *testing.T, objects ...client.Object) client.Client {
+		t.Helper()
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, v1alpha1.AddToScheme(scheme))
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	}
+
+	newObjects := func(t *testing.T) (*v1alpha1.BillingGroup, *v1alpha1.OrganizationProject) {
+		t.Helper()
+		const example = "billinggroup"
+
+		bg := newObjectFromExampleYAMLByKind[v1alpha1.BillingGroup](t, example, "BillingGroup")
+		bg.Namespace = "default"
+		bg.Status.BillingGroupID = billingGroupID
+
+		project := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationProject](t, example, "OrganizationProject")
+		project.Namespace = "default"
+		return bg, project
+	}
+
+	t.Run("Creates the billing group in the organization account", func(t *testing.T) {
+		bg, _ := newObjects(t)
+		bg.Status = v1alpha1.BillingGroupStatus{}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			OrganizationGet(mock.Anything, bg.Spec.OrganizationID).
+			Return(&organization.OrganizationGetOut{AccountId: accountID}, nil).Once()
+		avn.EXPECT().
+			UserCreditCardsList(mock.Anything).
+			Return([]user.CardOut{{CardId: "card-long-id", Last4: "4242"}}, nil).Once()
+		avn.EXPECT().
+			BillingGroupCreate(mock.Anything, mock.MatchedBy(func(in *billinggroup.BillingGroupCreateIn) bool {
+				return *in.AccountId == accountID &&
+					in.BillingGroupName == bg.Spec.Name &&
+					*in.CardId == "card-long-id" &&
+					assert.Equal(t, bg.Spec.AddressLines, *in.AddressLines) &&
+					assert.Equal(t, billingGroupEmails(bg.Spec.BillingEmails), *in.BillingEmails)
+			})).
+			Return(&billinggroup.BillingGroupCreateOut{BillingGroupId: billingGroupID}, nil).Once()
+
+		c := &BillingGroupController{Client: newClient(t), avnGen: avn}
+		obs, err := c.Observe(t.Context(), bg)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = c.Create(t.Context(), bg)
+		require.NoError(t, err)
+		assert.Equal(t, billingGroupID, bg.Status.BillingGroupID)
+	})
+
+	t.Run("Detects drift of the billing emails", func(t *testing.T) {
+		bg, _ := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			BillingGroupGet(mock.Anything, billingGroupID).
+			Return(&billinggroup.BillingGroupGetOut{
+				BillingGroupId:   billingGroupID,
+				BillingGroupName: bg.Spec.Name,
+				AddressLines:     bg.Spec.AddressLines,
+				BillingCurrency:  bg.Spec.BillingCurrency,
+				BillingEmails:    []billinggroup.BillingEmailOut{{Email: "other@example.com"}},
+				BillingExtraText: bg.Spec.BillingExtraText,
+				CardInfo:         billinggroup.CardInfoOut{CardId: "card-long-id", Last4: "4242"},
+				City:             bg.Spec.City,
+				Company:          bg.Spec.Company,
+				CountryCode:      bg.Spec.CountryCode,
+				VatId:            bg.Spec.VatID,
+				ZipCode:          bg.Spec.ZipCode,
+			}, nil).Once()
+
+		obs, err := (&BillingGroupController{Client: newClient(t), avnGen: avn}).Observe(t.Context(), bg)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Resolves billingGroupRef of the organization project", func(t *testing.T) {
+		bg, project := newObjects(t)
+
+		got, err := (&OrganizationProjectController{Client: newClient(t, bg)}).resolveBillingGroupID(t.Context(), project)
+		require.NoError(t, err)
+		assert.Equal(t, billingGroupID, got)
+
+		bg.Status.BillingGroupID = ""
+		_, err = (&OrganizationProjectController{Client: newClient(t, bg)}).resolveBillingGroupID(t.Context(), project)
+		assert.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Doesn't delete the billing group used by projects", func(t *testing.T) {
+		bg, project := newObjects(t)
+		legacy := &v1alpha1.Project{}
+		legacy.Name = "my-project"
+		legacy.Namespace = "other"
+		legacy.Spec.BillingGroupID = billingGroupID
+
+		err := (&BillingGroupController{Client: newClient(t, project, legacy), avnGen: avngen.NewMockClient(t)}).Delete(t.Context(), bg)
+		require.ErrorIs(t, err, v1alpha1.ErrDeleteDependencies)
+		assert.ErrorContains(t, err, "OrganizationProject default/my-organization-project, Project other/my-project")
+	})
+
+	t.Run("Deletes the billing group without projects", func(t *testing.T) {
+		bg, _ := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().BillingGroupDelete(mock.Anything, billingGroupID).Return(nil).Once()
+
+		require.NoError(t, (&BillingGroupController{Client: newClient(t), avnGen: avn}).Delete(t.Context(), bg))
+	})
+}
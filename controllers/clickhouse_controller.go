@@ -66,6 +66,10 @@ func (a *clickhouseAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
 	return &a.Spec.ServiceCommonSpec
 }
 
+func (a *clickhouseAdapter) getServiceFork() *v1alpha1.ServiceForkFields {
+	return &a.Spec.ServiceForkFields
+}
+
 func (a *clickhouseAdapter) getUserConfig() any {
 	return a.Spec.UserConfig
 }
@@ -84,6 +88,8 @@ func (a *clickhouseAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 		"USER":     s.ServiceUriParams["user"],
 	}
 
+	addPrivatelinkEndpointDetails(stringData, s.Components, "clickhouse", prefix)
+
 	return newSecret(a, stringData, false)
 }
 
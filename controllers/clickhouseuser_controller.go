@@ -6,12 +6,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/clickhouse"
 	"github.com/aiven/go-client-codegen/handler/service"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
@@ -25,6 +27,7 @@ import (
 type ClickhouseUserController struct {
 	client.Client
 	avnGen avngen.Client
+	rec    record.EventRecorder
 }
 
 func newClickhouseUserReconciler(c Controller) reconcilerType {
@@ -34,6 +37,7 @@ func newClickhouseUserReconciler(c Controller) reconcilerType {
 			return &ClickhouseUserController{
 				Client: c.Client,
 				avnGen: avnGen,
+				rec:    c.Recorder,
 			}
 		},
 		nil,
@@ -75,11 +79,17 @@ func (r *ClickhouseUserController) Observe(ctx context.Context, user *v1alpha1.C
 
 	secretDetails := buildConnectionDetailsFromService(svc, user, password)
 
+	now := time.Now()
+	setPreviousPasswordDetails(secretDetails, user, "", now)
+
+	// The password is rotated in Update.
+	rotate := user.Spec.ConnInfoSecretSource == nil && isPasswordRotationDue(user, now)
+
 	return Observation{
 		ResourceExists: true,
 		// Up-to-date is driven by "controllers.aiven.io/generation-was-processed" and "controllers.aiven.io/instance-is-running" annotations.
 		// Secret source changes are handled by `SecretWatchController`, which clears "controllers.aiven.io/generation-was-processed" to force Update.
-		ResourceUpToDate: IsReadyToUse(user),
+		ResourceUpToDate: IsReadyToUse(user) && !rotate,
 		SecretDetails:    secretDetails,
 	}, nil
 }
@@ -131,6 +141,18 @@ func (r *ClickhouseUserController) Update(ctx context.Context, user *v1alpha1.Cl
 		return UpdateResult{}, err
 	}
 
+	// Operator-managed mode: generate the password when the rotation is due.
+	now := time.Now()
+	rotate := password == "" && isPasswordRotationDue(user, now)
+	var previous string
+	if rotate {
+		previous, err = readConnectionSecretPassword(ctx, r.Client, user)
+		if err != nil {
+			return UpdateResult{}, err
+		}
+		password = generatePassword()
+	}
+
 	if password != "" {
 		// External mode: when a ConnInfoSecretSource is configured, we actively enforce the password from that source via PasswordReset.
 		// We rely on the Aiven API behavior that PasswordReset echoes the provided password back in the response.
@@ -150,8 +172,13 @@ func (r *ClickhouseUserController) Update(ctx context.Context, user *v1alpha1.Cl
 	if err != nil {
 		return UpdateResult{}, fmt.Errorf("building connection details: %w", err)
 	}
+	setPreviousPasswordDetails(secretDetails, user, previous, now)
 
-	return UpdateResult{SecretDetails: secretDetails}, nil
+	res := UpdateResult{SecretDetails: secretDetails}
+	if rotate {
+		res.SecretPublished = func() { markPasswordRotated(r.rec, user, now) }
+	}
+	return res, nil
 }
 
 func (r *ClickhouseUserController) Delete(ctx context.Context, user *v1alpha1.ClickhouseUser) error {
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"testing"
 
 	avngen "github.com/aiven/go-client-codegen"
@@ -707,6 +708,57 @@ func TestClickhouseUserController_Update(t *testing.T) {
 		require.NotContains(t, res.SecretDetails, "PASSWORD")
 	})
 
+	t.Run("Rotation is recorded once the new password is published", func(t *testing.T) {
+		user := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		user.Status.UUID = "uuid-rotate"
+		user.Annotations = map[string]string{rotatePasswordAnnotation: "2026-01-01"}
+
+		connSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      user.Name,
+				Namespace: user.Namespace,
+			},
+			Data: map[string][]byte{
+				"CLICKHOUSEUSER_PASSWORD": []byte("old-password"),
+			},
+		}
+
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(user, connSecret).
+			Build()
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceClickHousePasswordReset(mock.Anything, user.Spec.Project, user.Spec.ServiceName, user.Status.UUID, mock.MatchedBy(func(in *clickhouse.ServiceClickHousePasswordResetIn) bool {
+				return in.Password != nil && *in.Password != "old-password"
+			})).
+			RunAndReturn(func(_ context.Context, _, _, _ string, in *clickhouse.ServiceClickHousePasswordResetIn) (string, error) {
+				return *in.Password, nil
+			}).
+			Once()
+		avn.EXPECT().
+			ServiceGet(mock.Anything, user.Spec.Project, user.Spec.ServiceName, mock.Anything).
+			Return(&service.ServiceGetOut{ServiceUriParams: map[string]string{"host": "host", "port": "9440"}}, nil).
+			Once()
+
+		ctrl := &ClickhouseUserController{
+			Client: k8sClient,
+			avnGen: avn,
+			rec:    record.NewFakeRecorder(10),
+		}
+
+		res, err := ctrl.Update(t.Context(), user)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.SecretDetails["CLICKHOUSEUSER_PASSWORD"])
+		require.Nil(t, user.Status.LastRotatedAt, "the rotation is done again if the secret isn't published")
+
+		require.NotNil(t, res.SecretPublished)
+		res.SecretPublished()
+		require.NotNil(t, user.Status.LastRotatedAt)
+		require.Equal(t, "2026-01-01", user.Status.LastRotationRequest)
+	})
+
 	t.Run("Returns error when reading password from source secret fails in Update", func(t *testing.T) {
 		user := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
 		user.Status.UUID = "uuid-desired-fail"
@@ -54,6 +54,30 @@ type Observation struct {
 	// Keys are written as-is: controllers apply the secret prefix themselves,
 	// e.g. getSecretPrefix(obj) + "CA_CERT".
 	SecretDetails SecretDetails
+
+	// Diff optionally lists the fields that differ between the spec and the remote state.
+	// It is used to report planned changes in dry-run mode and by the AdoptIfMatches adoption policy.
+	Diff []FieldDiff
+
+	// Compared is true when the controller computed Diff, so an empty Diff means the compared fields match.
+	// The AdoptIfMatches adoption policy refuses to adopt resources that were not compared.
+	Compared bool
+
+	// SecretPublished is called once SecretDetails are written to the connection secret.
+	// The password rotation is recorded there, so a rotation whose password wasn't published is done again.
+	SecretPublished func()
+}
+
+// FieldDiff describes a single field that differs between the spec and the remote state.
+type FieldDiff struct {
+	// Path is the field path in the spec, e.g. "spec.partitions".
+	Path string
+
+	// Desired is the value from the spec.
+	Desired string
+
+	// Actual is the value on Aiven side, empty when the field is not set remotely.
+	Actual string
 }
 
 // CreateResult is returned from Create and carries optional information about the created external resource (for example, connection details).
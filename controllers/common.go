@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -28,6 +30,9 @@ import (
 const (
 	conditionTypeRunning     = "Running"
 	conditionTypeInitialized = "Initialized"
+	conditionTypePlanned     = "Planned"
+	conditionTypeAdopted     = "Adopted"
+	conditionTypeThrottled   = "Throttled"
 	ConditionTypeError       = "Error"
 
 	secretProtectionFinalizer = "finalizers.aiven.io/needed-to-delete-services"
@@ -37,9 +42,23 @@ const (
 	instanceIsRunningAnnotation   = "controllers.aiven.io/instance-is-running"
 	secretSourceUpdatedAnnotation = "controllers.aiven.io/secret-source-updated"
 
+	// createdAtAivenAnnotation marks the remote resource as created by the operator,
+	// so it isn't adopted when the reconcile fails after Create, before the generation is processed
+	createdAtAivenAnnotation = "controllers.aiven.io/created-at-aiven"
+
+	// authTokenAnnotation is the fingerprint of the auth secret token the object was last reconciled with
+	authTokenAnnotation = "controllers.aiven.io/auth-token"
+
 	deletionPolicyAnnotation = "controllers.aiven.io/deletion-policy"
 	deletionPolicyOrphan     = "Orphan"
 	deletionPolicyDelete     = "Delete"
+
+	dryRunAnnotation = "controllers.aiven.io/dry-run"
+
+	adoptionPolicyAnnotation     = "controllers.aiven.io/adoption-policy"
+	adoptionPolicyAdopt          = "Adopt"
+	adoptionPolicyAdoptIfMatches = "AdoptIfMatches"
+	adoptionPolicyFail           = "Fail"
 )
 
 type errCondition string
@@ -49,6 +68,7 @@ const (
 	errConditionPreconditions  errCondition = "Preconditions"
 	errConditionCreateOrUpdate errCondition = "CreateOrUpdate"
 	errConditionConnInfoSecret errCondition = "ConnInfoSecret"
+	errConditionAdoption       errCondition = "Adoption"
 )
 
 var (
@@ -171,6 +191,7 @@ func setConnectionSecretPublishPendingCondition(obj v1alpha1.AivenManagedObject)
 }
 
 func markConnectionSecretPublishFailed(obj v1alpha1.AivenManagedObject, err error) {
+	recordSecretPublishFailure(obj)
 	delete(obj.GetAnnotations(), instanceIsRunningAnnotation)
 	setConnectionSecretPublishPendingCondition(obj)
 	meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionConnInfoSecret, err))
@@ -181,6 +202,18 @@ func hasConnectionSecretPublishError(obj v1alpha1.AivenManagedObject) bool {
 	return cond != nil && cond.Reason == string(errConditionConnInfoSecret)
 }
 
+// markThrottled sets the Throttled condition when Aiven rate limits the requests and the retries are exhausted.
+// The reconcile is requeued instead of failing, so throttling doesn't cause more requests.
+func markThrottled(rec record.EventRecorder, obj v1alpha1.AivenManagedObject, err error) {
+	rec.Event(obj, corev1.EventTypeWarning, eventThrottledByAiven, err.Error())
+	meta.SetStatusCondition(obj.Conditions(), metav1.Condition{
+		Type:    conditionTypeThrottled,
+		Status:  metav1.ConditionTrue,
+		Reason:  "RateLimited",
+		Message: err.Error(),
+	})
+}
+
 func markInstanceNotReconciled(obj v1alpha1.AivenManagedObject) {
 	delete(obj.GetAnnotations(), instanceIsRunningAnnotation)
 	meta.SetStatusCondition(obj.Conditions(), getRunningCondition(metav1.ConditionFalse, "CheckRunning", "Instance is not reconciled on Aiven side"))
@@ -299,7 +332,15 @@ func userAgent(kubeVersion, operatorVersion string) string {
 
 // NewAivenGeneratedClient returns Aiven generated client client (aiven/go-client-codegen)
 func NewAivenGeneratedClient(token, kubeVersion, operatorVersion string) (avngen.Client, error) {
-	return avngen.NewClient(avngen.TokenOpt(token), avngen.UserAgentOpt(userAgent(kubeVersion, operatorVersion)))
+	c, err := avngen.NewClient(
+		avngen.TokenOpt(token),
+		avngen.UserAgentOpt(userAgent(kubeVersion, operatorVersion)),
+		avngen.DoerOpt(getAivenHTTPClient()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newMetricsClient(c), nil
 }
 
 func fromAnyPointer[T any](v *T) T {
@@ -342,6 +383,43 @@ func newSecret(o objWithSecret, stringData map[string]string, addPrefix bool) *c
 	}
 }
 
+// addPrivatelinkEndpointDetails adds the PRIVATELINK_HOST and PRIVATELINK_PORT of the primary component
+// when the service is reachable through a privatelink.
+func addPrivatelinkEndpointDetails(details map[string]string, components []service.ComponentOut, component, prefix string) {
+	for _, c := range components {
+		if c.Component == component && c.Route == service.RouteTypePrivatelink && c.Usage == service.UsageTypePrimary {
+			details[prefix+"PRIVATELINK_HOST"] = c.Host
+			details[prefix+"PRIVATELINK_PORT"] = strconv.Itoa(c.Port)
+			return
+		}
+	}
+}
+
+// applySecretTemplate adds the keys rendered from connInfoSecretTarget.template to the secret data.
+// The templates get the other keys of the secret.
+func applySecretTemplate(tmpl *v1alpha1.ConnInfoSecretTemplate, data map[string][]byte) error {
+	if tmpl == nil {
+		return nil
+	}
+
+	details := make(map[string]string, len(data))
+	for k, v := range data {
+		if _, ok := tmpl.Data[k]; !ok {
+			details[k] = string(v)
+		}
+	}
+
+	rendered, err := tmpl.Render(details)
+	if err != nil {
+		return fmt.Errorf("connInfoSecretTarget.template: %w", err)
+	}
+
+	for k, v := range rendered {
+		data[k] = []byte(v)
+	}
+	return nil
+}
+
 func connectionSecretName(o objWithSecret) string {
 	if target := o.GetConnInfoSecretTarget(); target.Name != "" {
 		return target.Name
@@ -359,6 +437,58 @@ func getSecretPrefix(o objWithSecret) string {
 	return strings.ToUpper(kind.GroupVersionKind().Kind) + "_"
 }
 
+// serviceWithSecret is a service resource that writes its connection details to a secret
+type serviceWithSecret interface {
+	client.Object
+	GetConnInfoSecretTarget() v1alpha1.ConnInfoSecretTarget
+	NoSecret() bool
+}
+
+// isServiceConnectionGone returns true if the service resource or its connection secret are gone,
+// so there is nothing left to clean up in the service.
+func isServiceConnectionGone(err error) bool {
+	return apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet)
+}
+
+// getServiceConnectionURI reads a URI from the connection secret of the service resource named after the service.
+// Used to connect to the service directly, e.g. with the admin credentials of a database.
+func getServiceConnectionURI(ctx context.Context, k8s client.Client, svc serviceWithSecret, kind, namespace, serviceName, key string) (*url.URL, error) {
+	if err := k8s.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, svc); err != nil {
+		return nil, fmt.Errorf("getting %s %s/%s: %w", kind, namespace, serviceName, err)
+	}
+
+	target := svc.GetConnInfoSecretTarget()
+	if svc.NoSecret() || !usesKubernetesSecretSink(svc) {
+		return nil, fmt.Errorf("%s %s/%s must write its connection details to a Kubernetes secret", kind, namespace, serviceName)
+	}
+
+	secret := &corev1.Secret{}
+	err := k8s.Get(ctx, types.NamespacedName{Name: connectionSecretName(svc), Namespace: namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: connection secret of %s %s/%s is not yet created", errPreconditionNotMet, kind, namespace, serviceName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting connection secret of %s %s/%s: %w", kind, namespace, serviceName, err)
+	}
+
+	// The kind of a fetched object is empty, so getSecretPrefix can't be used here.
+	prefix := target.Prefix
+	if prefix == "" {
+		prefix = strings.ToUpper(kind) + "_"
+	}
+
+	raw, ok := secret.Data[prefix+key]
+	if !ok {
+		return nil, fmt.Errorf("connection secret %s/%s has no %s%s key", namespace, secret.Name, prefix, key)
+	}
+
+	uri, err := url.Parse(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s service URI: %w", kind, err)
+	}
+	return uri, nil
+}
+
 // userConfigurationToAPI converts user config into a map
 func userConfigurationToAPI(c any, groups ...string) (map[string]any, error) {
 	if c == nil || (reflect.ValueOf(c).Kind() == reflect.Pointer && reflect.ValueOf(c).IsNil()) {
@@ -416,6 +546,11 @@ func isAivenError(err error, code int) bool {
 	return false
 }
 
+// isThrottled returns true if Aiven rejected the request because of the rate limit.
+func isThrottled(err error) bool {
+	return isAivenError(err, http.StatusTooManyRequests)
+}
+
 func isServerError(err error) bool {
 	var e avngen.Error
 	if errors.As(err, &e) {
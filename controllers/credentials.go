@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	credentialsKindCluster   = "AivenCredentials"
+	credentialsKindNamespace = "AivenNamespaceCredentials"
+)
+
+const eventUnableToUseCredentials = "UnableToUseCredentials"
+
+var errCredentialsNotAllowed = errors.New("namespace is not allowed to use the credentials")
+
+// +kubebuilder:rbac:groups=aiven.io,resources=aivencredentials;aivennamespacecredentials,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// resolveAuthSecret returns the secret with the Aiven token of the object and the key of the token in it.
+// credentialsRef takes precedence over authSecretRef.
+// Returns a nil secret when the object references neither.
+func (c *Controller) resolveAuthSecret(ctx context.Context, o v1alpha1.AivenManagedObject) (*corev1.Secret, string, error) {
+	var ref *v1alpha1.AuthSecretReference
+	namespace := o.GetNamespace()
+
+	if creds := o.CredentialsRef(); creds != nil {
+		secretRef, err := getCredentialsSecretRef(ctx, c.Client, namespace, creds)
+		if err != nil {
+			c.Recorder.Eventf(o, corev1.EventTypeWarning, eventUnableToUseCredentials, err.Error())
+			return nil, "", err
+		}
+		ref = &v1alpha1.AuthSecretReference{Name: secretRef.Name, Key: secretRef.Key}
+		namespace = secretRef.Namespace
+	} else if ref = o.AuthSecretRef(); ref == nil {
+		return nil, "", nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		c.Recorder.Eventf(o, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
+		return nil, "", fmt.Errorf("cannot get secret %q: %w", ref.Name, err)
+	}
+
+	return secret, ref.Key, nil
+}
+
+// authTokenFingerprint returns the fingerprint of the token the object is reconciled with:
+// the token of the token provider, the operator token or the auth secret token of the object.
+// Returns an empty string when the token can't be read.
+func (c *Controller) authTokenFingerprint(ctx context.Context, o v1alpha1.AivenManagedObject) string {
+	switch {
+	case c.TokenProvider != nil:
+		token, err := c.TokenProvider.Token(ctx)
+		if err != nil {
+			return ""
+		}
+		return tokenFingerprint([]byte(token))
+	case c.DefaultToken != "":
+		return tokenFingerprint([]byte(c.DefaultToken))
+	}
+
+	secret, key, err := c.resolveAuthSecret(ctx, o)
+	if err != nil || secret == nil {
+		return ""
+	}
+	return tokenFingerprint(secret.Data[key])
+}
+
+// tokenFingerprint identifies a token without exposing it
+func tokenFingerprint(token []byte) string {
+	sum := sha256.Sum256(token)
+	return hex.EncodeToString(sum[:8])
+}
+
+// markAuthToken records the auth secret token the object has been reconciled with.
+// OrganizationApplicationUserToken revokes the previous token once all objects that use its secret have the new one.
+func markAuthToken(o v1alpha1.AivenManagedObject, fingerprint string) {
+	if fingerprint != "" {
+		metav1.SetMetaDataAnnotation(o.GetObjectMeta(), authTokenAnnotation, fingerprint)
+	}
+}
+
+// authTokenChanged returns true when the object hasn't been reconciled with the token yet
+func authTokenChanged(o v1alpha1.AivenManagedObject, fingerprint string) bool {
+	return fingerprint != "" && o.GetAnnotations()[authTokenAnnotation] != fingerprint
+}
+
+// getCredentialsSecretRef returns the token secret of the credentials,
+// if the credentials can be used from the given namespace.
+func getCredentialsSecretRef(ctx context.Context, c client.Reader, namespace string, ref *v1alpha1.CredentialsReference) (*v1alpha1.CredentialsSecretReference, error) {
+	switch ref.Kind {
+	case credentialsKindNamespace:
+		creds := &v1alpha1.AivenNamespaceCredentials{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, creds); err != nil {
+			return nil, fmt.Errorf("cannot get %s %q: %w", ref.Kind, ref.Name, err)
+		}
+		return &v1alpha1.CredentialsSecretReference{
+			Name:      creds.Spec.SecretRef.Name,
+			Namespace: namespace,
+			Key:       creds.Spec.SecretRef.Key,
+		}, nil
+	case credentialsKindCluster, "":
+		creds := &v1alpha1.AivenCredentials{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, creds); err != nil {
+			return nil, fmt.Errorf("cannot get %s %q: %w", credentialsKindCluster, ref.Name, err)
+		}
+
+		allowed, err := credentialsAllowNamespace(ctx, c, creds, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("%w: %s %q, namespace %q", errCredentialsNotAllowed, credentialsKindCluster, ref.Name, namespace)
+		}
+		return &creds.Spec.SecretRef, nil
+	default:
+		return nil, fmt.Errorf("unknown credentials kind %q", ref.Kind)
+	}
+}
+
+// credentialsAllowNamespace checks the namespace selector of the credentials.
+// Without a selector, only the namespace of the secret is allowed.
+func credentialsAllowNamespace(ctx context.Context, c client.Reader, creds *v1alpha1.AivenCredentials, namespace string) (bool, error) {
+	if creds.Spec.NamespaceSelector == nil {
+		return namespace == creds.Spec.SecretRef.Namespace, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(creds.Spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespace selector of %s %q: %w", credentialsKindCluster, creds.Name, err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("cannot get namespace %q: %w", namespace, err)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -0,0 +1,210 @@
+package controllers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	yamlAivenCredentials = `
+apiVersion: aiven.io/v1alpha1
+kind: AivenCredentials
+metadata:
+  name: shared-token
+spec:
+  secretRef:
+    name: aiven-token
+    namespace: aiven-system
+    key: token
+  namespaceSelector:
+    matchLabels:
+      aiven.io/credentials: shared
+`
+
+	yamlAivenNamespaceCredentials = `
+apiVersion: aiven.io/v1alpha1
+kind: AivenNamespaceCredentials
+metadata:
+  name: team-token
+  namespace: default
+spec:
+  secretRef:
+    name: aiven-token
+    key: token
+`
+
+	yamlSharedAuthSecret = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: aiven-token
+  namespace: aiven-system
+data:
+  token: c2hhcmVkLXRva2Vu # gitleaks:allow
+`
+)
+
+func newTestNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestController_authTokenFingerprint(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	t.Run("Fingerprints the auth secret token", func(t *testing.T) {
+		c := &Controller{Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(newObjectFromYAML[corev1.Secret](t, yamlAuthSecret)).
+			Build()}
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth)
+
+		assert.Equal(t, tokenFingerprint([]byte("test-token")), c.authTokenFingerprint(t.Context(), obj))
+	})
+
+	t.Run("Fingerprints the operator token instead of the auth secret", func(t *testing.T) {
+		c := &Controller{DefaultToken: "default-token"}
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth)
+
+		assert.Equal(t, tokenFingerprint([]byte("default-token")), c.authTokenFingerprint(t.Context(), obj))
+	})
+
+	t.Run("Fingerprints the token of the token provider", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, []byte("provider-token"), 0o600))
+		provider, err := NewTokenProvider(TokenProviderOptions{File: path})
+		require.NoError(t, err)
+
+		c := &Controller{DefaultToken: "default-token", TokenProvider: provider}
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth)
+
+		assert.Equal(t, tokenFingerprint([]byte("provider-token")), c.authTokenFingerprint(t.Context(), obj))
+	})
+
+	t.Run("Empty when the token can't be read", func(t *testing.T) {
+		c := &Controller{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Recorder: record.NewFakeRecorder(10)}
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth)
+
+		assert.Empty(t, c.authTokenFingerprint(t.Context(), obj))
+	})
+}
+
+func TestController_resolveAuthSecret(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newUser := func(t *testing.T, kind, name string) *v1alpha1.ClickhouseUser {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth)
+		obj.Spec.CredentialsRef = &v1alpha1.CredentialsReference{Kind: kind, Name: name}
+		return obj
+	}
+
+	t.Run("Returns nil without references", func(t *testing.T) {
+		c := &Controller{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+
+		secret, key, err := c.resolveAuthSecret(t.Context(), obj)
+
+		require.NoError(t, err)
+		assert.Nil(t, secret)
+		assert.Empty(t, key)
+	})
+
+	t.Run("Uses AivenNamespaceCredentials from the same namespace", func(t *testing.T) {
+		c := &Controller{Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				newObjectFromYAML[v1alpha1.AivenNamespaceCredentials](t, yamlAivenNamespaceCredentials),
+				newObjectFromYAML[corev1.Secret](t, yamlAuthSecret),
+			).
+			Build()}
+
+		secret, key, err := c.resolveAuthSecret(t.Context(), newUser(t, credentialsKindNamespace, "team-token"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "test-token", string(secret.Data[key]))
+	})
+
+	t.Run("Uses AivenCredentials when the namespace matches the selector", func(t *testing.T) {
+		c := &Controller{Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials),
+				newObjectFromYAML[corev1.Secret](t, yamlSharedAuthSecret),
+				newObjectFromYAML[corev1.Secret](t, yamlAuthSecret),
+				newTestNamespace("default", map[string]string{"aiven.io/credentials": "shared"}),
+			).
+			Build()}
+
+		secret, key, err := c.resolveAuthSecret(t.Context(), newUser(t, "", "shared-token"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "shared-token", string(secret.Data[key]), "credentialsRef takes precedence over authSecretRef")
+		assert.Equal(t, "aiven-system", secret.Namespace)
+	})
+
+	t.Run("Denies AivenCredentials when the namespace does not match the selector", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		c := &Controller{
+			Client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials),
+					newObjectFromYAML[corev1.Secret](t, yamlSharedAuthSecret),
+					newTestNamespace("default", nil),
+				).
+				Build(),
+			Recorder: recorder,
+		}
+
+		secret, _, err := c.resolveAuthSecret(t.Context(), newUser(t, credentialsKindCluster, "shared-token"))
+
+		require.ErrorIs(t, err, errCredentialsNotAllowed)
+		assert.Nil(t, secret)
+		events := recorderEvents(recorder)
+		require.Len(t, events, 1)
+		assert.Contains(t, events[0], "Warning "+eventUnableToUseCredentials)
+	})
+
+	t.Run("Allows only the namespace of the secret without a selector", func(t *testing.T) {
+		creds := newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials)
+		creds.Spec.NamespaceSelector = nil
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(creds).Build()
+
+		allowed, err := credentialsAllowNamespace(t.Context(), k8sClient, creds, "aiven-system")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = credentialsAllowNamespace(t.Context(), k8sClient, creds, "default")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Allows all namespaces with an empty selector", func(t *testing.T) {
+		creds := newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials)
+		creds.Spec.NamespaceSelector = &metav1.LabelSelector{}
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newTestNamespace("default", nil)).Build()
+
+		allowed, err := credentialsAllowNamespace(t.Context(), k8sClient, creds, "default")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const eventDryRunPlannedAction = "DryRunPlannedAction"
+
+// plannedActionType is the change the reconciler would apply to Aiven if dry-run mode was off.
+type plannedActionType string
+
+const (
+	plannedActionNone   plannedActionType = "None"
+	plannedActionCreate plannedActionType = "Create"
+	plannedActionUpdate plannedActionType = "Update"
+	plannedActionDelete plannedActionType = "Delete"
+)
+
+// plannedAction is what the reconciler would do with the remote resource, plus the field-level diff when known.
+type plannedAction struct {
+	Action plannedActionType
+	Diff   []FieldDiff
+}
+
+func (p plannedAction) String() string {
+	if p.Action == plannedActionNone {
+		return "no changes planned, the resource is up to date"
+	}
+
+	msg := fmt.Sprintf("would %s the resource at Aiven", strings.ToLower(string(p.Action)))
+	if len(p.Diff) == 0 {
+		return msg
+	}
+	return msg + ": " + p.diffString()
+}
+
+// diffString renders the diff as `path: "actual" -> "desired"` pairs.
+func (p plannedAction) diffString() string {
+	changes := make([]string, 0, len(p.Diff))
+	for _, d := range p.Diff {
+		changes = append(changes, fmt.Sprintf("%s: %q -> %q", d.Path, d.Actual, d.Desired))
+	}
+	return strings.Join(changes, "; ")
+}
+
+// isDryRun reports whether changes to Aiven must be planned instead of applied.
+// The per-object annotation wins over the operator-wide default, so a single object can opt in or out.
+func isDryRun(o client.Object, operatorDefault bool) bool {
+	if v, ok := o.GetAnnotations()[dryRunAnnotation]; ok {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			return enabled
+		}
+	}
+	return operatorDefault
+}
+
+// recordPlannedAction stores the planned action in the Planned condition and emits an event when there is something to do.
+func recordPlannedAction(ctx context.Context, rec record.EventRecorder, obj v1alpha1.AivenManagedObject, plan plannedAction) {
+	status := metav1.ConditionTrue
+	if plan.Action == plannedActionNone {
+		status = metav1.ConditionFalse
+	}
+
+	msg := plan.String()
+	meta.SetStatusCondition(obj.Conditions(), metav1.Condition{
+		Type:    conditionTypePlanned,
+		Status:  status,
+		Reason:  string(plan.Action),
+		Message: msg,
+	})
+
+	logr.FromContextOrDiscard(ctx).Info("dry-run mode, changes are not applied", "action", plan.Action, "diff", plan.Diff)
+	if plan.Action != plannedActionNone {
+		rec.Event(obj, corev1.EventTypeNormal, eventDryRunPlannedAction, msg)
+	}
+}
+
+// diffFields compares desired and actual maps recursively and returns the fields that differ.
+// Only keys of desired are compared: fields that are not set in the spec are managed by Aiven.
+func diffFields(path string, desired, actual map[string]any) []FieldDiff {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var result []FieldDiff
+	for _, k := range keys {
+		p := path + "." + k
+		d, a := desired[k], actual[k]
+		dm, dIsMap := d.(map[string]any)
+		am, aIsMap := a.(map[string]any)
+		if dIsMap && (aIsMap || a == nil) {
+			result = append(result, diffFields(p, dm, am)...)
+			continue
+		}
+
+		ds, as := diffValue(d), diffValue(a)
+		if ds != as {
+			result = append(result, FieldDiff{Path: p, Desired: ds, Actual: as})
+		}
+	}
+	return result
+}
+
+// diffValue renders a value for FieldDiff, so numbers decoded as float64 and int compare equal.
+func diffValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestIsDryRun(t *testing.T) {
+	cases := []struct {
+		name            string
+		annotation      *string
+		operatorDefault bool
+		expected        bool
+	}{
+		{name: "operator default off", expected: false},
+		{name: "operator default on", operatorDefault: true, expected: true},
+		{name: "annotation enables", annotation: new("true"), expected: true},
+		{name: "annotation disables", annotation: new("false"), operatorDefault: true, expected: false},
+		{name: "invalid annotation is ignored", annotation: new("maybe"), operatorDefault: true, expected: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &v1alpha1.KafkaTopic{}
+			if tc.annotation != nil {
+				metav1.SetMetaDataAnnotation(&obj.ObjectMeta, dryRunAnnotation, *tc.annotation)
+			}
+			assert.Equal(t, tc.expected, isDryRun(obj, tc.operatorDefault))
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	desired := map[string]any{
+		"ip_filter": []any{"0.0.0.0/0"},
+		"kafka": map[string]any{
+			"auto_create_topics_enable": true,
+			"num_partitions":            3,
+		},
+		"kafka_version": "3.8",
+	}
+	actual := map[string]any{
+		"ip_filter": []any{"0.0.0.0/0"},
+		"kafka": map[string]any{
+			"auto_create_topics_enable": false,
+			"num_partitions":            float64(3),
+		},
+		"schema_registry": true,
+	}
+
+	assert.Equal(t, []FieldDiff{
+		{Path: "spec.userConfig.kafka.auto_create_topics_enable", Desired: "true", Actual: "false"},
+		{Path: "spec.userConfig.kafka_version", Desired: `"3.8"`, Actual: ""},
+	}, diffFields("spec.userConfig", desired, actual))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// flinkJarFileRoot is the directory in the operator pod with the JARs of the file source.
+	// The path of the source can't leave it, so the resources can't read other files of the operator.
+	flinkJarFileRoot = "/var/run/aiven-operator/flink-jars"
+
+	// flinkJarPVCRoot is the directory in the operator pod with the PersistentVolumeClaims of the pvc source,
+	// each mounted at <root>/<claimName>.
+	flinkJarPVCRoot = "/var/run/aiven-operator/pvc"
+)
+
+// flinkJarDownloadClient downloads the JARs of the url source.
+// It refuses the addresses inside the cluster or the cloud network, see checkFlinkJarAddress.
+// The check runs on every connection, so redirects to such addresses are refused too.
+var flinkJarDownloadClient = &http.Client{
+	Timeout: 30 * time.Minute,
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: 30 * time.Second,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				return checkFlinkJarAddress(address)
+			},
+		}).DialContext,
+	},
+}
+
+// checkFlinkJarAddress refuses loopback, link-local, e.g. the cloud metadata endpoints, and private addresses,
+// so the resources can't make the operator read the services of the cluster.
+func checkFlinkJarAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsPrivate() {
+		return fmt.Errorf("address %s is not allowed", address)
+	}
+	return nil
+}
+
+// flinkJarUploadClient uploads the JARs to Aiven.
+var flinkJarUploadClient = &http.Client{Timeout: 30 * time.Minute}
+
+// flinkJar is a JAR copied to a temporary file.
+type flinkJar struct {
+	file   *os.File
+	size   int64
+	sha256 string
+}
+
+func (j *flinkJar) Close() error {
+	return errors.Join(j.file.Close(), os.Remove(j.file.Name()))
+}
+
+// fetchFlinkJar copies the JAR from the source to a temporary file.
+// Uploads need the size of the file in advance, which a download doesn't always have.
+func fetchFlinkJar(ctx context.Context, k8s client.Reader, namespace string, src v1alpha1.FlinkJarSource) (*flinkJar, error) {
+	r, err := openFlinkJarSource(ctx, k8s, namespace, src)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := os.CreateTemp("", "flink-*.jar")
+	if err != nil {
+		return nil, err
+	}
+	jar := &flinkJar{file: f}
+
+	h := sha256.New()
+	jar.size, err = io.Copy(io.MultiWriter(f, h), r)
+	if err == nil {
+		jar.sha256 = hex.EncodeToString(h.Sum(nil))
+		_, err = f.Seek(0, io.SeekStart)
+	}
+
+	switch {
+	case err != nil:
+	case jar.size == 0:
+		err = errors.New("the JAR is empty")
+	case src.URL != nil && src.URL.SHA256 != "" && src.URL.SHA256 != jar.sha256:
+		err = fmt.Errorf("the JAR checksum %s doesn't match %s", jar.sha256, src.URL.SHA256)
+	}
+	if err != nil {
+		return nil, errors.Join(err, jar.Close())
+	}
+	return jar, nil
+}
+
+func openFlinkJarSource(ctx context.Context, k8s client.Reader, namespace string, src v1alpha1.FlinkJarSource) (io.ReadCloser, error) {
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := k8s.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, cm); err != nil {
+			return nil, fmt.Errorf("cannot get ConfigMap %q: %w", ref.Name, err)
+		}
+		data, ok := cm.BinaryData[ref.Key]
+		if !ok {
+			s, ok := cm.Data[ref.Key]
+			if !ok {
+				return nil, fmt.Errorf("ConfigMap %q has no key %q", ref.Name, ref.Key)
+			}
+			data = []byte(s)
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	case src.File != nil:
+		return os.Open(flinkJarPath(flinkJarFileRoot, src.File.Path))
+	case src.PVC != nil:
+		return os.Open(flinkJarPath(filepath.Join(flinkJarPVCRoot, src.PVC.ClaimName), src.PVC.Path))
+	case src.URL != nil:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := flinkJarDownloadClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("cannot download the JAR: %w", err)
+		}
+		if rsp.StatusCode != http.StatusOK {
+			rsp.Body.Close()
+			return nil, fmt.Errorf("cannot download the JAR: status %d", rsp.StatusCode)
+		}
+		return rsp.Body, nil
+	}
+	return nil, errors.New("the JAR source is not set")
+}
+
+// flinkJarPath joins the path to the root. The path is cleaned as an absolute one, so it can't leave the root.
+func flinkJarPath(root, path string) string {
+	return filepath.Join(root, filepath.Clean("/"+path))
+}
+
+// uploadFlinkJar uploads the JAR to the upload URL of an application version.
+func uploadFlinkJar(ctx context.Context, uploadURL string, jar *flinkJar) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, jar.file)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = jar.size
+
+	rsp, err := flinkJarUploadClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot upload the JAR: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("cannot upload the JAR: status %d: %s", rsp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
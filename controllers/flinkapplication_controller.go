@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkapplication"
+	"github.com/aiven/go-client-codegen/handler/flinkapplicationversion"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications/finalizers,verbs=get;create;update
+
+// FlinkApplicationController reconciles a FlinkApplication object.
+type FlinkApplicationController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newFlinkApplicationReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.FlinkApplication] {
+			return &FlinkApplicationController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *FlinkApplicationController) Observe(ctx context.Context, app *v1alpha1.FlinkApplication) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, app.Spec.Project, app.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.getApplication(ctx, app)
+	switch {
+	case isNotFound(err):
+		return Observation{ResourceExists: false}, nil
+	case err != nil:
+		return Observation{}, fmt.Errorf("describing Flink application: %w", err)
+	}
+
+	app.Status.ApplicationID = out.Id
+	if latest := latestFlinkApplicationVersion(out.ApplicationVersions); latest != nil {
+		app.Status.Version = latest.Version
+		app.Status.VersionID = latest.Id
+	}
+	markInstanceRunning(app)
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(app) && out.Name == app.GetApplicationName(),
+	}, nil
+}
+
+// getApplication gets the application by Status.ApplicationID, or finds it by name when the ID is not known yet.
+func (r *FlinkApplicationController) getApplication(ctx context.Context, app *v1alpha1.FlinkApplication) (*flinkapplication.ServiceFlinkGetApplicationOut, error) {
+	id := app.Status.ApplicationID
+	if id == "" {
+		list, err := r.avnGen.ServiceFlinkListApplications(ctx, app.Spec.Project, app.Spec.ServiceName)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range list {
+			if a.Name == app.GetApplicationName() {
+				id = a.Id
+				break
+			}
+		}
+		if id == "" {
+			return nil, NewNotFound(fmt.Sprintf("Flink application %q not found", app.GetApplicationName()))
+		}
+	}
+	return r.avnGen.ServiceFlinkGetApplication(ctx, app.Spec.Project, app.Spec.ServiceName, id)
+}
+
+func (r *FlinkApplicationController) Create(ctx context.Context, app *v1alpha1.FlinkApplication) (CreateResult, error) {
+	delete(app.GetAnnotations(), instanceIsRunningAnnotation)
+
+	in := &flinkapplication.ServiceFlinkCreateApplicationIn{
+		Name: app.GetApplicationName(),
+		ApplicationVersion: &flinkapplication.ApplicationVersionIn{
+			Statement: app.Spec.Statement,
+			Sinks: flinkApplicationTables(app.Spec.Sinks, func(t string, id *string) flinkapplication.SinkIn {
+				return flinkapplication.SinkIn{CreateTable: t, IntegrationId: id}
+			}),
+			Sources: flinkApplicationTables(app.Spec.Sources, func(t string, id *string) flinkapplication.SourceIn {
+				return flinkapplication.SourceIn{CreateTable: t, IntegrationId: id}
+			}),
+		},
+	}
+	out, err := r.avnGen.ServiceFlinkCreateApplication(ctx, app.Spec.Project, app.Spec.ServiceName, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create Flink application on Aiven side: %w", err)
+	}
+
+	app.Status.ApplicationID = out.Id
+	if latest := latestFlinkApplicationVersion(out.ApplicationVersions); latest != nil {
+		app.Status.Version = latest.Version
+		app.Status.VersionID = latest.Id
+	}
+
+	const reason = "Created"
+	meta.SetStatusCondition(&app.Status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&app.Status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, nil
+}
+
+// Update renames the application and creates a new application version when the statement, sinks or sources change.
+func (r *FlinkApplicationController) Update(ctx context.Context, app *v1alpha1.FlinkApplication) (UpdateResult, error) {
+	out, err := r.avnGen.ServiceFlinkGetApplication(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+
+	if out.Name != app.GetApplicationName() {
+		in := &flinkapplication.ServiceFlinkUpdateApplicationIn{Name: app.GetApplicationName()}
+		if _, err := r.avnGen.ServiceFlinkUpdateApplication(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, in); err != nil {
+			return UpdateResult{}, fmt.Errorf("cannot update Flink application: %w", err)
+		}
+	}
+
+	latest := latestFlinkApplicationVersion(out.ApplicationVersions)
+	if latest != nil && flinkApplicationVersionEqual(app, latest) {
+		return UpdateResult{}, nil
+	}
+
+	in := &flinkapplicationversion.ServiceFlinkCreateApplicationVersionIn{
+		Statement: app.Spec.Statement,
+		Sinks: flinkApplicationTables(app.Spec.Sinks, func(t string, id *string) flinkapplicationversion.SinkIn {
+			return flinkapplicationversion.SinkIn{CreateTable: t, IntegrationId: id}
+		}),
+		Sources: flinkApplicationTables(app.Spec.Sources, func(t string, id *string) flinkapplicationversion.SourceIn {
+			return flinkapplicationversion.SourceIn{CreateTable: t, IntegrationId: id}
+		}),
+	}
+	version, err := r.avnGen.ServiceFlinkCreateApplicationVersion(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, in)
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("cannot create Flink application version: %w", err)
+	}
+
+	app.Status.Version = version.Version
+	app.Status.VersionID = version.Id
+	return UpdateResult{}, nil
+}
+
+func (r *FlinkApplicationController) Delete(ctx context.Context, app *v1alpha1.FlinkApplication) error {
+	if app.Status.ApplicationID == "" {
+		return nil
+	}
+	_, err := r.avnGen.ServiceFlinkDeleteApplication(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// flinkApplicationTables converts the tables to the sinks or sources of a request.
+func flinkApplicationTables[T any](tables []v1alpha1.FlinkApplicationTable, newTable func(createTable string, integrationID *string) T) []T {
+	res := make([]T, 0, len(tables))
+	for _, t := range tables {
+		res = append(res, newTable(t.CreateTable, NilIfZero(t.IntegrationID)))
+	}
+	return res
+}
+
+// latestFlinkApplicationVersion returns the version with the highest number.
+func latestFlinkApplicationVersion(versions []flinkapplication.ApplicationVersionOut) *flinkapplication.ApplicationVersionOut {
+	var latest *flinkapplication.ApplicationVersionOut
+	for i := range versions {
+		if latest == nil || versions[i].Version > latest.Version {
+			latest = &versions[i]
+		}
+	}
+	return latest
+}
+
+// flinkApplicationVersionEqual returns true when the version has the statement, sinks and sources of the spec.
+func flinkApplicationVersionEqual(app *v1alpha1.FlinkApplication, v *flinkapplication.ApplicationVersionOut) bool {
+	if v.Statement != app.Spec.Statement || len(v.Sinks) != len(app.Spec.Sinks) || len(v.Sources) != len(app.Spec.Sources) {
+		return false
+	}
+	for i, s := range v.Sinks {
+		if s.CreateTable != app.Spec.Sinks[i].CreateTable || fromAnyPointer(s.IntegrationId) != app.Spec.Sinks[i].IntegrationID {
+			return false
+		}
+	}
+	for i, s := range v.Sources {
+		if s.CreateTable != app.Spec.Sources[i].CreateTable || fromAnyPointer(s.IntegrationId) != app.Spec.Sources[i].IntegrationID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkapplication"
+	"github.com/aiven/go-client-codegen/handler/flinkapplicationversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFlinkApplicationController(t *testing.T) {
+	t.Parallel()
+
+	newApplication := func(t *testing.T) *v1alpha1.FlinkApplication {
+		t.Helper()
+		app := newObjectFromExampleYAML[v1alpha1.FlinkApplication](t, "flinkapplication")
+		app.Namespace = "default"
+		return app
+	}
+
+	currentVersion := func(app *v1alpha1.FlinkApplication) flinkapplication.ApplicationVersionOut {
+		v := flinkapplication.ApplicationVersionOut{Id: "version-2", Version: 2, Statement: app.Spec.Statement}
+		for _, s := range app.Spec.Sinks {
+			v.Sinks = append(v.Sinks, flinkapplication.SinkOut{CreateTable: s.CreateTable, IntegrationId: NilIfZero(s.IntegrationID)})
+		}
+		for _, s := range app.Spec.Sources {
+			v.Sources = append(v.Sources, flinkapplication.SourceOut{CreateTable: s.CreateTable, IntegrationId: NilIfZero(s.IntegrationID)})
+		}
+		return v
+	}
+
+	t.Run("Observe finds the application by name and records the latest version", func(t *testing.T) {
+		app := newApplication(t)
+		app.Generation = 1
+		app.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, app.Spec.Project, app.Spec.ServiceName, mock.Anything).
+			Return(newRunningService(), nil).Once()
+		avn.EXPECT().
+			ServiceFlinkListApplications(mock.Anything, app.Spec.Project, app.Spec.ServiceName).
+			Return([]flinkapplication.ApplicationOut{{Id: "other", Name: "other"}, {Id: "app-id", Name: app.Name}}, nil).Once()
+		avn.EXPECT().
+			ServiceFlinkGetApplication(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id").
+			Return(&flinkapplication.ServiceFlinkGetApplicationOut{
+				Id:   "app-id",
+				Name: app.Name,
+				ApplicationVersions: []flinkapplication.ApplicationVersionOut{
+					currentVersion(app),
+					{Id: "version-1", Version: 1},
+				},
+			}, nil).Once()
+
+		ctrl := &FlinkApplicationController{avnGen: avn}
+
+		obs, err := ctrl.Observe(t.Context(), app)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+		assert.Equal(t, "app-id", app.Status.ApplicationID)
+		assert.Equal(t, 2, app.Status.Version)
+		assert.Equal(t, "version-2", app.Status.VersionID)
+	})
+
+	t.Run("Observe reports a missing application", func(t *testing.T) {
+		app := newApplication(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, app.Spec.Project, app.Spec.ServiceName, mock.Anything).
+			Return(newRunningService(), nil).Once()
+		avn.EXPECT().
+			ServiceFlinkListApplications(mock.Anything, app.Spec.Project, app.Spec.ServiceName).
+			Return(nil, nil).Once()
+
+		ctrl := &FlinkApplicationController{avnGen: avn}
+
+		obs, err := ctrl.Observe(t.Context(), app)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: false}, obs)
+	})
+
+	t.Run("Update doesn't create a version when the statement, sinks and sources are the same", func(t *testing.T) {
+		app := newApplication(t)
+		app.Status.ApplicationID = "app-id"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceFlinkGetApplication(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id").
+			Return(&flinkapplication.ServiceFlinkGetApplicationOut{
+				Id:                  "app-id",
+				Name:                app.Name,
+				ApplicationVersions: []flinkapplication.ApplicationVersionOut{currentVersion(app)},
+			}, nil).Once()
+
+		ctrl := &FlinkApplicationController{avnGen: avn}
+
+		_, err := ctrl.Update(t.Context(), app)
+		require.NoError(t, err)
+	})
+
+	t.Run("Update creates a new version when the statement changes", func(t *testing.T) {
+		app := newApplication(t)
+		app.Status.ApplicationID = "app-id"
+		version := currentVersion(app)
+		app.Spec.Statement = "INSERT INTO sink_table SELECT * FROM source_table"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceFlinkGetApplication(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id").
+			Return(&flinkapplication.ServiceFlinkGetApplicationOut{
+				Id:                  "app-id",
+				Name:                app.Name,
+				ApplicationVersions: []flinkapplication.ApplicationVersionOut{version},
+			}, nil).Once()
+		avn.EXPECT().
+			ServiceFlinkCreateApplicationVersion(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id",
+				mock.MatchedBy(func(in *flinkapplicationversion.ServiceFlinkCreateApplicationVersionIn) bool {
+					return in.Statement == app.Spec.Statement && len(in.Sinks) == 1 && len(in.Sources) == 1
+				})).
+			Return(&flinkapplicationversion.ServiceFlinkCreateApplicationVersionOut{Id: "version-3", Version: 3}, nil).Once()
+
+		ctrl := &FlinkApplicationController{avnGen: avn}
+
+		_, err := ctrl.Update(t.Context(), app)
+		require.NoError(t, err)
+		assert.Equal(t, 3, app.Status.Version)
+		assert.Equal(t, "version-3", app.Status.VersionID)
+	})
+}
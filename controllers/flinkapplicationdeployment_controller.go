@@ -0,0 +1,258 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkapplicationdeployment"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplicationdeployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplicationdeployments/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplicationdeployments/finalizers,verbs=get;create;update
+
+// Flink job states reported by Aiven.
+const (
+	flinkJobStateRunning = "RUNNING"
+)
+
+// flinkJobStoppedStates are the states of a job that is no longer running.
+// Aiven runs one deployment of an application at a time, so a new deployment waits for the job to reach one of them.
+var flinkJobStoppedStates = map[string]bool{
+	"CANCELED":  true,
+	"FAILED":    true,
+	"FINISHED":  true,
+	"SUSPENDED": true,
+}
+
+// flinkJobStoppingStates are the states of a job that is being canceled, stopped or deleted.
+var flinkJobStoppingStates = map[string]bool{
+	"CANCELLING_REQUESTED":      true,
+	"CANCELLING":                true,
+	"SAVING_AND_STOP_REQUESTED": true,
+	"SAVING_AND_STOP":           true,
+	"DELETE_REQUESTED":          true,
+	"DELETING":                  true,
+}
+
+// FlinkApplicationDeploymentController reconciles a FlinkApplicationDeployment object.
+type FlinkApplicationDeploymentController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newFlinkApplicationDeploymentReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.FlinkApplicationDeployment] {
+			return &FlinkApplicationDeploymentController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *FlinkApplicationDeploymentController) Observe(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, d.Spec.Project, d.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	app, err := r.getApplication(ctx, d)
+	if err != nil {
+		return Observation{}, err
+	}
+	d.Status.ApplicationID = app.Status.ApplicationID
+
+	if d.Status.DeploymentID == "" {
+		// Picks up the deployment created before the status was saved.
+		out, err := r.avnGen.ServiceFlinkGetApplication(ctx, d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID)
+		if err != nil {
+			return Observation{}, fmt.Errorf("describing Flink application: %w", err)
+		}
+		if out.CurrentDeployment == nil {
+			return Observation{ResourceExists: false}, nil
+		}
+		d.Status.DeploymentID = out.CurrentDeployment.Id
+	}
+
+	out, err := r.avnGen.ServiceFlinkGetApplicationDeployment(ctx, d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID, d.Status.DeploymentID)
+	switch {
+	case isNotFound(err):
+		d.Status.DeploymentID = ""
+		return Observation{ResourceExists: false}, nil
+	case err != nil:
+		return Observation{}, fmt.Errorf("describing Flink application deployment: %w", err)
+	}
+
+	setFlinkApplicationDeploymentStatus(&d.Status.FlinkJobStatus, out)
+	switch d.Status.State {
+	case flinkJobStateRunning:
+		markInstanceRunning(d)
+	default:
+		meta.SetStatusCondition(&d.Status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", fmt.Sprintf("Flink job is %s", d.Status.State)))
+	}
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(d) && d.Status.VersionID == app.Status.VersionID,
+	}, nil
+}
+
+// getApplication returns the referenced FlinkApplication once it is created in Aiven.
+func (r *FlinkApplicationDeploymentController) getApplication(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment) (*v1alpha1.FlinkApplication, error) {
+	app := &v1alpha1.FlinkApplication{}
+	key := types.NamespacedName{Name: d.Spec.ApplicationRef.Name, Namespace: d.Namespace}
+	if err := r.Get(ctx, key, app); err != nil {
+		return nil, fmt.Errorf("cannot get FlinkApplication %q: %w", key.Name, err)
+	}
+	if app.Status.ApplicationID == "" || app.Status.VersionID == "" {
+		return nil, fmt.Errorf("%w: FlinkApplication %q is not created yet", errPreconditionNotMet, key.Name)
+	}
+	return app, nil
+}
+
+func (r *FlinkApplicationDeploymentController) Create(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment) (CreateResult, error) {
+	delete(d.GetAnnotations(), instanceIsRunningAnnotation)
+
+	app, err := r.getApplication(ctx, d)
+	if err != nil {
+		return CreateResult{}, err
+	}
+	if err := r.deploy(ctx, d, app, d.Spec.StartingSavepoint); err != nil {
+		return CreateResult{}, err
+	}
+
+	const reason = "Created"
+	meta.SetStatusCondition(&d.Status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&d.Status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, nil
+}
+
+// Update redeploys the application: stops the running job, then creates a new deployment of the latest version.
+// With restartFromSavepoint, the job is stopped with a savepoint and the new deployment starts from it.
+func (r *FlinkApplicationDeploymentController) Update(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment) (UpdateResult, error) {
+	app, err := r.getApplication(ctx, d)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+
+	stopped, err := r.stopJob(ctx, d, !d.IsRestartFromSavepoint())
+	if err != nil {
+		return UpdateResult{}, err
+	}
+	if !stopped {
+		return UpdateResult{}, fmt.Errorf("%w: waiting for Flink job %q to stop", errPreconditionNotMet, d.Status.JobID)
+	}
+
+	savepoint := ""
+	if d.IsRestartFromSavepoint() {
+		savepoint = d.Status.LastSavepoint
+	}
+	return UpdateResult{}, r.deploy(ctx, d, app, savepoint)
+}
+
+func (r *FlinkApplicationDeploymentController) Delete(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment) error {
+	if d.Status.ApplicationID == "" || d.Status.DeploymentID == "" {
+		return nil
+	}
+
+	stopped, err := r.stopJob(ctx, d, d.Spec.CancelOnDelete)
+	if err != nil {
+		return err
+	}
+	if !stopped {
+		return fmt.Errorf("%w: waiting for Flink job %q to stop", errDeletionInProgress, d.Status.JobID)
+	}
+
+	_, err = r.avnGen.ServiceFlinkDeleteApplicationDeployment(ctx, d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID, d.Status.DeploymentID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deploy creates a new deployment of the latest application version.
+func (r *FlinkApplicationDeploymentController) deploy(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment, app *v1alpha1.FlinkApplication, savepoint string) error {
+	in := &flinkapplicationdeployment.ServiceFlinkCreateApplicationDeploymentIn{
+		VersionId:         app.Status.VersionID,
+		Parallelism:       NilIfZero(d.Spec.Parallelism),
+		RestartEnabled:    d.Spec.RestartEnabled,
+		StartingSavepoint: NilIfZero(savepoint),
+	}
+	out, err := r.avnGen.ServiceFlinkCreateApplicationDeployment(ctx, d.Spec.Project, d.Spec.ServiceName, app.Status.ApplicationID, in)
+	if err != nil {
+		return fmt.Errorf("cannot create Flink application deployment: %w", err)
+	}
+
+	d.Status.ApplicationID = app.Status.ApplicationID
+	d.Status.DeploymentID = out.Id
+	d.Status.VersionID = out.VersionId
+	d.Status.JobID = fromAnyPointer(out.JobId)
+	d.Status.State = string(out.Status)
+	d.Status.Error = ""
+	return nil
+}
+
+// stopJob cancels the job, or stops it with a savepoint, unless the job is already stopping.
+// Returns true when the job is no longer running or the deployment is gone.
+func (r *FlinkApplicationDeploymentController) stopJob(ctx context.Context, d *v1alpha1.FlinkApplicationDeployment, cancel bool) (bool, error) {
+	if d.Status.DeploymentID == "" {
+		return true, nil
+	}
+
+	project, service, appID, deploymentID := d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID, d.Status.DeploymentID
+	out, err := r.avnGen.ServiceFlinkGetApplicationDeployment(ctx, project, service, appID, deploymentID)
+	switch {
+	case isNotFound(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+
+	setFlinkApplicationDeploymentStatus(&d.Status.FlinkJobStatus, out)
+	return stopFlinkJob(d.Status.State, func() error {
+		if cancel {
+			_, err := r.avnGen.ServiceFlinkCancelApplicationDeployment(ctx, project, service, appID, deploymentID)
+			return err
+		}
+		_, err := r.avnGen.ServiceFlinkStopApplicationDeployment(ctx, project, service, appID, deploymentID)
+		return err
+	})
+}
+
+// stopFlinkJob calls stop unless the job in the given state is already stopped or stopping.
+// Returns true when the job is no longer running.
+func stopFlinkJob(state string, stop func() error) (bool, error) {
+	switch {
+	case flinkJobStoppedStates[state]:
+		return true, nil
+	case flinkJobStoppingStates[state]:
+		return false, nil
+	}
+	if err := stop(); err != nil && !isNotFound(err) {
+		return false, fmt.Errorf("cannot stop Flink job: %w", err)
+	}
+	return false, nil
+}
+
+func setFlinkApplicationDeploymentStatus(s *v1alpha1.FlinkJobStatus, out *flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut) {
+	s.DeploymentID = out.Id
+	s.VersionID = out.VersionId
+	s.JobID = fromAnyPointer(out.JobId)
+	s.State = string(out.Status)
+	s.Error = fromAnyPointer(out.ErrorMsg)
+
+	// Keeps the last known savepoint when the job is restarted without one.
+	if savepoint := fromAnyPointer(out.LastSavepoint); savepoint != "" {
+		s.LastSavepoint = savepoint
+	}
+}
@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkapplication"
+	"github.com/aiven/go-client-codegen/handler/flinkapplicationdeployment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFlinkApplicationDeploymentController(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newDeployment := func(t *testing.T) *v1alpha1.FlinkApplicationDeployment {
+		t.Helper()
+		d := newObjectFromExampleYAML[v1alpha1.FlinkApplicationDeployment](t, "flinkapplicationdeployment")
+		d.Namespace = "default"
+		return d
+	}
+
+	newController := func(t *testing.T, avn avngen.Client) *FlinkApplicationDeploymentController {
+		t.Helper()
+		app := newObjectFromExampleYAML[v1alpha1.FlinkApplication](t, "flinkapplication")
+		app.Namespace = "default"
+		app.Status.ApplicationID = "app-id"
+		app.Status.VersionID = "version-2"
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).Build()
+		return &FlinkApplicationDeploymentController{Client: k8sClient, avnGen: avn}
+	}
+
+	expectDeployment := func(avn *avngen.MockClient, d *v1alpha1.FlinkApplicationDeployment, out *flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut) {
+		avn.EXPECT().
+			ServiceFlinkGetApplicationDeployment(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id", "deployment-1").
+			Return(out, nil).Once()
+	}
+
+	t.Run("Observe picks up the current deployment and reports a new application version", func(t *testing.T) {
+		d := newDeployment(t)
+		d.Generation = 1
+		d.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, d.Spec.Project, d.Spec.ServiceName, mock.Anything).
+			Return(newRunningService(), nil).Once()
+		avn.EXPECT().
+			ServiceFlinkGetApplication(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id").
+			Return(&flinkapplication.ServiceFlinkGetApplicationOut{
+				Id:                "app-id",
+				CurrentDeployment: &flinkapplication.CurrentDeploymentOut{Id: "deployment-1"},
+			}, nil).Once()
+		expectDeployment(avn, d, &flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut{
+			Id:            "deployment-1",
+			JobId:         NilIfZero("job-1"),
+			LastSavepoint: NilIfZero("s3://savepoints/1"),
+			Status:        flinkapplicationdeployment.DeploymentStatusTypeRunning,
+			VersionId:     "version-1",
+		})
+
+		obs, err := newController(t, avn).Observe(t.Context(), d)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: false}, obs)
+		assert.Equal(t, "deployment-1", d.Status.DeploymentID)
+		assert.Equal(t, "job-1", d.Status.JobID)
+		assert.Equal(t, "RUNNING", d.Status.State)
+		assert.Equal(t, "s3://savepoints/1", d.Status.LastSavepoint)
+		assert.Equal(t, "true", d.Annotations[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Update stops the running job with a savepoint and waits", func(t *testing.T) {
+		d := newDeployment(t)
+		d.Status.ApplicationID = "app-id"
+		d.Status.DeploymentID = "deployment-1"
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, d, &flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut{
+			Id:     "deployment-1",
+			Status: flinkapplicationdeployment.DeploymentStatusTypeRunning,
+		})
+		avn.EXPECT().
+			ServiceFlinkStopApplicationDeployment(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id", "deployment-1").
+			Return(&flinkapplicationdeployment.ServiceFlinkStopApplicationDeploymentOut{}, nil).Once()
+
+		_, err := newController(t, avn).Update(t.Context(), d)
+		require.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Update restarts the latest version from the last savepoint", func(t *testing.T) {
+		d := newDeployment(t)
+		d.Status.ApplicationID = "app-id"
+		d.Status.DeploymentID = "deployment-1"
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, d, &flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut{
+			Id:            "deployment-1",
+			LastSavepoint: NilIfZero("s3://savepoints/2"),
+			Status:        flinkapplicationdeployment.DeploymentStatusTypeFinished,
+			VersionId:     "version-1",
+		})
+		avn.EXPECT().
+			ServiceFlinkCreateApplicationDeployment(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id",
+				mock.MatchedBy(func(in *flinkapplicationdeployment.ServiceFlinkCreateApplicationDeploymentIn) bool {
+					return in.VersionId == "version-2" &&
+						fromAnyPointer(in.StartingSavepoint) == "s3://savepoints/2" &&
+						fromAnyPointer(in.Parallelism) == 2
+				})).
+			Return(&flinkapplicationdeployment.ServiceFlinkCreateApplicationDeploymentOut{
+				Id:        "deployment-2",
+				Status:    flinkapplicationdeployment.DeploymentStatusTypeInitializing,
+				VersionId: "version-2",
+			}, nil).Once()
+
+		_, err := newController(t, avn).Update(t.Context(), d)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment-2", d.Status.DeploymentID)
+		assert.Equal(t, "version-2", d.Status.VersionID)
+		assert.Equal(t, "INITIALIZING", d.Status.State)
+	})
+
+	t.Run("Delete cancels the job with cancelOnDelete and deletes the deployment once it's canceled", func(t *testing.T) {
+		d := newDeployment(t)
+		d.Spec.CancelOnDelete = true
+		d.Status.ApplicationID = "app-id"
+		d.Status.DeploymentID = "deployment-1"
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, d, &flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut{
+			Id:     "deployment-1",
+			Status: flinkapplicationdeployment.DeploymentStatusTypeRunning,
+		})
+		avn.EXPECT().
+			ServiceFlinkCancelApplicationDeployment(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id", "deployment-1").
+			Return(&flinkapplicationdeployment.ServiceFlinkCancelApplicationDeploymentOut{}, nil).Once()
+
+		ctrl := newController(t, avn)
+		require.ErrorIs(t, ctrl.Delete(t.Context(), d), errDeletionInProgress)
+
+		expectDeployment(avn, d, &flinkapplicationdeployment.ServiceFlinkGetApplicationDeploymentOut{
+			Id:     "deployment-1",
+			Status: flinkapplicationdeployment.DeploymentStatusTypeCanceled,
+		})
+		avn.EXPECT().
+			ServiceFlinkDeleteApplicationDeployment(mock.Anything, d.Spec.Project, d.Spec.ServiceName, "app-id", "deployment-1").
+			Return(&flinkapplicationdeployment.ServiceFlinkDeleteApplicationDeploymentOut{}, nil).Once()
+
+		require.NoError(t, ctrl.Delete(t.Context(), d))
+	})
+}
@@ -0,0 +1,352 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkjarapplication"
+	"github.com/aiven/go-client-codegen/handler/flinkjarapplicationdeployment"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkjarapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkjarapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkjarapplications/finalizers,verbs=get;create;update
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// Aiven verifies an uploaded JAR before it can be deployed.
+const (
+	flinkJarFileStatusReady  = "READY"
+	flinkJarFileStatusFailed = "FAILED"
+)
+
+// FlinkJarApplicationController reconciles a FlinkJarApplication object.
+type FlinkJarApplicationController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newFlinkJarApplicationReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.FlinkJarApplication] {
+			return &FlinkJarApplicationController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *FlinkJarApplicationController) Observe(ctx context.Context, app *v1alpha1.FlinkJarApplication) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, app.Spec.Project, app.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.getApplication(ctx, app)
+	switch {
+	case isNotFound(err):
+		return Observation{ResourceExists: false}, nil
+	case err != nil:
+		return Observation{}, fmt.Errorf("describing Flink JAR application: %w", err)
+	}
+	app.Status.ApplicationID = out.Id
+
+	if dep := app.Status.Deployment; dep != nil && dep.DeploymentID != "" {
+		d, err := r.avnGen.ServiceFlinkGetJarApplicationDeployment(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, dep.DeploymentID)
+		switch {
+		case isNotFound(err):
+			app.Status.Deployment = nil
+		case err != nil:
+			return Observation{}, fmt.Errorf("describing Flink JAR application deployment: %w", err)
+		default:
+			setFlinkJarApplicationDeploymentStatus(&dep.FlinkJobStatus, d)
+		}
+	}
+
+	switch dep := app.Status.Deployment; {
+	case app.Spec.Deployment == nil, dep != nil && dep.State == flinkJobStateRunning:
+		markInstanceRunning(app)
+	case dep == nil:
+		meta.SetStatusCondition(&app.Status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", "Flink job is not deployed"))
+	default:
+		meta.SetStatusCondition(&app.Status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", fmt.Sprintf("Flink job is %s", dep.State)))
+	}
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(app) && out.Name == app.GetApplicationName(),
+	}, nil
+}
+
+// getApplication gets the application by Status.ApplicationID, or finds it by name when the ID is not known yet.
+func (r *FlinkJarApplicationController) getApplication(ctx context.Context, app *v1alpha1.FlinkJarApplication) (*flinkjarapplication.ServiceFlinkGetJarApplicationOut, error) {
+	id := app.Status.ApplicationID
+	if id == "" {
+		list, err := r.avnGen.ServiceFlinkListJarApplications(ctx, app.Spec.Project, app.Spec.ServiceName)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range list {
+			if a.Name == app.GetApplicationName() {
+				id = a.Id
+				break
+			}
+		}
+		if id == "" {
+			return nil, NewNotFound(fmt.Sprintf("Flink JAR application %q not found", app.GetApplicationName()))
+		}
+	}
+	return r.avnGen.ServiceFlinkGetJarApplication(ctx, app.Spec.Project, app.Spec.ServiceName, id)
+}
+
+func (r *FlinkJarApplicationController) Create(ctx context.Context, app *v1alpha1.FlinkJarApplication) (CreateResult, error) {
+	delete(app.GetAnnotations(), instanceIsRunningAnnotation)
+
+	in := &flinkjarapplication.ServiceFlinkCreateJarApplicationIn{Name: app.GetApplicationName()}
+	out, err := r.avnGen.ServiceFlinkCreateJarApplication(ctx, app.Spec.Project, app.Spec.ServiceName, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create Flink JAR application on Aiven side: %w", err)
+	}
+	app.Status.ApplicationID = out.Id
+
+	const reason = "Created"
+	meta.SetStatusCondition(&app.Status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&app.Status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, r.sync(ctx, app)
+}
+
+func (r *FlinkJarApplicationController) Update(ctx context.Context, app *v1alpha1.FlinkJarApplication) (UpdateResult, error) {
+	in := &flinkjarapplication.ServiceFlinkUpdateJarApplicationIn{Name: app.GetApplicationName()}
+	if _, err := r.avnGen.ServiceFlinkUpdateJarApplication(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, in); err != nil {
+		return UpdateResult{}, fmt.Errorf("cannot update Flink JAR application: %w", err)
+	}
+	return UpdateResult{}, r.sync(ctx, app)
+}
+
+func (r *FlinkJarApplicationController) Delete(ctx context.Context, app *v1alpha1.FlinkJarApplication) error {
+	if app.Status.ApplicationID == "" {
+		return nil
+	}
+
+	if dep := app.Status.Deployment; dep != nil && dep.DeploymentID != "" {
+		cancel := app.Spec.Deployment != nil && app.Spec.Deployment.CancelOnDelete
+		stopped, err := r.stopJob(ctx, app, cancel)
+		if err != nil {
+			return err
+		}
+		if !stopped {
+			return fmt.Errorf("%w: waiting for Flink job %q to stop", errDeletionInProgress, dep.JobID)
+		}
+	}
+
+	_, err := r.avnGen.ServiceFlinkDeleteJarApplication(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// sync uploads the new versions and deploys the version of the spec.
+func (r *FlinkJarApplicationController) sync(ctx context.Context, app *v1alpha1.FlinkJarApplication) error {
+	for _, v := range app.Spec.Versions {
+		if app.Status.GetVersion(v.Name) != nil {
+			continue
+		}
+		s, err := r.uploadVersion(ctx, app, v)
+		if err != nil {
+			return fmt.Errorf("cannot upload version %q: %w", v.Name, err)
+		}
+		app.Status.Versions = append(app.Status.Versions, *s)
+	}
+
+	// Forgets the versions removed from the spec. Aiven keeps them.
+	app.Status.Versions = slices.DeleteFunc(app.Status.Versions, func(s v1alpha1.FlinkJarApplicationVersionStatus) bool {
+		return !slices.ContainsFunc(app.Spec.Versions, func(v v1alpha1.FlinkJarApplicationVersion) bool {
+			return v.Name == s.Name
+		})
+	})
+
+	return r.syncDeployment(ctx, app)
+}
+
+// uploadVersion creates a new application version and uploads the JAR to it.
+func (r *FlinkJarApplicationController) uploadVersion(ctx context.Context, app *v1alpha1.FlinkJarApplication, v v1alpha1.FlinkJarApplicationVersion) (*v1alpha1.FlinkJarApplicationVersionStatus, error) {
+	jar, err := fetchFlinkJar(ctx, r.Client, app.Namespace, v.Source)
+	if err != nil {
+		return nil, err
+	}
+	defer jar.Close()
+
+	project, service, appID := app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID
+	out, err := r.avnGen.ServiceFlinkCreateJarApplicationVersion(ctx, project, service, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = errors.New("no upload URL")
+	if out.FileInfo != nil && out.FileInfo.Url != nil {
+		err = uploadFlinkJar(ctx, *out.FileInfo.Url, jar)
+	}
+	if err != nil {
+		// Removes the empty version, a new one is created on retry.
+		_, delErr := r.avnGen.ServiceFlinkDeleteJarApplicationVersion(ctx, project, service, appID, out.Id)
+		return nil, errors.Join(err, delErr)
+	}
+
+	return &v1alpha1.FlinkJarApplicationVersionStatus{
+		Name:      v.Name,
+		VersionID: out.Id,
+		Version:   out.Version,
+		SHA256:    jar.sha256,
+	}, nil
+}
+
+// syncDeployment stops the job when the deployment changes and deploys the version of the spec.
+func (r *FlinkJarApplicationController) syncDeployment(ctx context.Context, app *v1alpha1.FlinkJarApplication) error {
+	spec := app.Spec.Deployment
+	current := app.Status.Deployment
+
+	if current != nil && current.DeploymentID != "" {
+		out, err := r.avnGen.ServiceFlinkGetJarApplicationDeployment(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, current.DeploymentID)
+		switch {
+		case isNotFound(err):
+			current.DeploymentID = ""
+		case err != nil:
+			return err
+		default:
+			setFlinkJarApplicationDeploymentStatus(&current.FlinkJobStatus, out)
+			if spec != nil && flinkJarDeploymentEqual(spec, app.Status.GetVersion(spec.Version), out) {
+				current.Version = spec.Version
+				return nil
+			}
+
+			cancel := spec != nil && !spec.IsRestartFromSavepoint()
+			stopped, err := stopFlinkJob(current.State, r.stopFunc(ctx, app, cancel))
+			if err != nil {
+				return err
+			}
+			if !stopped {
+				return fmt.Errorf("%w: waiting for Flink job %q to stop", errPreconditionNotMet, current.JobID)
+			}
+		}
+	}
+
+	if spec == nil {
+		app.Status.Deployment = nil
+		return nil
+	}
+
+	version := app.Status.GetVersion(spec.Version)
+	if err := r.checkVersionFile(ctx, app, version); err != nil {
+		return err
+	}
+
+	savepoint := spec.StartingSavepoint
+	if current != nil && current.LastSavepoint != "" && spec.IsRestartFromSavepoint() {
+		savepoint = current.LastSavepoint
+	}
+
+	in := &flinkjarapplicationdeployment.ServiceFlinkCreateJarApplicationDeploymentIn{
+		VersionId:         version.VersionID,
+		EntryClass:        NilIfZero(spec.EntryClass),
+		ProgramArgs:       spec.ProgramArgs,
+		Parallelism:       NilIfZero(spec.Parallelism),
+		RestartEnabled:    spec.RestartEnabled,
+		StartingSavepoint: NilIfZero(savepoint),
+	}
+	out, err := r.avnGen.ServiceFlinkCreateJarApplicationDeployment(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, in)
+	if err != nil {
+		return fmt.Errorf("cannot create Flink JAR application deployment: %w", err)
+	}
+
+	status := &v1alpha1.FlinkJarApplicationDeploymentStatus{Version: spec.Version}
+	if current != nil {
+		status.LastSavepoint = current.LastSavepoint
+	}
+	status.DeploymentID = out.Id
+	status.VersionID = out.VersionId
+	status.JobID = fromAnyPointer(out.JobId)
+	status.State = string(out.Status)
+	app.Status.Deployment = status
+	return nil
+}
+
+// checkVersionFile returns an error until Aiven verifies the uploaded JAR.
+func (r *FlinkJarApplicationController) checkVersionFile(ctx context.Context, app *v1alpha1.FlinkJarApplication, version *v1alpha1.FlinkJarApplicationVersionStatus) error {
+	out, err := r.avnGen.ServiceFlinkGetJarApplicationVersion(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, version.VersionID)
+	if err != nil {
+		return fmt.Errorf("describing Flink JAR application version: %w", err)
+	}
+	if out.FileInfo == nil {
+		return fmt.Errorf("%w: version %q has no file yet", errPreconditionNotMet, version.Name)
+	}
+
+	switch string(out.FileInfo.FileStatus) {
+	case flinkJarFileStatusReady:
+		return nil
+	case flinkJarFileStatusFailed:
+		return fmt.Errorf("version %q has an invalid JAR: %s", version.Name, fromAnyPointer(out.FileInfo.VerifyErrorMessage))
+	}
+	return fmt.Errorf("%w: version %q is being verified", errPreconditionNotMet, version.Name)
+}
+
+// stopJob cancels the job, or stops it with a savepoint, unless the job is already stopping.
+// Returns true when the job is no longer running or the deployment is gone.
+func (r *FlinkJarApplicationController) stopJob(ctx context.Context, app *v1alpha1.FlinkJarApplication, cancel bool) (bool, error) {
+	dep := app.Status.Deployment
+	out, err := r.avnGen.ServiceFlinkGetJarApplicationDeployment(ctx, app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, dep.DeploymentID)
+	switch {
+	case isNotFound(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+
+	setFlinkJarApplicationDeploymentStatus(&dep.FlinkJobStatus, out)
+	return stopFlinkJob(dep.State, r.stopFunc(ctx, app, cancel))
+}
+
+func (r *FlinkJarApplicationController) stopFunc(ctx context.Context, app *v1alpha1.FlinkJarApplication, cancel bool) func() error {
+	project, service, appID, deploymentID := app.Spec.Project, app.Spec.ServiceName, app.Status.ApplicationID, app.Status.Deployment.DeploymentID
+	return func() error {
+		if cancel {
+			_, err := r.avnGen.ServiceFlinkCancelJarApplicationDeployment(ctx, project, service, appID, deploymentID)
+			return err
+		}
+		_, err := r.avnGen.ServiceFlinkStopJarApplicationDeployment(ctx, project, service, appID, deploymentID)
+		return err
+	}
+}
+
+// flinkJarDeploymentEqual returns true when the deployment runs the version with the settings of the spec.
+func flinkJarDeploymentEqual(spec *v1alpha1.FlinkJarApplicationDeployment, version *v1alpha1.FlinkJarApplicationVersionStatus, out *flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut) bool {
+	restartEnabled := spec.RestartEnabled == nil || *spec.RestartEnabled
+	return version != nil &&
+		out.VersionId == version.VersionID &&
+		fromAnyPointer(out.EntryClass) == spec.EntryClass &&
+		slices.Equal(out.ProgramArgs, spec.ProgramArgs) &&
+		(spec.Parallelism == 0 || out.Parallelism == spec.Parallelism) &&
+		out.RestartEnabled == restartEnabled
+}
+
+func setFlinkJarApplicationDeploymentStatus(s *v1alpha1.FlinkJobStatus, out *flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut) {
+	s.DeploymentID = out.Id
+	s.VersionID = out.VersionId
+	s.JobID = fromAnyPointer(out.JobId)
+	s.State = string(out.Status)
+	s.Error = fromAnyPointer(out.ErrorMsg)
+
+	// Keeps the last known savepoint when the job is restarted without one.
+	if savepoint := fromAnyPointer(out.LastSavepoint); savepoint != "" {
+		s.LastSavepoint = savepoint
+	}
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/flinkjarapplicationdeployment"
+	"github.com/aiven/go-client-codegen/handler/flinkjarapplicationversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFetchFlinkJar(t *testing.T) {
+	jarData := []byte("PK\x03\x04 not really a jar")
+	sum := sha256.Sum256(jarData)
+	jarSHA256 := hex.EncodeToString(sum[:])
+
+	t.Run("Reads binaryData of a ConfigMap", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "jars", Namespace: "default"},
+			BinaryData: map[string][]byte{"app.jar": jarData},
+		}).Build()
+
+		src := v1alpha1.FlinkJarSource{ConfigMapKeyRef: &v1alpha1.FlinkJarConfigMapKeyRef{Name: "jars", Key: "app.jar"}}
+		jar, err := fetchFlinkJar(t.Context(), k8sClient, "default", src)
+		require.NoError(t, err)
+		defer jar.Close()
+
+		assert.Equal(t, jarSHA256, jar.sha256)
+		assert.Equal(t, int64(len(jarData)), jar.size)
+		got, err := io.ReadAll(jar.file)
+		require.NoError(t, err)
+		assert.Equal(t, jarData, got)
+	})
+
+	t.Run("Keeps file and PVC paths in their roots", func(t *testing.T) {
+		assert.Equal(t, "/var/run/aiven-operator/flink-jars/app.jar", flinkJarPath(flinkJarFileRoot, "app.jar"))
+		assert.Equal(t, "/var/run/aiven-operator/flink-jars/etc/passwd", flinkJarPath(flinkJarFileRoot, "../../../../etc/passwd"))
+		assert.Equal(t, "/var/run/aiven-operator/pvc/jars/app.jar", flinkJarPath(flinkJarPVCRoot+"/jars", "/../app.jar"))
+	})
+
+	t.Run("Refuses to download from loopback addresses", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(jarData)
+		}))
+		defer srv.Close()
+
+		src := v1alpha1.FlinkJarSource{URL: &v1alpha1.FlinkJarURLSource{URL: srv.URL}}
+		_, err := fetchFlinkJar(t.Context(), nil, "default", src)
+		require.ErrorContains(t, err, "is not allowed")
+	})
+
+	t.Run("Refuses to download from addresses inside the cluster", func(t *testing.T) {
+		for _, address := range []string{"127.0.0.1:80", "169.254.169.254:80", "10.0.0.1:443", "172.16.0.1:443", "192.168.1.1:443", "[fd00::1]:443", "[::1]:443", "0.0.0.0:80"} {
+			assert.ErrorContains(t, checkFlinkJarAddress(address), "is not allowed", address)
+		}
+		assert.NoError(t, checkFlinkJarAddress("203.0.113.10:443"))
+	})
+
+	t.Run("Checks the checksum of a download", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(jarData)
+		}))
+		defer srv.Close()
+
+		defaultClient := flinkJarDownloadClient
+		flinkJarDownloadClient = srv.Client()
+		defer func() { flinkJarDownloadClient = defaultClient }()
+
+		src := v1alpha1.FlinkJarSource{URL: &v1alpha1.FlinkJarURLSource{URL: srv.URL, SHA256: jarSHA256}}
+		jar, err := fetchFlinkJar(t.Context(), nil, "default", src)
+		require.NoError(t, err)
+		require.NoError(t, jar.Close())
+
+		src.URL.SHA256 = "0000000000000000000000000000000000000000000000000000000000000000"
+		_, err = fetchFlinkJar(t.Context(), nil, "default", src)
+		require.ErrorContains(t, err, "doesn't match")
+	})
+}
+
+func TestFlinkJarApplicationController_Rollback(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newApplication := func(t *testing.T) *v1alpha1.FlinkJarApplication {
+		t.Helper()
+		app := newObjectFromExampleYAML[v1alpha1.FlinkJarApplication](t, "flinkjarapplication")
+		app.Namespace = "default"
+		// Rolls back from v2 to v1.
+		app.Spec.Deployment.Version = "v1"
+		app.Status.ApplicationID = "app-id"
+		app.Status.Versions = []v1alpha1.FlinkJarApplicationVersionStatus{
+			{Name: "v1", VersionID: "version-1", Version: 1},
+			{Name: "v2", VersionID: "version-2", Version: 2},
+		}
+		app.Status.Deployment = &v1alpha1.FlinkJarApplicationDeploymentStatus{
+			Version:        "v2",
+			FlinkJobStatus: v1alpha1.FlinkJobStatus{DeploymentID: "deployment-2", VersionID: "version-2"},
+		}
+		return app
+	}
+
+	newController := func(avn avngen.Client) *FlinkJarApplicationController {
+		return &FlinkJarApplicationController{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), avnGen: avn}
+	}
+
+	expectDeployment := func(avn *avngen.MockClient, app *v1alpha1.FlinkJarApplication, out *flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut) {
+		avn.EXPECT().
+			ServiceFlinkGetJarApplicationDeployment(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id", "deployment-2").
+			Return(out, nil).Once()
+	}
+
+	t.Run("Stops the running job with a savepoint and waits", func(t *testing.T) {
+		app := newApplication(t)
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, app, &flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut{
+			Id:        "deployment-2",
+			Status:    flinkjarapplicationdeployment.DeploymentStatusTypeRunning,
+			VersionId: "version-2",
+		})
+		avn.EXPECT().
+			ServiceFlinkStopJarApplicationDeployment(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id", "deployment-2").
+			Return(&flinkjarapplicationdeployment.ServiceFlinkStopJarApplicationDeploymentOut{}, nil).Once()
+
+		err := newController(avn).syncDeployment(t.Context(), app)
+		require.ErrorIs(t, err, errPreconditionNotMet)
+		assert.Equal(t, "v2", app.Status.Deployment.Version)
+	})
+
+	t.Run("Deploys the previous version from the last savepoint", func(t *testing.T) {
+		app := newApplication(t)
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, app, &flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut{
+			Id:            "deployment-2",
+			LastSavepoint: NilIfZero("s3://savepoints/2"),
+			Status:        flinkjarapplicationdeployment.DeploymentStatusTypeFinished,
+			VersionId:     "version-2",
+		})
+		avn.EXPECT().
+			ServiceFlinkGetJarApplicationVersion(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id", "version-1").
+			Return(&flinkjarapplicationversion.ServiceFlinkGetJarApplicationVersionOut{
+				Id:       "version-1",
+				FileInfo: &flinkjarapplicationversion.FileInfoOut{FileStatus: flinkjarapplicationversion.FileStatusTypeReady},
+			}, nil).Once()
+		avn.EXPECT().
+			ServiceFlinkCreateJarApplicationDeployment(mock.Anything, app.Spec.Project, app.Spec.ServiceName, "app-id",
+				mock.MatchedBy(func(in *flinkjarapplicationdeployment.ServiceFlinkCreateJarApplicationDeploymentIn) bool {
+					return in.VersionId == "version-1" &&
+						fromAnyPointer(in.StartingSavepoint) == "s3://savepoints/2" &&
+						fromAnyPointer(in.EntryClass) == "org.apache.flink.examples.WordCount" &&
+						assert.ObjectsAreEqual([]string{"--output", "print"}, in.ProgramArgs)
+				})).
+			Return(&flinkjarapplicationdeployment.ServiceFlinkCreateJarApplicationDeploymentOut{
+				Id:        "deployment-3",
+				Status:    flinkjarapplicationdeployment.DeploymentStatusTypeInitializing,
+				VersionId: "version-1",
+			}, nil).Once()
+
+		require.NoError(t, newController(avn).syncDeployment(t.Context(), app))
+		assert.Equal(t, "v1", app.Status.Deployment.Version)
+		assert.Equal(t, "deployment-3", app.Status.Deployment.DeploymentID)
+		assert.Equal(t, "s3://savepoints/2", app.Status.Deployment.LastSavepoint)
+	})
+
+	t.Run("Keeps the deployment that runs the version of the spec", func(t *testing.T) {
+		app := newApplication(t)
+		app.Spec.Deployment.Version = "v2"
+
+		avn := avngen.NewMockClient(t)
+		expectDeployment(avn, app, &flinkjarapplicationdeployment.ServiceFlinkGetJarApplicationDeploymentOut{
+			Id:             "deployment-2",
+			EntryClass:     NilIfZero("org.apache.flink.examples.WordCount"),
+			Parallelism:    2,
+			ProgramArgs:    []string{"--output", "print"},
+			RestartEnabled: true,
+			Status:         flinkjarapplicationdeployment.DeploymentStatusTypeRunning,
+			VersionId:      "version-2",
+		})
+
+		require.NoError(t, newController(avn).syncDeployment(t.Context(), app))
+		assert.Equal(t, "deployment-2", app.Status.Deployment.DeploymentID)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/privatelink"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpprivatelinks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpprivatelinks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpprivatelinks/finalizers,verbs=get;create;update
+
+// GCPPrivateLinkController reconciles a GCPPrivateLink object.
+type GCPPrivateLinkController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newGCPPrivateLinkReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.GCPPrivateLink] {
+			return &GCPPrivateLinkController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *GCPPrivateLinkController) Observe(ctx context.Context, pl *v1alpha1.GCPPrivateLink) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, pl.Spec.Project, pl.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.avnGen.ServicePrivatelinkGoogleGet(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if isNotFound(err) {
+		return Observation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot get GCP privatelink: %w", err)
+	}
+
+	pl.Status.GoogleServiceAttachment = out.GoogleServiceAttachment
+	setPrivateLinkState(pl, &pl.Status.PrivateLinkStatus, string(out.State))
+
+	if pl.Status.State == privateLinkStateActive {
+		connections, err := r.avnGen.ServicePrivatelinkGoogleConnectionsGet(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+		if err != nil {
+			return Observation{}, fmt.Errorf("cannot list GCP privatelink connections: %w", err)
+		}
+
+		pl.Status.Connections = make([]v1alpha1.PrivateLinkConnectionStatus, 0, len(connections))
+		for _, c := range connections {
+			pl.Status.Connections = append(pl.Status.Connections, v1alpha1.PrivateLinkConnectionStatus{
+				ID:            fromAnyPointer(c.PrivatelinkConnectionId),
+				EndpointID:    c.PscConnectionId,
+				State:         string(c.State),
+				UserIPAddress: c.UserIpAddress,
+			})
+		}
+	}
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(pl) && !gcpPrivateLinkHasPendingConnections(pl),
+	}, nil
+}
+
+func (r *GCPPrivateLinkController) Create(ctx context.Context, pl *v1alpha1.GCPPrivateLink) (CreateResult, error) {
+	delete(pl.GetAnnotations(), instanceIsRunningAnnotation)
+
+	out, err := r.avnGen.ServicePrivatelinkGoogleCreate(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create GCP privatelink: %w", err)
+	}
+
+	setPrivateLinkCreated(&pl.Status.PrivateLinkStatus, string(out.State))
+	return CreateResult{}, nil
+}
+
+// Update approves the pending connections of the spec with their IP addresses.
+func (r *GCPPrivateLinkController) Update(ctx context.Context, pl *v1alpha1.GCPPrivateLink) (UpdateResult, error) {
+	for _, c := range pl.Spec.Connections {
+		conn := findPrivateLinkConnection(pl.Status.Connections, c.PSCConnectionID)
+		if conn == nil || conn.State != privateLinkConnectionStatePendingUserApproval {
+			continue
+		}
+
+		_, err := r.avnGen.ServicePrivatelinkGoogleConnectionApproval(ctx, pl.Spec.Project, pl.Spec.ServiceName, conn.ID, &privatelink.ServicePrivatelinkGoogleConnectionApprovalIn{
+			UserIpAddress: c.UserIPAddress,
+		})
+		if err != nil {
+			return UpdateResult{}, fmt.Errorf("cannot approve GCP privatelink connection %q: %w", conn.ID, err)
+		}
+	}
+	return UpdateResult{}, nil
+}
+
+func (r *GCPPrivateLinkController) Delete(ctx context.Context, pl *v1alpha1.GCPPrivateLink) error {
+	_, err := r.avnGen.ServicePrivatelinkGoogleDelete(ctx, pl.Spec.Project, pl.Spec.ServiceName)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// gcpPrivateLinkHasPendingConnections returns true when a connection of the spec waits for approval.
+func gcpPrivateLinkHasPendingConnections(pl *v1alpha1.GCPPrivateLink) bool {
+	for _, c := range pl.Spec.Connections {
+		conn := findPrivateLinkConnection(pl.Status.Connections, c.PSCConnectionID)
+		if conn != nil && conn.State == privateLinkConnectionStatePendingUserApproval {
+			return true
+		}
+	}
+	return false
+}
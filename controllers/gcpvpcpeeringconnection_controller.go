@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpvpcpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpvpcpeeringconnections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=gcpvpcpeeringconnections/finalizers,verbs=get;create;update
+
+func newGCPVPCPeeringConnectionReconciler(c Controller) reconcilerType {
+	return newVPCPeeringConnectionReconciler(c, gcpVPCPeer)
+}
+
+var gcpVPCPeer = vpcPeer[*v1alpha1.GCPVPCPeeringConnection]{
+	createIn: func(obj *v1alpha1.GCPVPCPeeringConnection) *vpc.VpcPeeringConnectionCreateIn {
+		return &vpc.VpcPeeringConnectionCreateIn{
+			PeerCloudAccount: obj.Spec.GCPProjectID,
+			PeerVpc:          obj.Spec.PeerVPC,
+		}
+	},
+	delete: func(ctx context.Context, avnGen avngen.Client, obj *v1alpha1.GCPVPCPeeringConnection, projectVPCID string) error {
+		_, err := avnGen.VpcPeeringConnectionDelete(ctx, obj.Spec.Project, projectVPCID, obj.Spec.GCPProjectID, obj.Spec.PeerVPC)
+		return err
+	},
+	setStatus: func(obj *v1alpha1.GCPVPCPeeringConnection, stateInfo map[string]string) SecretDetails {
+		obj.Status.AivenProjectID = stateInfo["to_project_id"]
+		obj.Status.AivenVPCNetwork = stateInfo["to_vpc_network"]
+		obj.Status.SelfLink = ""
+		if obj.Status.AivenProjectID != "" && obj.Status.AivenVPCNetwork != "" {
+			obj.Status.SelfLink = fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/global/networks/%s", obj.Status.AivenProjectID, obj.Status.AivenVPCNetwork)
+		}
+		return SecretDetails{
+			"AIVEN_PROJECT_ID":  obj.Status.AivenProjectID,
+			"AIVEN_VPC_NETWORK": obj.Status.AivenVPCNetwork,
+			"SELF_LINK":         obj.Status.SelfLink,
+			"GCP_PROJECT_ID":    obj.Spec.GCPProjectID,
+			"PEER_VPC":          obj.Spec.PeerVPC,
+		}
+	},
+}
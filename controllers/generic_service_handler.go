@@ -1,18 +1,22 @@
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/aiven/go-client-codegen/handler/staticip"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -60,19 +64,22 @@ func (h *genericServiceHandler) createOrUpdate(ctx context.Context, avnGen avnge
 	spec := o.getServiceCommonSpec()
 	ometa := o.getObjectMeta()
 
+	objRefs := make([]v1alpha1.Object, len(refs))
+	for i, r := range refs {
+		objRefs[i] = r
+	}
+
 	// Project id reference
 	// Could be right in spec or referenced (has ref)
 	projectVPCID := spec.ProjectVPCID
 	if projectVPCID == "" {
-		vpcRefs := make([]v1alpha1.Object, len(refs))
-		for i, r := range refs {
-			vpcRefs[i] = r
-		}
-		if p := v1alpha1.FindProjectVPC(vpcRefs); p != nil {
+		if p := v1alpha1.FindProjectVPC(objRefs); p != nil {
 			projectVPCID = p.Status.ID
 		}
 	}
 
+	staticIPs := v1alpha1.FindStaticIPs(objRefs)
+
 	oldService, err := avnGen.ServiceGet(ctx, spec.Project, ometa.Name)
 	exists := err == nil
 	if !exists && !isNotFound(err) {
@@ -100,6 +107,14 @@ func (h *genericServiceHandler) createOrUpdate(ctx context.Context, avnGen avnge
 			return err
 		}
 
+		var forkedFrom *v1alpha1.ServiceForkStatus
+		if fp, ok := o.(serviceForkProvider); ok {
+			forkedFrom, err = setServiceForkUserConfig(ctx, avnGen, spec.Project, fp.getServiceFork(), userConfig)
+			if err != nil {
+				return err
+			}
+		}
+
 		req := service.ServiceCreateIn{
 			Cloud:                 NilIfZero(spec.CloudName),
 			DiskSpaceMb:           NilIfZero(diskSpace),
@@ -130,6 +145,25 @@ func (h *genericServiceHandler) createOrUpdate(ctx context.Context, avnGen avnge
 		if err != nil {
 			return fmt.Errorf("failed to create service: %w", err)
 		}
+		o.getServiceStatus().ForkedFrom = forkedFrom
+
+		// Static IPs can only be associated with an existing service,
+		// and static_ips can only be enabled once they are associated.
+		if len(staticIPs) > 0 {
+			if err := associateStaticIPs(ctx, avnGen, spec.Project, ometa.Name, staticIPs); err != nil {
+				return err
+			}
+
+			userConfig, err := UpdateUserConfiguration(userCfg)
+			if err != nil {
+				return err
+			}
+			setStaticIPsUserConfig(userConfig, staticIPs, nil)
+			_, err = avnGen.ServiceUpdate(ctx, spec.Project, ometa.Name, &service.ServiceUpdateIn{UserConfig: &userConfig})
+			if err != nil {
+				return fmt.Errorf("failed to enable static IPs: %w", err)
+			}
+		}
 	} else {
 		userConfig, err := UpdateUserConfiguration(userCfg)
 		if err != nil {
@@ -142,6 +176,15 @@ func (h *genericServiceHandler) createOrUpdate(ctx context.Context, avnGen avnge
 			return err
 		}
 
+		// New static IPs must be associated before static_ips is enabled.
+		// The removed ones are dissociated after the update, once static_ips may be disabled.
+		if len(staticIPs) > 0 {
+			if err := associateStaticIPs(ctx, avnGen, spec.Project, ometa.Name, staticIPs); err != nil {
+				return err
+			}
+		}
+		setStaticIPsUserConfig(userConfig, staticIPs, o.getServiceStatus().StaticIPs)
+
 		req := service.ServiceUpdateIn{
 			Cloud:                 NilIfZero(spec.CloudName),
 			DiskSpaceMb:           NilIfZero(diskSpace),
@@ -157,8 +200,19 @@ func (h *genericServiceHandler) createOrUpdate(ctx context.Context, avnGen avnge
 		if err != nil {
 			return fmt.Errorf("failed to update service: %w", err)
 		}
+
+		if err := dissociateStaticIPs(ctx, avnGen, spec.Project, ometa.Name, staticIPs, o.getServiceStatus().StaticIPs); err != nil {
+			return err
+		}
 	}
 
+	status := o.getServiceStatus()
+	status.StaticIPs = nil
+	for _, ip := range staticIPs {
+		status.StaticIPs = append(status.StaticIPs, ip.Status.ID)
+	}
+	slices.Sort(status.StaticIPs)
+
 	// Updates tags.
 	// Four scenarios: service created/updated * with/without tags
 	// By sending empty tags it clears existing list
@@ -217,6 +271,100 @@ func (h *genericServiceHandler) delete(ctx context.Context, avnGen avngen.Client
 	return false, fmt.Errorf("failed to delete service in Aiven: %w", err)
 }
 
+// plan compares the spec with the service at Aiven without changing anything, used in dry-run mode and for adoption.
+// It compares every field createOrUpdate sends to Aiven, except service integrations, which are only set on creation.
+// Migration secrets are not resolved here, so the migration block is compared as it is written in the spec.
+func (h *genericServiceHandler) plan(ctx context.Context, avnGen avngen.Client, obj client.Object, refs []client.Object) (plannedAction, error) {
+	o, err := h.fabric(obj)
+	if err != nil {
+		return plannedAction{}, err
+	}
+
+	spec := o.getServiceCommonSpec()
+	oldService, err := avnGen.ServiceGet(ctx, spec.Project, o.getObjectMeta().Name)
+	if isNotFound(err) {
+		return plannedAction{Action: plannedActionCreate}, nil
+	}
+	if err != nil {
+		return plannedAction{}, fmt.Errorf("failed to fetch service: %w", err)
+	}
+
+	objRefs := make([]v1alpha1.Object, len(refs))
+	for i, r := range refs {
+		objRefs[i] = r
+	}
+
+	var diff []FieldDiff
+	compare := func(path, desired, actual string) {
+		if desired != actual {
+			diff = append(diff, FieldDiff{Path: path, Desired: desired, Actual: actual})
+		}
+	}
+
+	if spec.Plan != "" {
+		compare("spec.plan", spec.Plan, oldService.Plan)
+	}
+	if spec.CloudName != "" {
+		compare("spec.cloudName", spec.CloudName, oldService.CloudName)
+	}
+
+	projectVPCID := spec.ProjectVPCID
+	if projectVPCID == "" {
+		if p := v1alpha1.FindProjectVPC(objRefs); p != nil {
+			projectVPCID = p.Status.ID
+		}
+	}
+	if projectVPCID != "" {
+		compare("spec.projectVpcId", projectVPCID, oldService.ProjectVpcId)
+	}
+
+	if diskSpace := v1alpha1.ConvertDiskSpace(o.getDiskSpace()); diskSpace > 0 {
+		compare("spec.diskSpace", fmt.Sprint(diskSpace), fmt.Sprint(fromAnyPointer(oldService.DiskSpaceMb)))
+	}
+
+	maintenance := fromAnyPointer(oldService.Maintenance)
+	if spec.MaintenanceWindowDow != "" {
+		compare("spec.maintenanceWindowDow", string(spec.MaintenanceWindowDow), string(maintenance.Dow))
+	}
+	if spec.MaintenanceWindowTime != "" {
+		compare("spec.maintenanceWindowTime", spec.MaintenanceWindowTime, maintenance.Time)
+	}
+
+	if spec.TerminationProtection != nil {
+		compare("spec.terminationProtection", fmt.Sprint(*spec.TerminationProtection), fmt.Sprint(oldService.TerminationProtection))
+	}
+	if spec.Powered != nil {
+		compare("spec.powered", fmt.Sprint(*spec.Powered), fmt.Sprint(oldService.State != service.ServiceStateTypePoweroff))
+	}
+
+	// Tags and technical emails are always replaced, so the empty spec clears them
+	compare("spec.tags", diffValue(emptyIfNil(spec.Tags)), diffValue(emptyIfNil(oldService.Tags)))
+
+	desiredEmails := make([]string, 0, len(spec.TechnicalEmails))
+	for _, e := range spec.TechnicalEmails {
+		desiredEmails = append(desiredEmails, e.Email)
+	}
+	actualEmails := make([]string, 0, len(oldService.TechEmails))
+	for _, e := range oldService.TechEmails {
+		actualEmails = append(actualEmails, e.Email)
+	}
+	slices.Sort(desiredEmails)
+	slices.Sort(actualEmails)
+	compare("spec.technicalEmails", strings.Join(desiredEmails, ", "), strings.Join(actualEmails, ", "))
+
+	userConfig, err := UpdateUserConfiguration(o.getUserConfig())
+	if err != nil {
+		return plannedAction{}, err
+	}
+	setStaticIPsUserConfig(userConfig, v1alpha1.FindStaticIPs(objRefs), o.getServiceStatus().StaticIPs)
+	diff = append(diff, diffFields("spec.userConfig", userConfig, oldService.UserConfig)...)
+
+	if len(diff) == 0 {
+		return plannedAction{Action: plannedActionNone}, nil
+	}
+	return plannedAction{Action: plannedActionUpdate, Diff: diff}, nil
+}
+
 func (h *genericServiceHandler) observe(ctx context.Context, avnGen avngen.Client, obj v1alpha1.AivenManagedObject) error {
 	o, err := h.fabric(obj)
 	if err != nil {
@@ -312,35 +460,77 @@ func (h *genericServiceHandler) observe(ctx context.Context, avnGen avngen.Clien
 	return nil
 }
 
-func (h *genericServiceHandler) publishConnectionSecret(ctx context.Context, obj v1alpha1.AivenManagedObject, goalSecret *corev1.Secret) error {
-	// `CreateOrUpdate` will populate this secret by calling Get.
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      goalSecret.Name,
-			Namespace: goalSecret.Namespace,
-		},
+// associateStaticIPs associates the referenced static IPs that aren't associated with the service yet.
+func associateStaticIPs(ctx context.Context, avnGen avngen.Client, project, serviceName string, staticIPs []*v1alpha1.StaticIP) error {
+	list, err := avnGen.StaticIPList(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to list static IPs: %w", err)
 	}
 
-	_, err := controllerutil.CreateOrUpdate(ctx, h.k8s, secret, func() error {
-		if secret.Data == nil {
-			secret.Data = make(map[string][]byte)
+	for _, ip := range staticIPs {
+		i := slices.IndexFunc(list, func(s staticip.StaticIpOut) bool {
+			return s.StaticIpAddressId == ip.Status.ID
+		})
+		if i < 0 {
+			return fmt.Errorf("%w: static IP %q not found", errPreconditionNotMet, ip.Name)
 		}
 
-		// Copy data from our goalSecret's StringData.
-		// This handles both Create and Update.
-		if goalSecret.StringData != nil {
-			secret.Data = make(map[string][]byte) // clear existing data
-			for key, value := range goalSecret.StringData {
-				secret.Data[key] = []byte(value)
+		switch list[i].ServiceName {
+		case serviceName:
+			continue
+		case "":
+			_, err := avnGen.ProjectStaticIPAssociate(ctx, project, ip.Status.ID, &staticip.ProjectStaticIpassociateIn{ServiceName: serviceName})
+			if err != nil {
+				return fmt.Errorf("failed to associate static IP %q: %w", ip.Name, err)
 			}
+		default:
+			return fmt.Errorf("static IP %q is associated with service %q", ip.Name, list[i].ServiceName)
 		}
+	}
+	return nil
+}
 
-		secret.Labels = goalSecret.Labels
-		secret.Annotations = goalSecret.Annotations
+// dissociateStaticIPs dissociates the static IPs the service was using, but no longer references.
+// Static IPs associated outside the operator are left untouched.
+func dissociateStaticIPs(ctx context.Context, avnGen avngen.Client, project, serviceName string, staticIPs []*v1alpha1.StaticIP, associated []string) error {
+	for _, id := range associated {
+		referenced := slices.ContainsFunc(staticIPs, func(ip *v1alpha1.StaticIP) bool {
+			return ip.Status.ID == id
+		})
+		if referenced {
+			continue
+		}
 
-		return controllerutil.SetControllerReference(obj, secret, h.k8s.Scheme())
-	})
-	return err
+		_, err := avnGen.ProjectStaticIPDissociate(ctx, project, id)
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to dissociate static IP %q from service %q: %w", id, serviceName, err)
+		}
+	}
+	return nil
+}
+
+// setStaticIPsUserConfig enables static_ips when the service references static IPs,
+// and disables it when the service no longer does. A static_ips set in the user config wins.
+func setStaticIPsUserConfig(userConfig map[string]any, staticIPs []*v1alpha1.StaticIP, associated []string) {
+	if _, ok := userConfig["static_ips"]; ok {
+		return
+	}
+
+	switch {
+	case len(staticIPs) > 0:
+		userConfig["static_ips"] = true
+	case len(associated) > 0:
+		userConfig["static_ips"] = false
+	}
+}
+
+func (h *genericServiceHandler) publishConnectionSecret(ctx context.Context, obj v1alpha1.AivenManagedObject, goalSecret *corev1.Secret) error {
+	// The secret data is replaced with the goal secret data.
+	sink, err := newSecretSink(ctx, h.k8s, h.k8s.Scheme(), obj, false)
+	if err != nil {
+		return err
+	}
+	return sink.Write(ctx, goalSecret)
 }
 
 func (h *genericServiceHandler) updateMigrationStatus(ctx context.Context, avnGen avngen.Client, o serviceAdapter, spec *v1alpha1.ServiceCommonSpec) error {
@@ -422,7 +612,7 @@ func (h *genericServiceHandler) checkPreconditions(ctx context.Context, avnGen a
 	}
 
 	switch o.getServiceType() {
-	case serviceTypeKafkaConnect:
+	case serviceTypeKafkaConnect, serviceTypeKafkaMirrorMaker:
 	default:
 		// Power-off not allowed without an initial backup.
 		if !fromAnyPointer(spec.Powered) {
@@ -448,15 +638,16 @@ type serviceType string
 
 const (
 	// Service types that can be returned by getServiceType()
-	serviceTypeKafka        serviceType = "kafka"
-	serviceTypeKafkaConnect serviceType = "kafka_connect"
-	serviceTypeMySQL        serviceType = "mysql"
-	serviceTypePostgreSQL   serviceType = "pg"
-	serviceTypeClickhouse   serviceType = "clickhouse"
-	serviceTypeOpenSearch   serviceType = "opensearch"
-	serviceTypeGrafana      serviceType = "grafana"
-	serviceTypeFlink        serviceType = "flink"
-	serviceTypeValkey       serviceType = "valkey"
+	serviceTypeKafka            serviceType = "kafka"
+	serviceTypeKafkaConnect     serviceType = "kafka_connect"
+	serviceTypeKafkaMirrorMaker serviceType = "kafka_mirrormaker"
+	serviceTypeMySQL            serviceType = "mysql"
+	serviceTypePostgreSQL       serviceType = "pg"
+	serviceTypeClickhouse       serviceType = "clickhouse"
+	serviceTypeOpenSearch       serviceType = "opensearch"
+	serviceTypeGrafana          serviceType = "grafana"
+	serviceTypeFlink            serviceType = "flink"
+	serviceTypeValkey           serviceType = "valkey"
 )
 
 // serviceAdapter turns client.Object into a generic thing
@@ -485,3 +676,51 @@ type migrationSecretProvider interface {
 	// Returns nil if the Secret is already gone (idempotent).
 	deleteMigrationSecret(ctx context.Context) error
 }
+
+// serviceForkProvider is an optional interface for service adapters that can be created
+// as a fork of another service.
+type serviceForkProvider interface {
+	getServiceFork() *v1alpha1.ServiceForkFields
+}
+
+// setServiceForkUserConfig sets the fork options of the user config for ServiceCreate.
+// Returns the origin of the fork with the backup Aiven restores, the latest one before the recovery target time.
+func setServiceForkUserConfig(ctx context.Context, avnGen avngen.Client, project string, fork *v1alpha1.ServiceForkFields, userConfig map[string]any) (*v1alpha1.ServiceForkStatus, error) {
+	if fork.ForkFrom == nil {
+		return nil, nil
+	}
+
+	status := &v1alpha1.ServiceForkStatus{
+		Project:            cmp.Or(fork.ForkFrom.Project, project),
+		ServiceName:        fork.ForkFrom.ServiceName,
+		RecoveryTargetTime: fork.RecoveryTargetTime,
+	}
+
+	backups, err := avnGen.ServiceBackupsGet(ctx, status.Project, status.ServiceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backups of service %s/%s: %w", status.Project, status.ServiceName, err)
+	}
+
+	var backup *service.BackupOut
+	for i, b := range backups {
+		if fork.RecoveryTargetTime != nil && b.BackupTime.After(fork.RecoveryTargetTime.Time) {
+			continue
+		}
+		if backup == nil || b.BackupTime.After(backup.BackupTime) {
+			backup = &backups[i]
+		}
+	}
+	if backup == nil {
+		return nil, fmt.Errorf("service %s/%s has no backup to fork from", status.Project, status.ServiceName)
+	}
+	status.BackupName = backup.BackupName
+	status.BackupTime = &metav1.Time{Time: backup.BackupTime}
+
+	delete(userConfig, "pg_service_to_fork_from") // Deprecated alias of service_to_fork_from
+	userConfig["service_to_fork_from"] = status.ServiceName
+	userConfig["project_to_fork_from"] = status.Project
+	if fork.RecoveryTargetTime != nil {
+		userConfig["recovery_target_time"] = fork.RecoveryTargetTime.UTC().Format(time.RFC3339)
+	}
+	return status, nil
+}
@@ -543,3 +543,44 @@ func TestHasPendingMigration(t *testing.T) {
 		assert.False(t, hasPendingMigration(pg))
 	})
 }
+
+func TestGenericServiceHandlerCreatesFork(t *testing.T) {
+	t.Parallel()
+
+	target := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+	pg.Spec.ForkFrom = &v1alpha1.ServiceForkSource{ServiceName: "pg-source", Project: "source-project"}
+	pg.Spec.RecoveryTargetTime = &metav1.Time{Time: target}
+
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceGet(mock.Anything, "test-project", pg.Name).
+		Return(nil, newAivenError(http.StatusNotFound, "service not found")).Once()
+	avn.EXPECT().
+		ServiceBackupsGet(mock.Anything, "source-project", "pg-source").
+		Return([]service.BackupOut{
+			{BackupName: "before", BackupTime: target.Add(-2 * time.Hour)},
+			{BackupName: "chosen", BackupTime: target.Add(-time.Hour)},
+			{BackupName: "after", BackupTime: target.Add(time.Hour)},
+		}, nil).Once()
+	avn.EXPECT().
+		ServiceCreate(mock.Anything, "test-project", mock.MatchedBy(func(in *service.ServiceCreateIn) bool {
+			return assert.Equal(t, map[string]any{
+				"service_to_fork_from": "pg-source",
+				"project_to_fork_from": "source-project",
+				"recovery_target_time": "2026-10-01T12:00:00Z",
+			}, *in.UserConfig)
+		})).
+		Return(&service.ServiceCreateOut{}, nil).Once()
+	avn.EXPECT().
+		ProjectServiceTagsReplace(mock.Anything, "test-project", pg.Name, mock.Anything).
+		Return(nil).Once()
+
+	h := &genericServiceHandler{fabric: newPostgreSQLAdapterFactory(nil)}
+	require.NoError(t, h.createOrUpdate(t.Context(), avn, pg, nil))
+	require.NotNil(t, pg.Status.ForkedFrom)
+	assert.Equal(t, "source-project", pg.Status.ForkedFrom.Project)
+	assert.Equal(t, "pg-source", pg.Status.ForkedFrom.ServiceName)
+	assert.Equal(t, "chosen", pg.Status.ForkedFrom.BackupName)
+	assert.True(t, target.Add(-time.Hour).Equal(pg.Status.ForkedFrom.BackupTime.Time))
+}
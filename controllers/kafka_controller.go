@@ -99,13 +99,17 @@ func (a *kafkaAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 	addKafkaEndpointDetails(stringData, s.Components, prefix)
 
 	for _, c := range s.Components {
+		p := prefix
+		if c.Route == service.RouteTypePrivatelink {
+			p += "PRIVATELINK_"
+		}
 		switch c.Component {
 		case "kafka_connect":
-			stringData[prefix+"CONNECT_HOST"] = c.Host
-			stringData[prefix+"CONNECT_PORT"] = strconv.Itoa(c.Port)
+			stringData[p+"CONNECT_HOST"] = c.Host
+			stringData[p+"CONNECT_PORT"] = strconv.Itoa(c.Port)
 		case "kafka_rest":
-			stringData[prefix+"REST_HOST"] = c.Host
-			stringData[prefix+"REST_PORT"] = strconv.Itoa(c.Port)
+			stringData[p+"REST_HOST"] = c.Host
+			stringData[p+"REST_PORT"] = strconv.Itoa(c.Port)
 		}
 	}
 
@@ -128,17 +132,28 @@ func (a *kafkaAdapter) createOrUpdateServiceSpecific(_ context.Context, _ avngen
 	return nil
 }
 
+// addKafkaEndpointDetails adds the SASL and schema registry endpoints.
+// Components routed through a privatelink get the PRIVATELINK_ variants, including the certificate endpoint.
 func addKafkaEndpointDetails(details SecretDetails, components []service.ComponentOut, prefix string) {
 	for _, c := range components {
+		p := prefix
+		if c.Route == service.RouteTypePrivatelink {
+			p += "PRIVATELINK_"
+		}
 		switch c.Component {
 		case "kafka":
-			if c.KafkaAuthenticationMethod == service.KafkaAuthenticationMethodTypeSasl {
-				details[prefix+"SASL_HOST"] = c.Host
-				details[prefix+"SASL_PORT"] = strconv.Itoa(c.Port)
+			switch {
+			case c.KafkaAuthenticationMethod == service.KafkaAuthenticationMethodTypeSasl:
+				details[p+"SASL_HOST"] = c.Host
+				details[p+"SASL_PORT"] = strconv.Itoa(c.Port)
+			case p != prefix:
+				// The regular certificate endpoint comes from the service URI.
+				details[p+"HOST"] = c.Host
+				details[p+"PORT"] = strconv.Itoa(c.Port)
 			}
 		case "schema_registry":
-			details[prefix+"SCHEMA_REGISTRY_HOST"] = c.Host
-			details[prefix+"SCHEMA_REGISTRY_PORT"] = strconv.Itoa(c.Port)
+			details[p+"SCHEMA_REGISTRY_HOST"] = c.Host
+			details[p+"SCHEMA_REGISTRY_PORT"] = strconv.Itoa(c.Port)
 		}
 	}
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// KafkaMirrorMakerReconciler reconciles a KafkaMirrorMaker object
+type KafkaMirrorMakerReconciler struct {
+	Controller
+}
+
+func newKafkaMirrorMakerReconciler(c Controller) reconcilerType {
+	return &KafkaMirrorMakerReconciler{Controller: c}
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers/finalizers,verbs=get;create;update
+
+func (r *KafkaMirrorMakerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(r.Client, r.Recorder, newKafkaMirrorMakerAdapter, r.Log), &v1alpha1.KafkaMirrorMaker{})
+}
+
+func (r *KafkaMirrorMakerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.KafkaMirrorMaker{}).
+		Complete(r)
+}
+
+func newKafkaMirrorMakerAdapter(object client.Object) (serviceAdapter, error) {
+	kafkaMirrorMaker, ok := object.(*v1alpha1.KafkaMirrorMaker)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.KafkaMirrorMaker")
+	}
+	return &kafkaMirrorMakerAdapter{kafkaMirrorMaker}, nil
+}
+
+// kafkaMirrorMakerAdapter handles an Aiven KafkaMirrorMaker service
+type kafkaMirrorMakerAdapter struct {
+	*v1alpha1.KafkaMirrorMaker
+}
+
+func (a *kafkaMirrorMakerAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &v1alpha1.ServiceCommonSpec{BaseServiceFields: a.Spec.BaseServiceFields}
+}
+
+func (a *kafkaMirrorMakerAdapter) getUserConfig() any {
+	return a.Spec.UserConfig
+}
+
+func (a *kafkaMirrorMakerAdapter) newSecret(_ *service.ServiceGetOut) *corev1.Secret {
+	return nil
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceType() serviceType {
+	return serviceTypeKafkaMirrorMaker
+}
+
+func (a *kafkaMirrorMakerAdapter) getDiskSpace() string {
+	return ""
+}
+
+func (a *kafkaMirrorMakerAdapter) GetConnInfoSecretTarget() v1alpha1.ConnInfoSecretTarget {
+	return v1alpha1.ConnInfoSecretTarget{}
+}
+
+func (a *kafkaMirrorMakerAdapter) performUpgradeTaskIfNeeded(_ context.Context, _ avngen.Client, _ *service.ServiceGetOut) error {
+	return nil
+}
+
+func (a *kafkaMirrorMakerAdapter) createOrUpdateServiceSpecific(_ context.Context, _ avngen.Client, _ *service.ServiceGetOut) error {
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafkamirrormaker"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakerreplicationflows,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakerreplicationflows/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakerreplicationflows/finalizers,verbs=get;create;update
+
+// KafkaMirrorMakerReplicationFlowController reconciles a KafkaMirrorMakerReplicationFlow object.
+type KafkaMirrorMakerReplicationFlowController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newKafkaMirrorMakerReplicationFlowReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.KafkaMirrorMakerReplicationFlow] {
+			return &KafkaMirrorMakerReplicationFlowController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *KafkaMirrorMakerReplicationFlowController) Observe(ctx context.Context, flow *v1alpha1.KafkaMirrorMakerReplicationFlow) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, flow.Spec.Project, flow.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	got, err := r.avnGen.ServiceKafkaMirrorMakerGetReplicationFlow(ctx, flow.Spec.Project, flow.Spec.ServiceName, flow.Spec.SourceCluster, flow.Spec.TargetCluster)
+	switch {
+	case isNotFound(err):
+		return Observation{ResourceExists: false}, nil
+	case err != nil:
+		return Observation{}, fmt.Errorf("getting replication flow: %w", err)
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&flow.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(flow) && replicationFlowMatchesSpec(got, flow),
+	}, nil
+}
+
+func (r *KafkaMirrorMakerReplicationFlowController) Create(ctx context.Context, flow *v1alpha1.KafkaMirrorMakerReplicationFlow) (CreateResult, error) {
+	spec := flow.Spec
+	in := &kafkamirrormaker.ServiceKafkaMirrorMakerCreateReplicationFlowIn{
+		SourceCluster:                   spec.SourceCluster,
+		TargetCluster:                   spec.TargetCluster,
+		Enabled:                         replicationFlowEnabled(flow),
+		Topics:                          optionalSlice(spec.Topics),
+		TopicsBlacklist:                 optionalSlice(spec.TopicsBlacklist),
+		ReplicationPolicyClass:          kafkamirrormaker.ReplicationPolicyClassType(spec.ReplicationPolicyClass),
+		SyncGroupOffsetsEnabled:         spec.SyncGroupOffsetsEnabled,
+		SyncGroupOffsetsIntervalSeconds: spec.SyncGroupOffsetsIntervalSeconds,
+		EmitHeartbeatsEnabled:           spec.EmitHeartbeatsEnabled,
+		EmitBackwardHeartbeatsEnabled:   spec.EmitBackwardHeartbeatsEnabled,
+		OffsetSyncsTopicLocation:        kafkamirrormaker.OffsetSyncsTopicLocationType(spec.OffsetSyncsTopicLocation),
+		ReplicationFactor:               spec.ReplicationFactor,
+	}
+
+	err := r.avnGen.ServiceKafkaMirrorMakerCreateReplicationFlow(ctx, spec.Project, spec.ServiceName, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("creating replication flow: %w", err)
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(flow)
+
+	return CreateResult{}, nil
+}
+
+func (r *KafkaMirrorMakerReplicationFlowController) Update(ctx context.Context, flow *v1alpha1.KafkaMirrorMakerReplicationFlow) (UpdateResult, error) {
+	spec := flow.Spec
+	in := &kafkamirrormaker.ServiceKafkaMirrorMakerPatchReplicationFlowIn{
+		Enabled:                         new(replicationFlowEnabled(flow)),
+		Topics:                          optionalSlice(spec.Topics),
+		TopicsBlacklist:                 optionalSlice(spec.TopicsBlacklist),
+		ReplicationPolicyClass:          kafkamirrormaker.ReplicationPolicyClassType(spec.ReplicationPolicyClass),
+		SyncGroupOffsetsEnabled:         spec.SyncGroupOffsetsEnabled,
+		SyncGroupOffsetsIntervalSeconds: spec.SyncGroupOffsetsIntervalSeconds,
+		EmitHeartbeatsEnabled:           spec.EmitHeartbeatsEnabled,
+		EmitBackwardHeartbeatsEnabled:   spec.EmitBackwardHeartbeatsEnabled,
+		OffsetSyncsTopicLocation:        kafkamirrormaker.OffsetSyncsTopicLocationType(spec.OffsetSyncsTopicLocation),
+		ReplicationFactor:               spec.ReplicationFactor,
+	}
+
+	_, err := r.avnGen.ServiceKafkaMirrorMakerPatchReplicationFlow(ctx, spec.Project, spec.ServiceName, spec.SourceCluster, spec.TargetCluster, in)
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("updating replication flow: %w", err)
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(flow)
+
+	return UpdateResult{}, nil
+}
+
+func (r *KafkaMirrorMakerReplicationFlowController) Delete(ctx context.Context, flow *v1alpha1.KafkaMirrorMakerReplicationFlow) error {
+	err := r.avnGen.ServiceKafkaMirrorMakerDeleteReplicationFlow(ctx, flow.Spec.Project, flow.Spec.ServiceName, flow.Spec.SourceCluster, flow.Spec.TargetCluster)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting replication flow: %w", err)
+	}
+	return nil
+}
+
+// replicationFlowEnabled returns the desired state of the flow, enabled unless disabled explicitly.
+func replicationFlowEnabled(flow *v1alpha1.KafkaMirrorMakerReplicationFlow) bool {
+	return flow.Spec.Enable == nil || *flow.Spec.Enable
+}
+
+// replicationFlowMatchesSpec detects drift between the remote flow and the spec.
+// Optional fields are compared only when set, because Aiven reports defaults for the rest.
+func replicationFlowMatchesSpec(remote *kafkamirrormaker.ServiceKafkaMirrorMakerGetReplicationFlowOut, flow *v1alpha1.KafkaMirrorMakerReplicationFlow) bool {
+	spec := flow.Spec
+	switch {
+	case remote.Enabled != replicationFlowEnabled(flow):
+		return false
+	case spec.Topics != nil && !slices.Equal(remote.Topics, spec.Topics):
+		return false
+	case spec.TopicsBlacklist != nil && !slices.Equal(remote.TopicsBlacklist, spec.TopicsBlacklist):
+		return false
+	case spec.ReplicationPolicyClass != "" && string(remote.ReplicationPolicyClass) != spec.ReplicationPolicyClass:
+		return false
+	case spec.OffsetSyncsTopicLocation != "" && string(remote.OffsetSyncsTopicLocation) != spec.OffsetSyncsTopicLocation:
+		return false
+	}
+
+	return optionalEqual(spec.SyncGroupOffsetsEnabled, remote.SyncGroupOffsetsEnabled) &&
+		optionalEqual(spec.SyncGroupOffsetsIntervalSeconds, remote.SyncGroupOffsetsIntervalSeconds) &&
+		optionalEqual(spec.EmitHeartbeatsEnabled, remote.EmitHeartbeatsEnabled) &&
+		optionalEqual(spec.EmitBackwardHeartbeatsEnabled, remote.EmitBackwardHeartbeatsEnabled) &&
+		optionalEqual(spec.ReplicationFactor, remote.ReplicationFactor)
+}
+
+// optionalEqual returns true if the desired value is not set or equals the remote one.
+func optionalEqual[T comparable](desired, remote *T) bool {
+	return desired == nil || remote != nil && *desired == *remote
+}
+
+// optionalSlice returns nil for an unset list, so Aiven keeps its default.
+func optionalSlice(in []string) *[]string {
+	if in == nil {
+		return nil
+	}
+	return &in
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafkamirrormaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestKafkaMirrorMakerReplicationFlowController(t *testing.T) {
+	t.Parallel()
+
+	newFlow := func(t *testing.T) *v1alpha1.KafkaMirrorMakerReplicationFlow {
+		t.Helper()
+		flow := newObjectFromExampleYAMLByKind[v1alpha1.KafkaMirrorMakerReplicationFlow](
+			t, "kafkamirrormakerreplicationflow", "KafkaMirrorMakerReplicationFlow",
+		)
+		flow.Generation = 1
+		flow.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return flow
+	}
+
+	expectFlow := func(t *testing.T, avn *avngen.MockClient, flow string) {
+		t.Helper()
+		avn.EXPECT().
+			ServiceGet(mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", mock.Anything).
+			Return(runningService(), nil).Once()
+
+		var out kafkamirrormaker.ServiceKafkaMirrorMakerGetReplicationFlowOut
+		require.NoError(t, json.Unmarshal([]byte(flow), &out))
+		avn.EXPECT().
+			ServiceKafkaMirrorMakerGetReplicationFlow(mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", "source", "target").
+			Return(&out, nil).Once()
+	}
+
+	const upToDate = `{
+		"source_cluster": "source",
+		"target_cluster": "target",
+		"enabled": true,
+		"topics": ["orders-.*"],
+		"topics.blacklist": [".*[\\-\\.]internal", "__.*"],
+		"replication_policy_class": "org.apache.kafka.connect.mirror.IdentityReplicationPolicy",
+		"sync_group_offsets_enabled": true,
+		"sync_group_offsets_interval_seconds": 60,
+		"emit_heartbeats_enabled": false,
+		"offset_syncs_topic_location": "source",
+		"replication_factor": 3
+	}`
+
+	t.Run("Flow matching the spec is up to date", func(t *testing.T) {
+		flow := newFlow(t)
+		avn := avngen.NewMockClient(t)
+		expectFlow(t, avn, upToDate)
+
+		obs, err := (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Observe(t.Context(), flow)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", flow.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Flow changed outside the operator is not up to date", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		expectFlow(t, avn, `{
+			"source_cluster": "source",
+			"target_cluster": "target",
+			"enabled": false,
+			"topics": ["orders-.*"],
+			"topics.blacklist": [".*[\\-\\.]internal", "__.*"],
+			"replication_policy_class": "org.apache.kafka.connect.mirror.IdentityReplicationPolicy",
+			"sync_group_offsets_enabled": true,
+			"sync_group_offsets_interval_seconds": 60
+		}`)
+
+		obs, err := (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Observe(t.Context(), newFlow(t))
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing flow is created", func(t *testing.T) {
+		flow := newFlow(t)
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", mock.Anything).
+			Return(runningService(), nil).Once()
+		avn.EXPECT().
+			ServiceKafkaMirrorMakerGetReplicationFlow(mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", "source", "target").
+			Return(nil, newAivenError(404, "Replication flow not found")).Once()
+
+		obs, err := (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Observe(t.Context(), flow)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		avn.EXPECT().
+			ServiceKafkaMirrorMakerCreateReplicationFlow(
+				mock.Anything, "my-aiven-project", "my-kafka-mirrormaker",
+				mock.MatchedBy(func(in *kafkamirrormaker.ServiceKafkaMirrorMakerCreateReplicationFlowIn) bool {
+					return in.SourceCluster == "source" && in.TargetCluster == "target" && in.Enabled &&
+						in.Topics != nil && assert.ObjectsAreEqual([]string{"orders-.*"}, *in.Topics) &&
+						in.SyncGroupOffsetsIntervalSeconds != nil && *in.SyncGroupOffsetsIntervalSeconds == 60 &&
+						in.EmitHeartbeatsEnabled == nil
+				}),
+			).Return(nil).Once()
+
+		_, err = (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Create(t.Context(), flow)
+		require.NoError(t, err)
+		assert.Equal(t, "true", flow.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Disabled flow is patched", func(t *testing.T) {
+		flow := newFlow(t)
+		flow.Spec.Enable = new(false)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceKafkaMirrorMakerPatchReplicationFlow(
+				mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", "source", "target",
+				mock.MatchedBy(func(in *kafkamirrormaker.ServiceKafkaMirrorMakerPatchReplicationFlowIn) bool {
+					return in.Enabled != nil && !*in.Enabled
+				}),
+			).Return(nil, nil).Once()
+
+		_, err := (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Update(t.Context(), flow)
+		require.NoError(t, err)
+	})
+
+	t.Run("Deleting a missing flow succeeds", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceKafkaMirrorMakerDeleteReplicationFlow(mock.Anything, "my-aiven-project", "my-kafka-mirrormaker", "source", "target").
+			Return(newAivenError(404, "Replication flow not found")).Once()
+
+		require.NoError(t, (&KafkaMirrorMakerReplicationFlowController{avnGen: avn}).Delete(t.Context(), newFlow(t)))
+	})
+}
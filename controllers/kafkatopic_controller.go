@@ -5,6 +5,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/kafkatopic"
@@ -91,9 +92,13 @@ func (r *KafkaTopicController) Observe(ctx context.Context, topic *v1alpha1.Kafk
 			metav1.SetMetaDataAnnotation(&topic.ObjectMeta, instanceIsRunningAnnotation, "true")
 		}
 
+		// Partitions and replication changed outside the operator are set back to the spec
+		diff := kafkaTopicDiff(topic, topicInfo)
 		return Observation{
 			ResourceExists:   true,
-			ResourceUpToDate: hasLatestGeneration(topic),
+			ResourceUpToDate: hasLatestGeneration(topic) && len(diff) == 0,
+			Diff:             diff,
+			Compared:         kafkaTopicComparable(topic),
 		}, nil
 	}
 
@@ -233,3 +238,29 @@ func convertKafkaTopicConfig(topic *v1alpha1.KafkaTopic) *kafkatopic.ConfigIn {
 		UncleanLeaderElectionEnable:     topic.Spec.Config.UncleanLeaderElectionEnable,
 	}
 }
+
+// kafkaTopicComparable reports whether kafkaTopicDiff covers the whole spec.
+// The list response has no config and tags, so topics that set them can't be compared.
+func kafkaTopicComparable(topic *v1alpha1.KafkaTopic) bool {
+	return topic.Spec.Config == nil && len(topic.Spec.Tags) == 0
+}
+
+// kafkaTopicDiff lists the topic fields that differ from the list response, reported in dry-run mode.
+func kafkaTopicDiff(topic *v1alpha1.KafkaTopic, remote kafkatopic.TopicOut) []FieldDiff {
+	var diff []FieldDiff
+	if topic.Spec.Partitions != remote.Partitions {
+		diff = append(diff, FieldDiff{
+			Path:    "spec.partitions",
+			Desired: strconv.Itoa(topic.Spec.Partitions),
+			Actual:  strconv.Itoa(remote.Partitions),
+		})
+	}
+	if topic.Spec.Replication != remote.Replication {
+		diff = append(diff, FieldDiff{
+			Path:    "spec.replication",
+			Desired: strconv.Itoa(topic.Spec.Replication),
+			Actual:  strconv.Itoa(remote.Replication),
+		})
+	}
+	return diff
+}
@@ -7,9 +7,11 @@ import (
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/kafkatopic"
 	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -231,7 +233,12 @@ func TestKafkaTopicReconciler(t *testing.T) {
 		avn.EXPECT().
 			ServiceKafkaTopicList(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
 			Return([]kafkatopic.TopicOut{
-				{TopicName: topic.GetTopicName(), State: kafkatopic.TopicStateTypeConfiguring},
+				{
+					TopicName:   topic.GetTopicName(),
+					State:       kafkatopic.TopicStateTypeConfiguring,
+					Partitions:  topic.Spec.Partitions,
+					Replication: topic.Spec.Replication,
+				},
 			}, nil).Once()
 
 		r, res, err := runScenario(t, topic, avn)
@@ -263,7 +270,12 @@ func TestKafkaTopicReconciler(t *testing.T) {
 		avn.EXPECT().
 			ServiceKafkaTopicList(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
 			Return([]kafkatopic.TopicOut{
-				{TopicName: topic.GetTopicName(), State: kafkatopic.TopicStateTypeActive},
+				{
+					TopicName:   topic.GetTopicName(),
+					State:       kafkatopic.TopicStateTypeActive,
+					Partitions:  topic.Spec.Partitions,
+					Replication: topic.Spec.Replication,
+				},
 			}, nil).Once()
 
 		r, res, err := runScenario(t, topic, avn)
@@ -319,6 +331,46 @@ func TestKafkaTopicReconciler(t *testing.T) {
 		require.Equal(t, kafkatopic.TopicStateTypeActive, got.Status.State)
 	})
 
+	t.Run("Sets partitions changed outside the operator back to the spec", func(t *testing.T) {
+		topic := newObjectFromYAML[v1alpha1.KafkaTopic](t, yamlKafkaTopic)
+		topic.Generation = 1
+		topic.Spec.Project = "test-project-drift"
+		topic.Spec.ServiceName = "test-service-drift"
+		topic.Annotations = map[string]string{
+			processedGenerationAnnotation: "1",
+			instanceIsRunningAnnotation:   "true",
+		}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return(&service.ServiceGetOut{
+				NodeStates: []service.NodeStateOut{
+					{State: service.NodeStateTypeRunning},
+					{State: service.NodeStateTypeRunning},
+				},
+			}, nil).Once()
+		avn.EXPECT().
+			ServiceKafkaTopicList(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return([]kafkatopic.TopicOut{
+				{
+					TopicName:   topic.GetTopicName(),
+					State:       kafkatopic.TopicStateTypeActive,
+					Partitions:  topic.Spec.Partitions + 1,
+					Replication: topic.Spec.Replication,
+				},
+			}, nil).Once()
+		avn.EXPECT().
+			ServiceKafkaTopicUpdate(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName, topic.GetTopicName(), mock.MatchedBy(func(in *kafkatopic.ServiceKafkaTopicUpdateIn) bool {
+				return *in.Partitions == topic.Spec.Partitions
+			})).Return(nil).Once()
+
+		before := testutil.ToFloat64(driftDetectionsTotal.WithLabelValues("KafkaTopic"))
+		_, _, err := runScenario(t, topic, avn)
+		require.NoError(t, err)
+		require.Equal(t, before+1, testutil.ToFloat64(driftDetectionsTotal.WithLabelValues("KafkaTopic")))
+	})
+
 	t.Run("Returns error when KafkaTopic isn't visible yet but API reports it already exists", func(t *testing.T) {
 		topic := newObjectFromYAML[v1alpha1.KafkaTopic](t, yamlKafkaTopic)
 		topic.Generation = 1
@@ -394,6 +446,60 @@ func TestKafkaTopicReconciler(t *testing.T) {
 		require.NotContains(t, got.Annotations, instanceIsRunningAnnotation)
 	})
 
+	t.Run("AdoptIfMatches refuses a topic with config it can't compare", func(t *testing.T) {
+		topic := newObjectFromYAML[v1alpha1.KafkaTopic](t, yamlKafkaTopic)
+		topic.Generation = 1
+		topic.Annotations = map[string]string{adoptionPolicyAnnotation: adoptionPolicyAdoptIfMatches}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return(&service.ServiceGetOut{}, nil).Once()
+		avn.EXPECT().
+			ServiceKafkaTopicList(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return([]kafkatopic.TopicOut{{
+				TopicName:   topic.GetTopicName(),
+				Partitions:  topic.Spec.Partitions,
+				Replication: topic.Spec.Replication,
+				State:       kafkatopic.TopicStateTypeConfiguring,
+			}}, nil).Once()
+
+		_, _, err := runScenario(t, topic, avn)
+		require.ErrorIs(t, err, errAdoptionRefused)
+		require.ErrorContains(t, err, "doesn't support comparing the remote state")
+	})
+
+	t.Run("AdoptIfMatches adopts a matching topic without config and tags", func(t *testing.T) {
+		topic := newObjectFromYAML[v1alpha1.KafkaTopic](t, yamlKafkaTopic)
+		topic.Generation = 1
+		topic.Annotations = map[string]string{adoptionPolicyAnnotation: adoptionPolicyAdoptIfMatches}
+		topic.Spec.Config = nil
+		topic.Spec.Tags = nil
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return(&service.ServiceGetOut{}, nil).Once()
+		avn.EXPECT().
+			ServiceKafkaTopicList(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName).
+			Return([]kafkatopic.TopicOut{{
+				TopicName:   topic.GetTopicName(),
+				Partitions:  topic.Spec.Partitions,
+				Replication: topic.Spec.Replication,
+				State:       kafkatopic.TopicStateTypeConfiguring,
+			}}, nil).Once()
+		avn.EXPECT().
+			ServiceKafkaTopicUpdate(mock.Anything, topic.Spec.Project, topic.Spec.ServiceName, topic.GetTopicName(), mock.Anything).
+			Return(nil).Once()
+
+		r, _, err := runScenario(t, topic, avn)
+		require.NoError(t, err)
+
+		got := &v1alpha1.KafkaTopic{}
+		require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: topic.Name, Namespace: topic.Namespace}, got))
+		require.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, conditionTypeAdopted))
+	})
+
 	t.Run("Blocks deletion when termination protection is on", func(t *testing.T) {
 		topic := newObjectFromYAML[v1alpha1.KafkaTopic](t, yamlKafkaTopic)
 		topic.Generation = 1
@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const metricsNamespace = "aiven_operator"
+
+// Values of the condition label of the objects metric.
+const (
+	objectConditionRunning = "Running"
+	objectConditionError   = "Error"
+	objectConditionPending = "Pending"
+)
+
+var (
+	apiRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Number of Aiven API requests by operation and status code, every retry is counted.",
+	}, []string{"operation", "code"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of Aiven API requests by operation and status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"operation", "code"})
+
+	objectsTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "objects",
+		Help:      "Number of reconciled objects by kind and condition: Running, Error or Pending.",
+	}, []string{"kind", "condition"})
+
+	driftDetectionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "drift_detections_total",
+		Help:      "Number of times the remote resource didn't match the spec, which hadn't changed since the last reconcile.",
+	}, []string{"kind"})
+
+	secretPublishFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "secret_publish_failures_total",
+		Help:      "Number of failures to publish the connection secret.",
+	}, []string{"kind"})
+
+	preconditionWaitsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "precondition_waits_total",
+		Help:      "Number of reconciles requeued because the preconditions were not met.",
+	}, []string{"kind"})
+)
+
+var registerMetricsOnce sync.Once
+
+// registerMetrics adds the operator collectors to the controller-runtime registry,
+// which is served on --metrics-bind-address along with the default metrics.
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		metrics.Registry.MustRegister(
+			apiRequestsTotal,
+			apiRequestDuration,
+			objectsTotal,
+			driftDetectionsTotal,
+			secretPublishFailuresTotal,
+			preconditionWaitsTotal,
+		)
+	})
+}
+
+// objectKind returns the kind of the object.
+// Typed objects read from the cache have an empty TypeMeta, so the Go type name is used instead.
+func objectKind(o client.Object) string {
+	return reflect.TypeOf(o).Elem().Name()
+}
+
+func recordDriftDetection(o client.Object) {
+	driftDetectionsTotal.WithLabelValues(objectKind(o)).Inc()
+}
+
+func recordSecretPublishFailure(o client.Object) {
+	secretPublishFailuresTotal.WithLabelValues(objectKind(o)).Inc()
+}
+
+func recordPreconditionWait(o client.Object) {
+	preconditionWaitsTotal.WithLabelValues(objectKind(o)).Inc()
+}
+
+type objectKey struct {
+	kind string
+	types.NamespacedName
+}
+
+// objectConditions keeps the last seen condition of every object to maintain the objects gauge.
+var objectConditions = &objectConditionTracker{conditions: make(map[objectKey]string)}
+
+type objectConditionTracker struct {
+	mu         sync.Mutex
+	conditions map[objectKey]string
+}
+
+// observe updates the objects gauge with the current condition of the object.
+func (t *objectConditionTracker) observe(o v1alpha1.AivenManagedObject) {
+	key := objectKey{kind: objectKind(o), NamespacedName: client.ObjectKeyFromObject(o)}
+	condition := getObjectCondition(o)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, ok := t.conditions[key]
+	if ok && prev == condition {
+		return
+	}
+	if ok {
+		objectsTotal.WithLabelValues(key.kind, prev).Dec()
+	}
+	t.conditions[key] = condition
+	objectsTotal.WithLabelValues(key.kind, condition).Inc()
+}
+
+// forget removes the deleted object from the objects gauge.
+func (t *objectConditionTracker) forget(kind string, name types.NamespacedName) {
+	key := objectKey{kind: kind, NamespacedName: name}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if prev, ok := t.conditions[key]; ok {
+		objectsTotal.WithLabelValues(kind, prev).Dec()
+		delete(t.conditions, key)
+	}
+}
+
+func getObjectCondition(o v1alpha1.AivenManagedObject) string {
+	switch {
+	case meta.FindStatusCondition(*o.Conditions(), ConditionTypeError) != nil:
+		return objectConditionError
+	case IsReadyToUse(o):
+		return objectConditionRunning
+	}
+	return objectConditionPending
+}
+
+// metricsTransport records the Aiven API requests that go through it.
+type metricsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := t.next.RoundTrip(req)
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(rsp.StatusCode)
+	} else if errors.Is(err, req.Context().Err()) {
+		code = "canceled"
+	}
+
+	operation := apiOperation(req.Context())
+	apiRequestsTotal.WithLabelValues(operation, code).Inc()
+	apiRequestDuration.WithLabelValues(operation, code).Observe(time.Since(start).Seconds())
+	return rsp, err
+}
+
+const apiOperationUnknown = "unknown"
+
+//go:generate go run ../generators/avngenmetrics/... --output zz_generated.metrics_client.go
+
+// metricsClient labels the avngen calls with the Aiven API operation ID, which is the name of the method, e.g. ServiceGet.
+// Its methods are generated, the methods without a context are left to the embedded client.
+type metricsClient struct {
+	avngen.Client
+}
+
+func newMetricsClient(c avngen.Client) avngen.Client {
+	return &metricsClient{Client: c}
+}
+
+type apiOperationKey struct{}
+
+func withAPIOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, apiOperationKey{}, operation)
+}
+
+// apiOperation returns the operation the request was sent for.
+// Requests sent without metricsClient are reported as unknown.
+func apiOperation(ctx context.Context) string {
+	if operation, ok := ctx.Value(apiOperationKey{}).(string); ok {
+		return operation
+	}
+	return apiOperationUnknown
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestObjectConditionTracker(t *testing.T) {
+	tracker := &objectConditionTracker{conditions: make(map[objectKey]string)}
+	count := func(condition string) float64 {
+		return testutil.ToFloat64(objectsTotal.WithLabelValues("ClickhouseUser", condition))
+	}
+	pending, running, failed := count(objectConditionPending), count(objectConditionRunning), count(objectConditionError)
+
+	user := &v1alpha1.ClickhouseUser{ObjectMeta: metav1.ObjectMeta{Name: "metrics-user", Namespace: "default"}}
+	tracker.observe(user)
+	tracker.observe(user)
+	assert.Equal(t, pending+1, count(objectConditionPending), "the same object is counted once")
+
+	meta.SetStatusCondition(user.Conditions(), getErrorCondition(errConditionCreateOrUpdate, assert.AnError))
+	tracker.observe(user)
+	assert.Equal(t, pending, count(objectConditionPending))
+	assert.Equal(t, failed+1, count(objectConditionError))
+
+	meta.RemoveStatusCondition(user.Conditions(), ConditionTypeError)
+	metav1.SetMetaDataAnnotation(&user.ObjectMeta, instanceIsRunningAnnotation, "true")
+	metav1.SetMetaDataAnnotation(&user.ObjectMeta, processedGenerationAnnotation, "0")
+	tracker.observe(user)
+	assert.Equal(t, failed, count(objectConditionError))
+	assert.Equal(t, running+1, count(objectConditionRunning))
+
+	tracker.forget("ClickhouseUser", types.NamespacedName{Name: "metrics-user", Namespace: "default"})
+	tracker.forget("ClickhouseUser", types.NamespacedName{Name: "metrics-user", Namespace: "default"})
+	assert.Equal(t, running, count(objectConditionRunning))
+	assert.Empty(t, tracker.conditions)
+}
+
+func TestMetricsTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: &metricsTransport{next: http.DefaultTransport}}
+
+	t.Run("Labels avngen requests with the operation ID", func(t *testing.T) {
+		before := testutil.ToFloat64(apiRequestsTotal.WithLabelValues("ServiceGet", "404"))
+
+		avn, err := avngen.NewClient(avngen.TokenOpt("token"), avngen.HostOpt(srv.URL), avngen.DoerOpt(c))
+		require.NoError(t, err)
+		_, err = newMetricsClient(avn).ServiceGet(t.Context(), "my-project", "my-service")
+		require.True(t, isNotFound(err))
+
+		assert.Equal(t, before+1, testutil.ToFloat64(apiRequestsTotal.WithLabelValues("ServiceGet", "404")))
+	})
+
+	t.Run("Labels other requests as unknown", func(t *testing.T) {
+		before := testutil.ToFloat64(apiRequestsTotal.WithLabelValues(apiOperationUnknown, "404"))
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		rsp, err := c.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, rsp.Body.Close())
+
+		assert.Equal(t, before+1, testutil.ToFloat64(apiRequestsTotal.WithLabelValues(apiOperationUnknown, "404")))
+	})
+}
+
+func TestObjectKind(t *testing.T) {
+	assert.Equal(t, "KafkaTopic", objectKind(&v1alpha1.KafkaTopic{}))
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	mysqlUtils "github.com/aiven/aiven-operator/utils/mysql"
+)
+
+// openMySQL connects to the service with the admin credentials from the connection secret of the MySQL resource.
+// The resource must have the same name as the service.
+func openMySQL(ctx context.Context, k8s client.Client, namespace string, spec v1alpha1.ServiceDependant) (*sql.DB, error) {
+	uri, err := getServiceConnectionURI(ctx, k8s, &v1alpha1.MySQL{}, "MySQL", namespace, spec.ServiceName, "URI")
+	if err != nil {
+		return nil, err
+	}
+	return mysqlUtils.Open(ctx, uri)
+}
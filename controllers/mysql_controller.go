@@ -71,6 +71,10 @@ func (a *mySQLAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
 	return &a.Spec.ServiceCommonSpec
 }
 
+func (a *mySQLAdapter) getServiceFork() *v1alpha1.ServiceForkFields {
+	return &a.Spec.ServiceForkFields
+}
+
 func (a *mySQLAdapter) getUserConfig() any {
 	return a.Spec.UserConfig
 }
@@ -87,6 +91,8 @@ func (a *mySQLAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 		"REPLICA_URI": *s.ConnectionInfo.MysqlReplicaUri,
 	}
 
+	addPrivatelinkEndpointDetails(stringData, s.Components, "mysql", "")
+
 	return newSecret(a, stringData, true)
 }
 
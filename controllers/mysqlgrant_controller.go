@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	mysqlUtils "github.com/aiven/aiven-operator/utils/mysql"
+)
+
+// +kubebuilder:rbac:groups=aiven.io,resources=mysqlgrants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=mysqlgrants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=mysqlgrants/finalizers,verbs=get;create;update
+
+// MySQLGrantController reconciles a MySQLGrant object.
+type MySQLGrantController struct {
+	client.Client
+	avnGen avngen.Client
+
+	// openDB connects to the service, replaced with a fake database in tests
+	openDB func(ctx context.Context, k8s client.Client, namespace string, spec v1alpha1.ServiceDependant) (*sql.DB, error)
+}
+
+func newMySQLGrantReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.MySQLGrant] {
+			return &MySQLGrantController{Client: c.Client, avnGen: avnGen, openDB: openMySQL}
+		},
+		nil,
+	)
+}
+
+func (r *MySQLGrantController) Observe(ctx context.Context, g *v1alpha1.MySQLGrant) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, g.Spec.Project, g.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	db, err := r.openDB(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant)
+	if err != nil {
+		return Observation{}, err
+	}
+	defer db.Close()
+
+	// Unlike ClickhouseGrant, compares the spec with SHOW GRANTS on every reconciliation to detect drift
+	revokes, grants, err := r.planGrants(ctx, db, g)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	exists := wasEverApplied(g)
+	upToDate := exists && hasLatestGeneration(g) && len(revokes) == 0 && len(grants) == 0
+	if upToDate {
+		markInstanceRunning(g)
+	}
+	return Observation{ResourceExists: exists, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *MySQLGrantController) Create(ctx context.Context, g *v1alpha1.MySQLGrant) (CreateResult, error) {
+	return CreateResult{}, r.applyGrants(ctx, g)
+}
+
+func (r *MySQLGrantController) Update(ctx context.Context, g *v1alpha1.MySQLGrant) (UpdateResult, error) {
+	return UpdateResult{}, r.applyGrants(ctx, g)
+}
+
+func (r *MySQLGrantController) Delete(ctx context.Context, g *v1alpha1.MySQLGrant) error {
+	db, err := r.openDB(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant)
+	if err != nil {
+		if isServiceConnectionGone(err) {
+			return nil
+		}
+		return err
+	}
+	defer db.Close()
+
+	// Revokes the latest grants from the spec.
+	return mysqlUtils.ApplyGrants(ctx, db, g.Spec.BuildStatements(mysqlUtils.REVOKE), nil)
+}
+
+// applyGrants revokes the privileges the spec doesn't declare and grants the missing ones.
+func (r *MySQLGrantController) applyGrants(ctx context.Context, g *v1alpha1.MySQLGrant) error {
+	delete(g.GetAnnotations(), instanceIsRunningAnnotation)
+
+	db, err := r.openDB(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	revokes, grants, err := r.planGrants(ctx, db, g)
+	if err != nil {
+		return err
+	}
+
+	err = mysqlUtils.ApplyGrants(ctx, db, revokes, grants)
+	if mysqlUtils.IsMissingTable(err) {
+		// The table might be created later by the application
+		return setMySQLGrantPreconditionError(g, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Stores the applied grants, so their objects are still compared after they are removed from the spec.
+	g.Status.State = g.Spec.MySQLGrants.DeepCopy()
+	markInstanceRunning(g)
+	return nil
+}
+
+// mysqlGrantObject is a database or table of an account
+type mysqlGrantObject struct {
+	user, host, database, table string
+}
+
+// planGrants compares the privileges of SHOW GRANTS with the spec on every database and table
+// of the spec and of the previously applied grants, so the removed grants are compared too.
+// Returns the statements that revoke the extra privileges and grant options, and grant the missing ones.
+// Missing users of the spec are reported as errPreconditionNotMet.
+func (r *MySQLGrantController) planGrants(ctx context.Context, db *sql.DB, g *v1alpha1.MySQLGrant) ([]string, []string, error) {
+	var objects []mysqlGrantObject
+	desired := make(map[mysqlGrantObject][]mysqlUtils.Grant)
+	addObject := func(p v1alpha1.MySQLPrivilegeGrant) mysqlGrantObject {
+		o := mysqlGrantObject{user: p.User, host: p.GetHost(), database: p.Database, table: p.Table}
+		if _, ok := desired[o]; !ok {
+			objects = append(objects, o)
+			desired[o] = nil
+		}
+		return o
+	}
+
+	for _, p := range g.Spec.PrivilegeGrants {
+		o := addObject(p)
+		desired[o] = append(desired[o], mysqlUtils.Grant{
+			Privileges:      p.Privileges,
+			Database:        p.Database,
+			Table:           cmp.Or(p.Table, "*"),
+			WithGrantOption: p.WithGrantOption,
+		})
+	}
+
+	if g.Status.State != nil {
+		for _, p := range g.Status.State.PrivilegeGrants {
+			addObject(p)
+		}
+	}
+
+	var revokes, grants []string
+	accounts := make(map[string][]mysqlUtils.Grant)
+	for _, o := range objects {
+		account := mysqlUtils.QuoteAccount(o.user, o.host)
+		actual, ok := accounts[account]
+		if !ok {
+			var err error
+			actual, err = mysqlUtils.ShowGrants(ctx, db, o.user, o.host)
+			switch {
+			case mysqlUtils.IsMissingUser(err) && len(desired[o]) > 0:
+				return nil, nil, setMySQLGrantPreconditionError(g, fmt.Errorf("missing user defined in spec: %s", account))
+			case mysqlUtils.IsMissingUser(err):
+				// The user of a removed grant is gone with its privileges
+			case err != nil:
+				return nil, nil, err
+			}
+			accounts[account] = actual
+		}
+
+		revoke, grant := mysqlUtils.GrantStatements(
+			o.user,
+			o.host,
+			mysqlUtils.ObjectGrant(actual, o.database, o.table),
+			mysqlUtils.ObjectGrant(desired[o], o.database, o.table),
+		)
+		if revoke != "" {
+			revokes = append(revokes, revoke)
+		}
+		if grant != "" {
+			grants = append(grants, grant)
+		}
+	}
+	return revokes, grants, nil
+}
+
+func setMySQLGrantPreconditionError(g *v1alpha1.MySQLGrant, err error) error {
+	meta.SetStatusCondition(&g.Status.Conditions, getErrorCondition(errConditionPreconditions, err))
+	return fmt.Errorf("%w: %w", errPreconditionNotMet, err)
+}
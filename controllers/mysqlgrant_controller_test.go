@@ -0,0 +1,318 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	mysqlUtils "github.com/aiven/aiven-operator/utils/mysql"
+)
+
+func TestMySQLGrantsBuildStatements(t *testing.T) {
+	t.Parallel()
+
+	grants := &v1alpha1.MySQLGrants{
+		PrivilegeGrants: []v1alpha1.MySQLPrivilegeGrant{
+			{User: "app", Privileges: []string{"SELECT", "insert"}, Database: "shop"},
+			{User: "app", Host: "10.0.0.%", Privileges: []string{"ALL"}, Database: "shop", Table: "orders", WithGrantOption: true},
+			{User: "reporter", Privileges: []string{"SHOW VIEW"}, Database: "*"},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"GRANT SELECT, INSERT ON `shop`.* TO `app`@`%`",
+		"GRANT ALL PRIVILEGES ON `shop`.`orders` TO `app`@`10.0.0.%` WITH GRANT OPTION",
+		"GRANT SHOW VIEW ON *.* TO `reporter`@`%`",
+	}, grants.BuildStatements(mysqlUtils.GRANT))
+
+	assert.Equal(t, []string{
+		"REVOKE SELECT, INSERT ON `shop`.* FROM `app`@`%`",
+		"REVOKE ALL PRIVILEGES, GRANT OPTION ON `shop`.`orders` FROM `app`@`10.0.0.%`",
+		"REVOKE SHOW VIEW ON *.* FROM `reporter`@`%`",
+	}, grants.BuildStatements(mysqlUtils.REVOKE))
+}
+
+// fakeMySQL is a database/sql driver that answers SHOW GRANTS with the lines of the accounts
+// and records the executed statements, like chQueryRouter of the ClickhouseGrant tests.
+type fakeMySQL struct {
+	mu sync.Mutex
+
+	// grants, SHOW GRANTS lines by account, the other accounts don't exist
+	grants map[string][]string
+
+	// errs, the errors of the statements
+	errs map[string]error
+
+	executed []string
+}
+
+func (f *fakeMySQL) open(context.Context, client.Client, string, v1alpha1.ServiceDependant) (*sql.DB, error) {
+	return sql.OpenDB(f), nil
+}
+
+func (f *fakeMySQL) Connect(context.Context) (driver.Conn, error) {
+	return &fakeMySQLConn{f}, nil
+}
+
+func (f *fakeMySQL) Driver() driver.Driver {
+	return f
+}
+
+func (f *fakeMySQL) Open(string) (driver.Conn, error) {
+	return &fakeMySQLConn{f}, nil
+}
+
+type fakeMySQLConn struct {
+	f *fakeMySQL
+}
+
+func (c *fakeMySQLConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.f.mu.Lock()
+	defer c.f.mu.Unlock()
+
+	if err := c.f.errs[query]; err != nil {
+		return nil, err
+	}
+	c.f.executed = append(c.f.executed, query)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeMySQLConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.f.mu.Lock()
+	defer c.f.mu.Unlock()
+
+	account, ok := strings.CutPrefix(query, "SHOW GRANTS FOR ")
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	lines, ok := c.f.grants[account]
+	if !ok {
+		return nil, &mysql.MySQLError{Number: 1141, Message: "There is no such grant defined for user"}
+	}
+	return &fakeMySQLRows{lines: slices.Clone(lines)}, nil
+}
+
+func (c *fakeMySQLConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *fakeMySQLConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c *fakeMySQLConn) Close() error {
+	return nil
+}
+
+type fakeMySQLRows struct {
+	lines []string
+}
+
+func (r *fakeMySQLRows) Columns() []string {
+	return []string{"Grants"}
+}
+
+func (r *fakeMySQLRows) Next(dest []driver.Value) error {
+	if len(r.lines) == 0 {
+		return io.EOF
+	}
+	dest[0], r.lines = r.lines[0], r.lines[1:]
+	return nil
+}
+
+func (r *fakeMySQLRows) Close() error {
+	return nil
+}
+
+func TestMySQLGrantController(t *testing.T) {
+	t.Parallel()
+
+	const account = "`my-service-user`@`%`"
+
+	// granted are the SHOW GRANTS lines of the example
+	granted := []string{
+		"GRANT USAGE ON *.* TO `my-service-user`@`%`",
+		"GRANT SELECT, INSERT, UPDATE, DELETE ON `my-db`.* TO `my-service-user`@`%`",
+		"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%` WITH GRANT OPTION",
+	}
+
+	newGrant := func(t *testing.T, applied bool) *v1alpha1.MySQLGrant {
+		t.Helper()
+		g := newObjectFromExampleYAMLByKind[v1alpha1.MySQLGrant](t, "mysqlgrant", "MySQLGrant")
+		g.Namespace = "default"
+		g.Generation = 1
+		if applied {
+			g.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+			g.Status.State = g.Spec.MySQLGrants.DeepCopy()
+		}
+		return g
+	}
+
+	newController := func(t *testing.T, db *fakeMySQL) *MySQLGrantController {
+		t.Helper()
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ServiceGet(mock.Anything, "aiven-project-name", "my-mysql", mock.Anything).
+			Return(runningService(), nil).
+			Maybe()
+		return &MySQLGrantController{avnGen: avn, openDB: db.open}
+	}
+
+	t.Run("Never applied grants don't exist", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: granted}}
+
+		obs, err := newController(t, db).Observe(t.Context(), newGrant(t, false))
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceExists)
+	})
+
+	t.Run("Grants listed by SHOW GRANTS are up to date", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: granted}}
+
+		g := newGrant(t, true)
+		obs, err := newController(t, db).Observe(t.Context(), g)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+		assert.Equal(t, "true", g.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Create grants the privileges", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: {"GRANT USAGE ON *.* TO `my-service-user`@`%`"}}}
+
+		g := newGrant(t, false)
+		_, err := newController(t, db).Create(t.Context(), g)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GRANT DELETE, INSERT, SELECT, UPDATE ON `my-db`.* TO `my-service-user`@`%`",
+			"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%` WITH GRANT OPTION",
+		}, db.executed)
+		assert.Equal(t, &g.Spec.MySQLGrants, g.Status.State)
+		assert.Equal(t, "true", g.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Extra privileges and grant options are revoked", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: {
+			"GRANT USAGE ON *.* TO `my-service-user`@`%`",
+			"GRANT SELECT, INSERT, UPDATE, DELETE, DROP ON `my-db`.* TO `my-service-user`@`%` WITH GRANT OPTION",
+			"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%` WITH GRANT OPTION",
+		}}}
+		controller := newController(t, db)
+
+		g := newGrant(t, true)
+		obs, err := controller.Observe(t.Context(), g)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), g)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"REVOKE DROP, GRANT OPTION ON `my-db`.* FROM `my-service-user`@`%`"}, db.executed)
+	})
+
+	t.Run("Missing privileges are granted again", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: {
+			"GRANT SELECT ON `my-db`.* TO `my-service-user`@`%`",
+			"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%`",
+		}}}
+		controller := newController(t, db)
+
+		g := newGrant(t, true)
+		obs, err := controller.Observe(t.Context(), g)
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), g)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GRANT DELETE, INSERT, SELECT, UPDATE ON `my-db`.* TO `my-service-user`@`%`",
+			"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%` WITH GRANT OPTION",
+		}, db.executed)
+	})
+
+	t.Run("Grants removed from the spec are revoked", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: granted}}
+		controller := newController(t, db)
+
+		g := newGrant(t, true)
+		g.Generation = 2
+		g.Spec.PrivilegeGrants = g.Spec.PrivilegeGrants[:1]
+		obs, err := controller.Observe(t.Context(), g)
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), g)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"REVOKE ALL PRIVILEGES, GRANT OPTION ON `my-db`.`orders` FROM `my-service-user`@`%`"}, db.executed)
+		assert.Len(t, g.Status.State.PrivilegeGrants, 1)
+	})
+
+	t.Run("Global privileges outside the enum are kept", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: {
+			"GRANT SELECT, SHOW DATABASES, REPLICATION CLIENT ON *.* TO `my-service-user`@`%`",
+			"GRANT BACKUP_ADMIN,REPLICATION_SLAVE_ADMIN,XA_RECOVER_ADMIN ON *.* TO `my-service-user`@`%`",
+		}}}
+
+		g := newGrant(t, true)
+		g.Spec.PrivilegeGrants = []v1alpha1.MySQLPrivilegeGrant{{User: "my-service-user", Database: "*", Privileges: []string{"SELECT"}}}
+		g.Status.State = g.Spec.MySQLGrants.DeepCopy()
+		obs, err := newController(t, db).Observe(t.Context(), g)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing user is a precondition error", func(t *testing.T) {
+		db := &fakeMySQL{}
+
+		_, err := newController(t, db).Observe(t.Context(), newGrant(t, true))
+		require.ErrorIs(t, err, errPreconditionNotMet)
+		assert.ErrorContains(t, err, "missing user defined in spec: "+account)
+	})
+
+	t.Run("Missing table is a precondition error", func(t *testing.T) {
+		db := &fakeMySQL{
+			grants: map[string][]string{account: {"GRANT USAGE ON *.* TO `my-service-user`@`%`"}},
+			errs: map[string]error{
+				"GRANT ALL PRIVILEGES ON `my-db`.`orders` TO `my-service-user`@`%` WITH GRANT OPTION": &mysql.MySQLError{Number: 1146, Message: "Table 'my-db.orders' doesn't exist"},
+			},
+		}
+
+		_, err := newController(t, db).Create(t.Context(), newGrant(t, false))
+		require.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Delete revokes the grants", func(t *testing.T) {
+		db := &fakeMySQL{grants: map[string][]string{account: granted}}
+
+		require.NoError(t, newController(t, db).Delete(t.Context(), newGrant(t, true)))
+		assert.Equal(t, []string{
+			"REVOKE SELECT, INSERT, UPDATE, DELETE ON `my-db`.* FROM `my-service-user`@`%`",
+			"REVOKE ALL PRIVILEGES, GRANT OPTION ON `my-db`.`orders` FROM `my-service-user`@`%`",
+		}, db.executed)
+	})
+
+	t.Run("Deleting without the MySQL resource succeeds", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, v1alpha1.AddToScheme(scheme))
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		controller := &MySQLGrantController{Client: k8sClient, avnGen: avngen.NewMockClient(t), openDB: openMySQL}
+		require.NoError(t, controller.Delete(t.Context(), newGrant(t, true)))
+	})
+}
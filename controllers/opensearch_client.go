@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var openSearchHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// openSearchClient calls the OpenSearch REST API with basic auth.
+type openSearchClient struct {
+	client   *http.Client
+	baseURL  string
+	user     string
+	password string
+}
+
+// newOpenSearchClient returns a client authenticated with the service URI from the connection secret of the OpenSearch resource.
+// The resource must have the same name as the service.
+func newOpenSearchClient(
+	ctx context.Context,
+	k8s client.Client,
+	avnGen avngen.Client,
+	namespace string,
+	spec v1alpha1.ServiceDependant,
+) (*openSearchClient, error) {
+	if _, err := getServiceIfOperational(ctx, avnGen, spec.Project, spec.ServiceName); err != nil {
+		return nil, err
+	}
+
+	uri, err := getServiceConnectionURI(ctx, k8s, &v1alpha1.OpenSearch{}, "OpenSearch", namespace, spec.ServiceName, "URI")
+	if err != nil {
+		return nil, err
+	}
+
+	password, _ := uri.User.Password()
+	c := &openSearchClient{
+		client:   openSearchHTTPClient,
+		user:     uri.User.Username(),
+		password: password,
+	}
+	uri.User = nil
+	c.baseURL = uri.String()
+	return c, nil
+}
+
+// do sends a request to the path built from elem. Returns false if the entity doesn't exist.
+func (c *openSearchClient) do(ctx context.Context, method string, query url.Values, in, out any, elem ...string) (bool, error) {
+	u, err := url.JoinPath(c.baseURL, elem...)
+	if err != nil {
+		return false, err
+	}
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return false, err
+	}
+	req.SetBasicAuth(c.user, c.password)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("OpenSearch request failed: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case rsp.StatusCode == http.StatusNotFound && method != http.MethodPut:
+		return false, nil
+	case rsp.StatusCode >= http.StatusMultipleChoices:
+		return false, fmt.Errorf("OpenSearch request %s %s failed with status %d: %s", method, u, rsp.StatusCode, bytes.TrimSpace(b))
+	case out != nil:
+		if err := json.Unmarshal(b, out); err != nil {
+			return false, fmt.Errorf("cannot parse OpenSearch response: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// parseOpenSearchJSON decodes a JSON body of the spec keeping numbers as they are written.
+func parseOpenSearchJSON(s string) (any, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// openSearchJSONContains reports whether every value of desired is present in actual.
+// OpenSearch adds defaults and returns numbers and booleans of settings as strings,
+// so extra keys are ignored and scalars are compared by their string form.
+func openSearchJSONContains(desired, actual any) bool {
+	switch d := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !openSearchJSONContains(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !openSearchJSONContains(d[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return actual == nil
+	default:
+		switch actual.(type) {
+		case map[string]any, []any, nil:
+			return false
+		}
+		return fmt.Sprint(desired) == fmt.Sprint(actual)
+	}
+}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// newOpenSearchTestCluster serves the handler as the cluster of the "my-os" service
+// and returns a k8s client with the OpenSearch resource and its connection secret.
+func newOpenSearchTestCluster(t *testing.T, handler http.HandlerFunc) (client.Client, *avngen.MockClient) {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "avnadmin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	opensearch := &v1alpha1.OpenSearch{ObjectMeta: metav1.ObjectMeta{Name: "my-os", Namespace: "default"}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-os", Namespace: "default"},
+		Data: map[string][]byte{
+			"OPENSEARCH_URI": []byte(strings.Replace(srv.URL, "http://", "http://avnadmin:secret@", 1)),
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(opensearch, secret).Build()
+
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceGet(mock.Anything, "my-aiven-project", "my-os", mock.Anything).
+		Return(runningService(), nil)
+	return k8sClient, avn
+}
+
+func TestNewOpenSearchClient(t *testing.T) {
+	t.Parallel()
+
+	spec := v1alpha1.ServiceDependant{Project: "my-aiven-project", ServiceName: "my-os"}
+
+	t.Run("Credentials are taken from the connection secret", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		c, err := newOpenSearchClient(t.Context(), k8sClient, avn, "default", spec)
+		require.NoError(t, err)
+		assert.Equal(t, "avnadmin", c.user)
+		assert.NotContains(t, c.baseURL, "secret")
+
+		found, err := c.do(t.Context(), http.MethodGet, nil, nil, nil, "_cluster", "health")
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("Missing OpenSearch resource is an error", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, nil)
+
+		_, err := newOpenSearchClient(t.Context(), k8sClient, avn, "other", spec)
+		require.ErrorContains(t, err, "getting OpenSearch other/my-os")
+	})
+}
+
+func TestOpenSearchJSONContains(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) any {
+		t.Helper()
+		v, err := parseOpenSearchJSON(s)
+		require.NoError(t, err)
+		return v
+	}
+
+	cases := []struct {
+		name     string
+		desired  string
+		actual   string
+		expected bool
+	}{
+		{
+			name:     "equal",
+			desired:  `{"priority": 100, "index_patterns": ["logs-*"]}`,
+			actual:   `{"index_patterns": ["logs-*"], "priority": 100}`,
+			expected: true,
+		},
+		{
+			name:     "defaults added by OpenSearch",
+			desired:  `{"actions": [{"delete": {}}]}`,
+			actual:   `{"actions": [{"retry": {"count": 3}, "delete": {}}]}`,
+			expected: true,
+		},
+		{
+			name:     "settings returned as strings",
+			desired:  `{"number_of_shards": 1, "hidden": false}`,
+			actual:   `{"number_of_shards": "1", "hidden": "false"}`,
+			expected: true,
+		},
+		{
+			name:     "changed value",
+			desired:  `{"priority": 100}`,
+			actual:   `{"priority": 50}`,
+			expected: false,
+		},
+		{
+			name:     "missing list item",
+			desired:  `{"index_patterns": ["logs-*", "metrics-*"]}`,
+			actual:   `{"index_patterns": ["logs-*"]}`,
+			expected: false,
+		},
+		{
+			name:     "object replaced with a scalar",
+			desired:  `{"template": {"settings": {}}}`,
+			actual:   `{"template": "settings"}`,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, openSearchJSONContains(parse(t, c.desired), parse(t, c.actual)))
+		})
+	}
+}
@@ -68,6 +68,10 @@ func (a *opensearchAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
 	return &a.Spec.ServiceCommonSpec
 }
 
+func (a *opensearchAdapter) getServiceFork() *v1alpha1.ServiceForkFields {
+	return &a.Spec.ServiceForkFields
+}
+
 func (a *opensearchAdapter) getUserConfig() any {
 	return a.Spec.UserConfig
 }
@@ -87,6 +91,8 @@ func (a *opensearchAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 		"USER":     s.ServiceUriParams["user"],
 	}
 
+	addPrivatelinkEndpointDetails(stringData, s.Components, "opensearch", prefix)
+
 	return newSecret(a, stringData, false)
 }
 
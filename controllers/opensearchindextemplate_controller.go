@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	avngen "github.com/aiven/go-client-codegen"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOpenSearchIndexTemplateReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchIndexTemplate] {
+			return &OpenSearchIndexTemplateController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchindextemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchindextemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchindextemplates/finalizers,verbs=get;create;update
+
+// OpenSearchIndexTemplateController reconciles an OpenSearchIndexTemplate object.
+type OpenSearchIndexTemplateController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OpenSearchIndexTemplateController) Observe(ctx context.Context, t *v1alpha1.OpenSearchIndexTemplate) (Observation, error) {
+	desired, err := parseOpenSearchJSON(t.Spec.Body)
+	if err != nil {
+		return Observation{}, fmt.Errorf("invalid index template body: %w", err)
+	}
+
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, t.Namespace, t.Spec.ServiceDependant)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	actual, err := getOpenSearchIndexTemplate(ctx, c, t.GetTemplateName())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch index template: %w", err)
+	}
+	if actual == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	meta.SetStatusCondition(&t.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&t.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	normalizeIndexTemplateSettings(desired)
+	normalizeIndexTemplateSettings(actual)
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(t) && openSearchJSONContains(desired, actual),
+	}, nil
+}
+
+func (r *OpenSearchIndexTemplateController) Create(ctx context.Context, t *v1alpha1.OpenSearchIndexTemplate) (CreateResult, error) {
+	if err := r.putIndexTemplate(ctx, t); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&t.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(t)
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchIndexTemplateController) Update(ctx context.Context, t *v1alpha1.OpenSearchIndexTemplate) (UpdateResult, error) {
+	if err := r.putIndexTemplate(ctx, t); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&t.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(t)
+	return UpdateResult{}, nil
+}
+
+func (r *OpenSearchIndexTemplateController) Delete(ctx context.Context, t *v1alpha1.OpenSearchIndexTemplate) error {
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, t.Namespace, t.Spec.ServiceDependant)
+	if err != nil {
+		// Nothing to delete from when the service or its OpenSearch resource are gone.
+		if apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet) {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := c.do(ctx, http.MethodDelete, nil, nil, nil, "_index_template", t.GetTemplateName()); err != nil {
+		return fmt.Errorf("deleting OpenSearch index template: %w", err)
+	}
+	return nil
+}
+
+// putIndexTemplate creates or replaces the index template.
+func (r *OpenSearchIndexTemplateController) putIndexTemplate(ctx context.Context, t *v1alpha1.OpenSearchIndexTemplate) error {
+	body, err := parseOpenSearchJSON(t.Spec.Body)
+	if err != nil {
+		return fmt.Errorf("invalid index template body: %w", err)
+	}
+
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, t.Namespace, t.Spec.ServiceDependant)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.do(ctx, http.MethodPut, nil, body, nil, "_index_template", t.GetTemplateName()); err != nil {
+		return fmt.Errorf("putting OpenSearch index template: %w", err)
+	}
+	return nil
+}
+
+// getOpenSearchIndexTemplate returns the index template body, nil if it doesn't exist.
+func getOpenSearchIndexTemplate(ctx context.Context, c *openSearchClient, name string) (any, error) {
+	var rsp struct {
+		IndexTemplates []struct {
+			Name          string          `json:"name"`
+			IndexTemplate json.RawMessage `json:"index_template"`
+		} `json:"index_templates"`
+	}
+	found, err := c.do(ctx, http.MethodGet, nil, nil, &rsp, "_index_template", name)
+	if !found || err != nil {
+		return nil, err
+	}
+
+	for _, t := range rsp.IndexTemplates {
+		if t.Name == name {
+			return parseOpenSearchJSON(string(t.IndexTemplate))
+		}
+	}
+	return nil, nil
+}
+
+// normalizeIndexTemplateSettings flattens template.settings into the "index."-prefixed keys OpenSearch returns,
+// so {"number_of_shards": 1} matches {"index": {"number_of_shards": "1"}}.
+func normalizeIndexTemplateSettings(body any) {
+	b, _ := body.(map[string]any)
+	template, _ := b["template"].(map[string]any)
+	settings, ok := template["settings"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	flat := make(map[string]any)
+	flattenOpenSearchSettings(flat, "", settings)
+	template["settings"] = flat
+}
+
+func flattenOpenSearchSettings(dst map[string]any, prefix string, settings map[string]any) {
+	for k, v := range settings {
+		key := prefix + k
+		if m, ok := v.(map[string]any); ok {
+			flattenOpenSearchSettings(dst, key+".", m)
+			continue
+		}
+		if !strings.HasPrefix(key, "index.") {
+			key = "index." + key
+		}
+		dst[key] = v
+	}
+}
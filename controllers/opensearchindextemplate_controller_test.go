@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOpenSearchIndexTemplateController(t *testing.T) {
+	t.Parallel()
+
+	newTemplate := func(t *testing.T) *v1alpha1.OpenSearchIndexTemplate {
+		t.Helper()
+		tmpl := newObjectFromExampleYAMLByKind[v1alpha1.OpenSearchIndexTemplate](t, "opensearchindextemplate", "OpenSearchIndexTemplate")
+		tmpl.Namespace = "default"
+		tmpl.Generation = 1
+		tmpl.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return tmpl
+	}
+
+	serveTemplate := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/_index_template/logs" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = io.WriteString(w, `{"index_templates": [{"name": "logs", "index_template": `+body+`}]}`)
+		}
+	}
+
+	t.Run("Template normalized by OpenSearch is up to date", func(t *testing.T) {
+		tmpl := newTemplate(t)
+		k8sClient, avn := newOpenSearchTestCluster(t, serveTemplate(`{
+			"index_patterns": ["logs-*"],
+			"priority": 100,
+			"template": {
+				"settings": {
+					"index": {
+						"number_of_shards": "1",
+						"plugins": {"index_state_management": {"rollover_alias": "logs"}}
+					}
+				},
+				"mappings": {"properties": {"@timestamp": {"type": "date"}}}
+			},
+			"composed_of": []
+		}`))
+
+		obs, err := (&OpenSearchIndexTemplateController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), tmpl)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", tmpl.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Template changed outside the operator is not up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, serveTemplate(`{
+			"index_patterns": ["logs-*"],
+			"priority": 100,
+			"template": {"settings": {"index": {"number_of_shards": "3"}}}
+		}`))
+
+		obs, err := (&OpenSearchIndexTemplateController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), newTemplate(t))
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing template is created", func(t *testing.T) {
+		var put map[string]any
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		controller := &OpenSearchIndexTemplateController{Client: k8sClient, avnGen: avn}
+
+		tmpl := newTemplate(t)
+		obs, err := controller.Observe(t.Context(), tmpl)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), tmpl)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"logs-*"}, put["index_patterns"])
+	})
+
+	t.Run("Deleting a missing template succeeds", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		require.NoError(t, (&OpenSearchIndexTemplateController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newTemplate(t)))
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	avngen "github.com/aiven/go-client-codegen"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const openSearchISMPoliciesAPI = "_plugins/_ism/policies"
+
+func newOpenSearchISMPolicyReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchISMPolicy] {
+			return &OpenSearchISMPolicyController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchismpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchismpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchismpolicies/finalizers,verbs=get;create;update
+
+// OpenSearchISMPolicyController reconciles an OpenSearchISMPolicy object.
+type OpenSearchISMPolicyController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+// openSearchISMPolicy is the response of the ISM policy API
+type openSearchISMPolicy struct {
+	SeqNo       int64           `json:"_seq_no"`
+	PrimaryTerm int64           `json:"_primary_term"`
+	Policy      json.RawMessage `json:"policy"`
+}
+
+func (r *OpenSearchISMPolicyController) Observe(ctx context.Context, p *v1alpha1.OpenSearchISMPolicy) (Observation, error) {
+	desired, err := parseOpenSearchJSON(p.Spec.Policy)
+	if err != nil {
+		return Observation{}, fmt.Errorf("invalid ISM policy: %w", err)
+	}
+
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, p.Namespace, p.Spec.ServiceDependant)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	got, err := getOpenSearchISMPolicy(ctx, c, p.GetPolicyID())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch ISM policy: %w", err)
+	}
+	if got == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	actual, err := parseOpenSearchJSON(string(got.Policy))
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot parse OpenSearch ISM policy: %w", err)
+	}
+
+	meta.SetStatusCondition(&p.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&p.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	// The API adds policy_id, last_updated_time and the defaults of the actions to the policy.
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(p) && openSearchJSONContains(desired, actual),
+	}, nil
+}
+
+func (r *OpenSearchISMPolicyController) Create(ctx context.Context, p *v1alpha1.OpenSearchISMPolicy) (CreateResult, error) {
+	if err := r.putPolicy(ctx, p); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&p.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(p)
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchISMPolicyController) Update(ctx context.Context, p *v1alpha1.OpenSearchISMPolicy) (UpdateResult, error) {
+	if err := r.putPolicy(ctx, p); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&p.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(p)
+	return UpdateResult{}, nil
+}
+
+func (r *OpenSearchISMPolicyController) Delete(ctx context.Context, p *v1alpha1.OpenSearchISMPolicy) error {
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, p.Namespace, p.Spec.ServiceDependant)
+	if err != nil {
+		// Nothing to delete from when the service or its OpenSearch resource are gone.
+		if apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet) {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := c.do(ctx, http.MethodDelete, nil, nil, nil, openSearchISMPoliciesAPI, p.GetPolicyID()); err != nil {
+		return fmt.Errorf("deleting OpenSearch ISM policy: %w", err)
+	}
+	return nil
+}
+
+// putPolicy creates the policy or replaces the existing one.
+func (r *OpenSearchISMPolicyController) putPolicy(ctx context.Context, p *v1alpha1.OpenSearchISMPolicy) error {
+	policy, err := parseOpenSearchJSON(p.Spec.Policy)
+	if err != nil {
+		return fmt.Errorf("invalid ISM policy: %w", err)
+	}
+
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, p.Namespace, p.Spec.ServiceDependant)
+	if err != nil {
+		return err
+	}
+
+	got, err := getOpenSearchISMPolicy(ctx, c, p.GetPolicyID())
+	if err != nil {
+		return fmt.Errorf("getting OpenSearch ISM policy: %w", err)
+	}
+
+	// The API refuses to replace a policy without its current sequence number
+	var query url.Values
+	if got != nil {
+		query = url.Values{
+			"if_seq_no":       {strconv.FormatInt(got.SeqNo, 10)},
+			"if_primary_term": {strconv.FormatInt(got.PrimaryTerm, 10)},
+		}
+	}
+
+	body := map[string]any{"policy": policy}
+	if _, err := c.do(ctx, http.MethodPut, query, body, nil, openSearchISMPoliciesAPI, p.GetPolicyID()); err != nil {
+		return fmt.Errorf("putting OpenSearch ISM policy: %w", err)
+	}
+	return nil
+}
+
+// getOpenSearchISMPolicy returns the policy, nil if it doesn't exist.
+func getOpenSearchISMPolicy(ctx context.Context, c *openSearchClient, id string) (*openSearchISMPolicy, error) {
+	policy := new(openSearchISMPolicy)
+	found, err := c.do(ctx, http.MethodGet, nil, nil, policy, openSearchISMPoliciesAPI, id)
+	if !found {
+		return nil, err
+	}
+	return policy, nil
+}
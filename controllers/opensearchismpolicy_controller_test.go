@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOpenSearchISMPolicyController(t *testing.T) {
+	t.Parallel()
+
+	const policyPath = "/_plugins/_ism/policies/logs-retention"
+
+	newPolicy := func(t *testing.T) *v1alpha1.OpenSearchISMPolicy {
+		t.Helper()
+		p := newObjectFromExampleYAML[v1alpha1.OpenSearchISMPolicy](t, "opensearchismpolicy")
+		p.Namespace = "default"
+		p.Generation = 1
+		p.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return p
+	}
+
+	// storedPolicy is the example policy as returned by the API, with the fields it adds
+	const storedPolicy = `{
+		"_id": "logs-retention",
+		"_seq_no": 7,
+		"_primary_term": 1,
+		"policy": {
+			"policy_id": "logs-retention",
+			"description": "Deletes logs after 30 days",
+			"last_updated_time": 1767225600000,
+			"schema_version": 21,
+			"default_state": "hot",
+			"states": [
+				{
+					"name": "hot",
+					"actions": [],
+					"transitions": [{"state_name": "delete", "conditions": {"min_index_age": "30d"}}]
+				},
+				{
+					"name": "delete",
+					"actions": [{"retry": {"count": 3, "backoff": "exponential", "delay": "1m"}, "delete": {}}],
+					"transitions": []
+				}
+			],
+			"ism_template": [{"index_patterns": ["logs-*"], "priority": 100, "last_updated_time": 1767225600000}]
+		}
+	}`
+
+	t.Run("Policy with the fields added by OpenSearch is up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, policyPath, r.URL.Path)
+			_, _ = io.WriteString(w, storedPolicy)
+		})
+
+		p := newPolicy(t)
+		obs, err := (&OpenSearchISMPolicyController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), p)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", p.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Changed policy is not up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, storedPolicy)
+		})
+
+		p := newPolicy(t)
+		p.Spec.Policy = `{"description": "Deletes logs after 7 days", "default_state": "hot", "states": []}`
+		obs, err := (&OpenSearchISMPolicyController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), p)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing policy is created", func(t *testing.T) {
+		var put map[string]map[string]any
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				assert.Empty(t, r.URL.Query().Get("if_seq_no"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		controller := &OpenSearchISMPolicyController{Client: k8sClient, avnGen: avn}
+
+		p := newPolicy(t)
+		obs, err := controller.Observe(t.Context(), p)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), p)
+		require.NoError(t, err)
+		assert.Equal(t, "hot", put["policy"]["default_state"])
+	})
+
+	t.Run("Changed policy is replaced with the current sequence number", func(t *testing.T) {
+		var put map[string]map[string]any
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				assert.Equal(t, "7", r.URL.Query().Get("if_seq_no"))
+				assert.Equal(t, "1", r.URL.Query().Get("if_primary_term"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				return
+			}
+			_, _ = io.WriteString(w, storedPolicy)
+		})
+
+		p := newPolicy(t)
+		p.Spec.Policy = `{"description": "Deletes logs after 7 days", "default_state": "hot", "states": []}`
+		_, err := (&OpenSearchISMPolicyController{Client: k8sClient, avnGen: avn}).Update(t.Context(), p)
+		require.NoError(t, err)
+		assert.Equal(t, "Deletes logs after 7 days", put["policy"]["description"])
+	})
+
+	t.Run("Delete removes the policy", func(t *testing.T) {
+		deleted := false
+		k8sClient, avn := newOpenSearchTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			deleted = r.Method == http.MethodDelete && r.URL.Path == policyPath
+		})
+
+		require.NoError(t, (&OpenSearchISMPolicyController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newPolicy(t)))
+		assert.True(t, deleted)
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOpenSearchRoleReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchRole] {
+			return &OpenSearchRoleController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchroles/finalizers,verbs=get;create;update
+
+// OpenSearchRoleController reconciles an OpenSearchRole object.
+type OpenSearchRoleController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OpenSearchRoleController) securityClient(ctx context.Context, role *v1alpha1.OpenSearchRole) (*openSearchSecurityClient, error) {
+	return newOpenSearchSecurityClient(ctx, r.Client, r.avnGen, role.Spec.ServiceDependant, role.GetRefs()[0])
+}
+
+func (r *OpenSearchRoleController) Observe(ctx context.Context, role *v1alpha1.OpenSearchRole) (Observation, error) {
+	c, err := r.securityClient(ctx, role)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	got, err := c.getRole(ctx, role.GetRoleName())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch role: %w", err)
+	}
+	if got == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&role.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(role) && openSearchRolesMatch(openSearchRoleFromSpec(role.Spec), got),
+	}, nil
+}
+
+func (r *OpenSearchRoleController) Create(ctx context.Context, role *v1alpha1.OpenSearchRole) (CreateResult, error) {
+	if err := r.putRole(ctx, role); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(role)
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchRoleController) Update(ctx context.Context, role *v1alpha1.OpenSearchRole) (UpdateResult, error) {
+	if err := r.putRole(ctx, role); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(role)
+	return UpdateResult{}, nil
+}
+
+func (r *OpenSearchRoleController) Delete(ctx context.Context, role *v1alpha1.OpenSearchRole) error {
+	c, err := r.securityClient(ctx, role)
+	if err != nil {
+		// Nothing to delete from when the service or its security config are gone.
+		if apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet) {
+			return nil
+		}
+		return err
+	}
+
+	if err := c.deleteRole(ctx, role.GetRoleName()); err != nil {
+		return fmt.Errorf("deleting OpenSearch role: %w", err)
+	}
+	return nil
+}
+
+// putRole creates or replaces the role.
+func (r *OpenSearchRoleController) putRole(ctx context.Context, role *v1alpha1.OpenSearchRole) error {
+	c, err := r.securityClient(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	if err := c.putRole(ctx, role.GetRoleName(), openSearchRoleFromSpec(role.Spec)); err != nil {
+		return fmt.Errorf("putting OpenSearch role: %w", err)
+	}
+	return nil
+}
+
+func openSearchRoleFromSpec(spec v1alpha1.OpenSearchRoleSpec) *openSearchRole {
+	role := &openSearchRole{
+		Description:        spec.Description,
+		ClusterPermissions: spec.ClusterPermissions,
+	}
+	for _, p := range spec.IndexPermissions {
+		role.IndexPermissions = append(role.IndexPermissions, openSearchIndexPermission{
+			IndexPatterns:  p.IndexPatterns,
+			DLS:            p.DLS,
+			FLS:            p.FLS,
+			MaskedFields:   p.MaskedFields,
+			AllowedActions: p.AllowedActions,
+		})
+	}
+	for _, p := range spec.TenantPermissions {
+		role.TenantPermissions = append(role.TenantPermissions, openSearchTenantPermission{
+			TenantPatterns: p.TenantPatterns,
+			AllowedActions: p.AllowedActions,
+		})
+	}
+	return role
+}
+
+// openSearchRolesMatch compares the full role definition: index patterns, allowed actions, DLS and FLS.
+func openSearchRolesMatch(desired, actual *openSearchRole) bool {
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// Not parallel: the tests replace openSearchHTTPClient, see newOpenSearchSecurityTestCluster.
+func TestOpenSearchRoleController(t *testing.T) {
+	const rolePath = "/_plugins/_security/api/roles/logs-reader"
+
+	newRole := func(t *testing.T) *v1alpha1.OpenSearchRole {
+		t.Helper()
+		role := newObjectFromExampleYAML[v1alpha1.OpenSearchRole](t, "opensearchrole")
+		role.Namespace = "default"
+		role.Generation = 1
+		role.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return role
+	}
+
+	serveRole := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != rolePath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = io.WriteString(w, `{"logs-reader": `+body+`}`)
+		}
+	}
+
+	t.Run("Role returned by OpenSearch is up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, serveRole(`{
+			"reserved": false,
+			"description": "Reads logs without personal data",
+			"cluster_permissions": ["cluster_composite_ops_ro"],
+			"index_permissions": [{
+				"index_patterns": ["logs-*"],
+				"dls": "{\"term\": {\"public\": true}}",
+				"fls": ["~email"],
+				"masked_fields": ["ip"],
+				"allowed_actions": ["read"]
+			}],
+			"tenant_permissions": [{
+				"tenant_patterns": ["global_tenant"],
+				"allowed_actions": ["kibana_all_read"]
+			}]
+		}`))
+
+		role := newRole(t)
+		obs, err := (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), role)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", role.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Role changed outside the operator is not up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, serveRole(`{
+			"cluster_permissions": ["cluster_all"],
+			"index_permissions": [],
+			"tenant_permissions": []
+		}`))
+
+		obs, err := (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), newRole(t))
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing role is created", func(t *testing.T) {
+		var put map[string]any
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut && r.URL.Path == rolePath {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		controller := &OpenSearchRoleController{Client: k8sClient, avnGen: avn}
+
+		role := newRole(t)
+		obs, err := controller.Observe(t.Context(), role)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), role)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"cluster_composite_ops_ro"}, put["cluster_permissions"])
+		assert.Equal(t, "true", role.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Update replaces the role", func(t *testing.T) {
+		var put map[string]any
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, rolePath, r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+		})
+
+		role := newRole(t)
+		role.Spec.ClusterPermissions = []string{"cluster_monitor"}
+		_, err := (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Update(t.Context(), role)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"cluster_monitor"}, put["cluster_permissions"])
+	})
+
+	t.Run("Put errors are returned", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"status": "BAD_REQUEST", "message": "Invalid configuration"}`)
+		})
+
+		_, err := (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Update(t.Context(), newRole(t))
+		require.ErrorContains(t, err, "Invalid configuration")
+	})
+
+	t.Run("Delete removes the role", func(t *testing.T) {
+		deleted := false
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			deleted = r.Method == http.MethodDelete && r.URL.Path == rolePath
+		})
+
+		require.NoError(t, (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newRole(t)))
+		assert.True(t, deleted)
+	})
+
+	t.Run("Deleting without the security config succeeds", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		role := newRole(t)
+		role.Spec.SecurityConfigRef.Name = "missing"
+		require.NoError(t, (&OpenSearchRoleController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), role))
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOpenSearchRoleMappingReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchRoleMapping] {
+			return &OpenSearchRoleMappingController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchrolemappings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchrolemappings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchrolemappings/finalizers,verbs=get;create;update
+
+// OpenSearchRoleMappingController reconciles an OpenSearchRoleMapping object.
+type OpenSearchRoleMappingController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OpenSearchRoleMappingController) securityClient(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) (*openSearchSecurityClient, error) {
+	return newOpenSearchSecurityClient(ctx, r.Client, r.avnGen, m.Spec.ServiceDependant, m.GetRefs()[0])
+}
+
+func (r *OpenSearchRoleMappingController) Observe(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) (Observation, error) {
+	c, err := r.securityClient(ctx, m)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	got, err := c.getRoleMapping(ctx, m.GetRoleName())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch role mapping: %w", err)
+	}
+	if got == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	meta.SetStatusCondition(&m.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&m.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(m) && cmp.Equal(openSearchRoleMappingFromSpec(m.Spec), got, cmpopts.EquateEmpty()),
+	}, nil
+}
+
+func (r *OpenSearchRoleMappingController) Create(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) (CreateResult, error) {
+	if err := r.putRoleMapping(ctx, m); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&m.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(m)
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchRoleMappingController) Update(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) (UpdateResult, error) {
+	if err := r.putRoleMapping(ctx, m); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&m.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(m)
+	return UpdateResult{}, nil
+}
+
+func (r *OpenSearchRoleMappingController) Delete(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) error {
+	c, err := r.securityClient(ctx, m)
+	if err != nil {
+		// Nothing to delete from when the service or its security config are gone.
+		if apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet) {
+			return nil
+		}
+		return err
+	}
+
+	if err := c.deleteRoleMapping(ctx, m.GetRoleName()); err != nil {
+		return fmt.Errorf("deleting OpenSearch role mapping: %w", err)
+	}
+	return nil
+}
+
+// putRoleMapping creates or replaces the role mapping.
+func (r *OpenSearchRoleMappingController) putRoleMapping(ctx context.Context, m *v1alpha1.OpenSearchRoleMapping) error {
+	c, err := r.securityClient(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	if err := c.putRoleMapping(ctx, m.GetRoleName(), openSearchRoleMappingFromSpec(m.Spec)); err != nil {
+		return fmt.Errorf("putting OpenSearch role mapping: %w", err)
+	}
+	return nil
+}
+
+func openSearchRoleMappingFromSpec(spec v1alpha1.OpenSearchRoleMappingSpec) *openSearchRoleMapping {
+	return &openSearchRoleMapping{
+		BackendRoles: spec.BackendRoles,
+		Hosts:        spec.Hosts,
+		Users:        spec.Users,
+	}
+}
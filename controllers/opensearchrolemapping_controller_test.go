@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// Not parallel: the tests replace openSearchHTTPClient, see newOpenSearchSecurityTestCluster.
+func TestOpenSearchRoleMappingController(t *testing.T) {
+	const mappingPath = "/_plugins/_security/api/rolesmapping/logs-reader"
+
+	newMapping := func(t *testing.T) *v1alpha1.OpenSearchRoleMapping {
+		t.Helper()
+		m := newObjectFromExampleYAML[v1alpha1.OpenSearchRoleMapping](t, "opensearchrolemapping")
+		m.Namespace = "default"
+		m.Generation = 1
+		m.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return m
+	}
+
+	serveMapping := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != mappingPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = io.WriteString(w, `{"logs-reader": `+body+`}`)
+		}
+	}
+
+	t.Run("Mapping returned by OpenSearch is up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, serveMapping(`{
+			"reserved": false,
+			"users": ["alice"],
+			"backend_roles": ["logs-team"],
+			"hosts": []
+		}`))
+
+		m := newMapping(t)
+		obs, err := (&OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), m)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", m.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Mapping changed outside the operator is not up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, serveMapping(`{
+			"users": ["alice", "bob"],
+			"backend_roles": ["logs-team"]
+		}`))
+
+		obs, err := (&OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), newMapping(t))
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing mapping is created", func(t *testing.T) {
+		var put openSearchRoleMapping
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut && r.URL.Path == mappingPath {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		controller := &OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}
+
+		m := newMapping(t)
+		obs, err := controller.Observe(t.Context(), m)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), m)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice"}, put.Users)
+		assert.Equal(t, []string{"logs-team"}, put.BackendRoles)
+	})
+
+	t.Run("Update replaces the mapping", func(t *testing.T) {
+		var put openSearchRoleMapping
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, mappingPath, r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+		})
+
+		m := newMapping(t)
+		m.Spec.Users = []string{"alice", "bob"}
+		_, err := (&OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}).Update(t.Context(), m)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob"}, put.Users)
+	})
+
+	t.Run("Delete removes the mapping", func(t *testing.T) {
+		deleted := false
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			deleted = r.Method == http.MethodDelete && r.URL.Path == mappingPath
+		})
+
+		require.NoError(t, (&OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newMapping(t)))
+		assert.True(t, deleted)
+	})
+
+	t.Run("Deleting a missing mapping succeeds", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchSecurityTestCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		require.NoError(t, (&OpenSearchRoleMappingController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newMapping(t)))
+	})
+}
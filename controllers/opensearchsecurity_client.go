@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// openSearchSecurityAdminUser is the user Aiven creates when the security management is enabled
+	openSearchSecurityAdminUser = "os-sec-admin"
+	openSearchSecurityAPI       = "_plugins/_security/api"
+)
+
+// openSearchSecurityClient calls the REST API of the OpenSearch security plugin as the security admin.
+type openSearchSecurityClient struct {
+	*openSearchClient
+}
+
+// openSearchRole is a role of the security plugin REST API
+type openSearchRole struct {
+	Description        string                       `json:"description,omitempty"`
+	ClusterPermissions []string                     `json:"cluster_permissions"`
+	IndexPermissions   []openSearchIndexPermission  `json:"index_permissions"`
+	TenantPermissions  []openSearchTenantPermission `json:"tenant_permissions"`
+}
+
+type openSearchIndexPermission struct {
+	IndexPatterns  []string `json:"index_patterns"`
+	DLS            string   `json:"dls,omitempty"`
+	FLS            []string `json:"fls"`
+	MaskedFields   []string `json:"masked_fields"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+type openSearchTenantPermission struct {
+	TenantPatterns []string `json:"tenant_patterns"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// openSearchRoleMapping is a role mapping of the security plugin REST API
+type openSearchRoleMapping struct {
+	BackendRoles []string `json:"backend_roles"`
+	Hosts        []string `json:"hosts"`
+	Users        []string `json:"users"`
+}
+
+// newOpenSearchSecurityClient returns a client for the service, authenticated with the admin password of the security config.
+func newOpenSearchSecurityClient(
+	ctx context.Context,
+	k8s client.Client,
+	avnGen avngen.Client,
+	spec v1alpha1.ServiceDependant,
+	ref *v1alpha1.ResourceReferenceObject,
+) (*openSearchSecurityClient, error) {
+	cfg := &v1alpha1.OpenSearchSecurityConfig{}
+	if err := k8s.Get(ctx, ref.NamespacedName, cfg); err != nil {
+		return nil, fmt.Errorf("getting OpenSearchSecurityConfig %s: %w", ref.NamespacedName, err)
+	}
+
+	if cfg.Spec.Project != spec.Project || cfg.Spec.ServiceName != spec.ServiceName {
+		return nil, fmt.Errorf("OpenSearchSecurityConfig %s belongs to service %s/%s", ref.NamespacedName, cfg.Spec.Project, cfg.Spec.ServiceName)
+	}
+
+	password, err := GetPasswordFromSecret(ctx, k8s, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := getServiceIfOperational(ctx, avnGen, spec.Project, spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &openSearchSecurityClient{&openSearchClient{
+		client:   openSearchHTTPClient,
+		baseURL:  "https://" + net.JoinHostPort(s.ServiceUriParams["host"], s.ServiceUriParams["port"]),
+		user:     openSearchSecurityAdminUser,
+		password: password,
+	}}, nil
+}
+
+func (c *openSearchSecurityClient) getRole(ctx context.Context, name string) (*openSearchRole, error) {
+	role := new(openSearchRole)
+	found, err := c.get(ctx, "roles", name, role)
+	if !found {
+		return nil, err
+	}
+	return role, nil
+}
+
+func (c *openSearchSecurityClient) putRole(ctx context.Context, name string, role *openSearchRole) error {
+	_, err := c.do(ctx, http.MethodPut, nil, role, nil, openSearchSecurityAPI, "roles", name)
+	return err
+}
+
+func (c *openSearchSecurityClient) deleteRole(ctx context.Context, name string) error {
+	_, err := c.do(ctx, http.MethodDelete, nil, nil, nil, openSearchSecurityAPI, "roles", name)
+	return err
+}
+
+func (c *openSearchSecurityClient) getRoleMapping(ctx context.Context, name string) (*openSearchRoleMapping, error) {
+	mapping := new(openSearchRoleMapping)
+	found, err := c.get(ctx, "rolesmapping", name, mapping)
+	if !found {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+func (c *openSearchSecurityClient) putRoleMapping(ctx context.Context, name string, mapping *openSearchRoleMapping) error {
+	_, err := c.do(ctx, http.MethodPut, nil, mapping, nil, openSearchSecurityAPI, "rolesmapping", name)
+	return err
+}
+
+func (c *openSearchSecurityClient) deleteRoleMapping(ctx context.Context, name string) error {
+	_, err := c.do(ctx, http.MethodDelete, nil, nil, nil, openSearchSecurityAPI, "rolesmapping", name)
+	return err
+}
+
+// get reads an entity. The API responds with an object keyed by the entity name.
+func (c *openSearchSecurityClient) get(ctx context.Context, resource, name string, out any) (bool, error) {
+	var entities map[string]json.RawMessage
+	found, err := c.do(ctx, http.MethodGet, nil, nil, &entities, openSearchSecurityAPI, resource, name)
+	if !found || err != nil {
+		return false, err
+	}
+
+	raw, ok := entities[name]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return false, fmt.Errorf("cannot parse OpenSearch security response: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// newOpenSearchSecurityTestCluster serves the handler as the security plugin API of the "my-os" service
+// and returns a k8s client with the OpenSearchSecurityConfig example and its admin password secret.
+// It replaces openSearchHTTPClient to trust the test server, so the tests using it can't be parallel.
+func newOpenSearchSecurityTestCluster(t *testing.T, handler http.HandlerFunc) (client.Client, *avngen.MockClient) {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != openSearchSecurityAdminUser || password != "MyCustomPassword123!" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	httpClient := openSearchHTTPClient
+	openSearchHTTPClient = srv.Client()
+	t.Cleanup(func() { openSearchHTTPClient = httpClient })
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+
+	cfg := newObjectFromExampleYAMLByKind[v1alpha1.OpenSearchSecurityConfig](t, "opensearchsecurityconfig", "OpenSearchSecurityConfig")
+	cfg.Namespace = "default"
+	secret := newObjectFromExampleYAMLByKind[corev1.Secret](t, "opensearchsecurityconfig", "Secret")
+	secret.Namespace = "default"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cfg, secret).Build()
+
+	s := runningService()
+	s.ServiceUriParams = map[string]string{"host": host, "port": port}
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceGet(mock.Anything, "my-aiven-project", "my-os", mock.Anything).
+		Return(s, nil).
+		Maybe()
+	return k8sClient, avn
+}
+
+func TestOpenSearchSecurityClient(t *testing.T) {
+	t.Parallel()
+
+	const rolePath = "/_plugins/_security/api/roles/logs-reader"
+
+	newClient := func(t *testing.T, handler http.HandlerFunc) *openSearchSecurityClient {
+		t.Helper()
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			if !ok || user != openSearchSecurityAdminUser || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			handler(w, r)
+		}))
+		t.Cleanup(srv.Close)
+		return &openSearchSecurityClient{&openSearchClient{
+			client:   srv.Client(),
+			baseURL:  srv.URL,
+			user:     openSearchSecurityAdminUser,
+			password: "secret",
+		}}
+	}
+
+	t.Run("Get role", func(t *testing.T) {
+		c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, rolePath, r.URL.Path)
+			_, _ = io.WriteString(w, `{"logs-reader": {
+				"reserved": false,
+				"cluster_permissions": ["cluster_composite_ops_ro"],
+				"index_permissions": [{
+					"index_patterns": ["logs-*"],
+					"dls": "{\"term\": {\"public\": true}}",
+					"fls": ["~email"],
+					"masked_fields": ["ip"],
+					"allowed_actions": ["read"]
+				}],
+				"tenant_permissions": []
+			}}`)
+		})
+
+		role, err := c.getRole(t.Context(), "logs-reader")
+		require.NoError(t, err)
+		require.NotNil(t, role)
+		assert.Equal(t, []string{"cluster_composite_ops_ro"}, role.ClusterPermissions)
+		require.Len(t, role.IndexPermissions, 1)
+		assert.JSONEq(t, `{"term": {"public": true}}`, role.IndexPermissions[0].DLS)
+		assert.Equal(t, []string{"~email"}, role.IndexPermissions[0].FLS)
+	})
+
+	t.Run("Missing role is nil", func(t *testing.T) {
+		c := newClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"status": "NOT_FOUND", "message": "Resource 'logs-reader' not found."}`)
+		})
+
+		role, err := c.getRole(t.Context(), "logs-reader")
+		require.NoError(t, err)
+		assert.Nil(t, role)
+	})
+
+	t.Run("Put role mapping", func(t *testing.T) {
+		c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/_plugins/_security/api/rolesmapping/logs-reader", r.URL.Path)
+
+			var mapping openSearchRoleMapping
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&mapping))
+			assert.Equal(t, []string{"alice"}, mapping.Users)
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		err := c.putRoleMapping(t.Context(), "logs-reader", &openSearchRoleMapping{Users: []string{"alice"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Put errors are returned", func(t *testing.T) {
+		c := newClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"status": "BAD_REQUEST", "message": "Invalid configuration"}`)
+		})
+
+		err := c.putRole(t.Context(), "logs-reader", &openSearchRole{})
+		require.ErrorContains(t, err, "Invalid configuration")
+	})
+
+	t.Run("Deleting a missing role succeeds", func(t *testing.T) {
+		c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		require.NoError(t, c.deleteRole(t.Context(), "logs-reader"))
+	})
+}
+
+func TestOpenSearchRolesMatch(t *testing.T) {
+	t.Parallel()
+
+	spec := v1alpha1.OpenSearchRoleSpec{
+		ClusterPermissions: []string{"cluster_composite_ops_ro"},
+		IndexPermissions: []v1alpha1.OpenSearchRoleIndexPermission{{
+			IndexPatterns:  []string{"logs-*"},
+			AllowedActions: []string{"read"},
+			DLS:            `{"term": {"public": true}}`,
+			FLS:            []string{"~email"},
+		}},
+	}
+
+	actual := func() *openSearchRole {
+		return &openSearchRole{
+			ClusterPermissions: []string{"cluster_composite_ops_ro"},
+			IndexPermissions: []openSearchIndexPermission{{
+				IndexPatterns:  []string{"logs-*"},
+				AllowedActions: []string{"read"},
+				DLS:            `{"term": {"public": true}}`,
+				FLS:            []string{"~email"},
+				MaskedFields:   []string{},
+			}},
+			TenantPermissions: []openSearchTenantPermission{},
+		}
+	}
+
+	assert.True(t, openSearchRolesMatch(openSearchRoleFromSpec(spec), actual()))
+
+	changedDLS := actual()
+	changedDLS.IndexPermissions[0].DLS = ""
+	assert.False(t, openSearchRolesMatch(openSearchRoleFromSpec(spec), changedDLS))
+
+	changedFLS := actual()
+	changedFLS.IndexPermissions[0].FLS = nil
+	assert.False(t, openSearchRolesMatch(openSearchRoleFromSpec(spec), changedFLS))
+
+	changedActions := actual()
+	changedActions.IndexPermissions[0].AllowedActions = []string{"read", "write"}
+	assert.False(t, openSearchRolesMatch(openSearchRoleFromSpec(spec), changedActions))
+
+	changedPatterns := actual()
+	changedPatterns.IndexPermissions[0].IndexPatterns = []string{"*"}
+	assert.False(t, openSearchRolesMatch(openSearchRoleFromSpec(spec), changedPatterns))
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	avnopensearch "github.com/aiven/go-client-codegen/handler/opensearch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOpenSearchSecurityConfigReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchSecurityConfig] {
+			return &OpenSearchSecurityConfigController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsecurityconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsecurityconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsecurityconfigs/finalizers,verbs=get;create;update
+
+// OpenSearchSecurityConfigController reconciles an OpenSearchSecurityConfig object.
+type OpenSearchSecurityConfigController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OpenSearchSecurityConfigController) Observe(ctx context.Context, cr *v1alpha1.OpenSearchSecurityConfig) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, cr.Spec.Project, cr.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	security, err := r.avnGen.ServiceOpenSearchSecurityGet(ctx, cr.Spec.Project, cr.Spec.ServiceName)
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch security config: %w", err)
+	}
+
+	if !security.SecurityPluginAvailable {
+		return Observation{}, fmt.Errorf("%w: the security plugin isn't available for the service", errPreconditionNotMet)
+	}
+
+	if !security.SecurityPluginAdminEnabled {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	_, version, err := r.adminPassword(ctx, cr)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&cr.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(cr) && cr.Status.AdminPasswordSecretVersion == version,
+	}, nil
+}
+
+func (r *OpenSearchSecurityConfigController) Create(ctx context.Context, cr *v1alpha1.OpenSearchSecurityConfig) (CreateResult, error) {
+	password, version, err := r.adminPassword(ctx, cr)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	_, err = r.avnGen.ServiceOpenSearchSecuritySet(
+		ctx,
+		cr.Spec.Project,
+		cr.Spec.ServiceName,
+		&avnopensearch.ServiceOpenSearchSecuritySetIn{AdminPassword: password},
+	)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("enabling OpenSearch security management: %w", err)
+	}
+
+	cr.Status.AdminPasswordSecretVersion = version
+	meta.SetStatusCondition(&cr.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&cr.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchSecurityConfigController) Update(ctx context.Context, cr *v1alpha1.OpenSearchSecurityConfig) (UpdateResult, error) {
+	password, version, err := r.adminPassword(ctx, cr)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+
+	if cr.Status.AdminPasswordSecretVersion != version {
+		// The password isn't kept anywhere to compare with, so any change of the secret is a possible password change.
+		// Aiven changes the admin password only when given the current one.
+		previous, err := r.previousAdminPassword(ctx, cr)
+		if err != nil {
+			return UpdateResult{}, err
+		}
+
+		if previous != password {
+			_, err = r.avnGen.ServiceOpenSearchSecurityReset(
+				ctx,
+				cr.Spec.Project,
+				cr.Spec.ServiceName,
+				&avnopensearch.ServiceOpenSearchSecurityResetIn{AdminPassword: previous, NewPassword: password},
+			)
+			if err != nil {
+				return UpdateResult{}, fmt.Errorf("changing OpenSearch security admin password: %w", err)
+			}
+		}
+		cr.Status.AdminPasswordSecretVersion = version
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&cr.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return UpdateResult{}, nil
+}
+
+// Delete leaves the security management enabled: Aiven doesn't allow disabling it.
+func (r *OpenSearchSecurityConfigController) Delete(_ context.Context, _ *v1alpha1.OpenSearchSecurityConfig) error {
+	return nil
+}
+
+// adminPassword returns the admin password and the resourceVersion of its secret.
+func (r *OpenSearchSecurityConfigController) adminPassword(ctx context.Context, cr *v1alpha1.OpenSearchSecurityConfig) (string, string, error) {
+	password, err := GetPasswordFromSecret(ctx, r.Client, cr)
+	if err != nil {
+		return "", "", err
+	}
+
+	source := cr.Spec.AdminPasswordSecretSource
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: cr.Namespace}, secret); err != nil {
+		return "", "", fmt.Errorf("failed to read adminPasswordSecretSource %s/%s: %w", cr.Namespace, source.Name, err)
+	}
+	return password, secret.ResourceVersion, nil
+}
+
+// previousAdminPassword reads the current admin password from the previousPasswordKey of the secret.
+// It is the same as the password when the secret changed, but the password didn't.
+func (r *OpenSearchSecurityConfigController) previousAdminPassword(ctx context.Context, cr *v1alpha1.OpenSearchSecurityConfig) (string, error) {
+	source := cr.Spec.AdminPasswordSecretSource
+	if source.PreviousPasswordKey == "" {
+		return "", fmt.Errorf("the admin password secret has changed, set previousPasswordKey to the secret key with the current password")
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: cr.Namespace}, secret); err != nil {
+		return "", fmt.Errorf("failed to read adminPasswordSecretSource %s/%s: %w", cr.Namespace, source.Name, err)
+	}
+
+	previous, ok := secret.Data[source.PreviousPasswordKey]
+	if !ok || len(previous) == 0 {
+		return "", fmt.Errorf("previous password not found in source secret %s/%s (expected %s key)", cr.Namespace, source.Name, source.PreviousPasswordKey)
+	}
+	return string(previous), nil
+}
@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	avnopensearch "github.com/aiven/go-client-codegen/handler/opensearch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOpenSearchSecurityConfigController(t *testing.T) {
+	t.Parallel()
+
+	const password = "MyCustomPassword123!"
+
+	newConfig := func(t *testing.T) *v1alpha1.OpenSearchSecurityConfig {
+		t.Helper()
+		cfg := newObjectFromExampleYAMLByKind[v1alpha1.OpenSearchSecurityConfig](t, "opensearchsecurityconfig", "OpenSearchSecurityConfig")
+		cfg.Namespace = "default"
+		cfg.Generation = 1
+		cfg.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return cfg
+	}
+
+	newController := func(t *testing.T, data map[string][]byte) (*OpenSearchSecurityConfigController, *avngen.MockClient) {
+		t.Helper()
+		secret := newObjectFromExampleYAMLByKind[corev1.Secret](t, "opensearchsecurityconfig", "Secret")
+		secret.Namespace = "default"
+		for k, v := range data {
+			secret.Data[k] = v
+		}
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+		avn := avngen.NewMockClient(t)
+		return &OpenSearchSecurityConfigController{Client: k8sClient, avnGen: avn}, avn
+	}
+
+	// secretVersion returns the resourceVersion of the admin password secret
+	secretVersion := func(t *testing.T, controller *OpenSearchSecurityConfigController) string {
+		t.Helper()
+		secret := &corev1.Secret{}
+		require.NoError(t, controller.Get(t.Context(), types.NamespacedName{Name: "os-security-admin", Namespace: "default"}, secret))
+		return secret.ResourceVersion
+	}
+
+	expectSecurity := func(avn *avngen.MockClient, out *avnopensearch.ServiceOpenSearchSecurityGetOut) {
+		avn.EXPECT().
+			ServiceGet(mock.Anything, "my-aiven-project", "my-os", mock.Anything).
+			Return(runningService(), nil).
+			Once()
+		avn.EXPECT().
+			ServiceOpenSearchSecurityGet(mock.Anything, "my-aiven-project", "my-os").
+			Return(out, nil).
+			Once()
+	}
+
+	t.Run("Errors when the security plugin isn't available", func(t *testing.T) {
+		controller, avn := newController(t, nil)
+		expectSecurity(avn, &avnopensearch.ServiceOpenSearchSecurityGetOut{SecurityPluginAvailable: false})
+
+		_, err := controller.Observe(t.Context(), newConfig(t))
+		require.ErrorIs(t, err, errPreconditionNotMet)
+		assert.ErrorContains(t, err, "the security plugin isn't available")
+	})
+
+	t.Run("Security management not enabled yet doesn't exist", func(t *testing.T) {
+		controller, avn := newController(t, nil)
+		expectSecurity(avn, &avnopensearch.ServiceOpenSearchSecurityGetOut{SecurityPluginAvailable: true})
+
+		obs, err := controller.Observe(t.Context(), newConfig(t))
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceExists)
+	})
+
+	t.Run("Create enables security management with the admin password", func(t *testing.T) {
+		controller, avn := newController(t, nil)
+		avn.EXPECT().
+			ServiceOpenSearchSecuritySet(mock.Anything, "my-aiven-project", "my-os", &avnopensearch.ServiceOpenSearchSecuritySetIn{AdminPassword: password}).
+			Return(&avnopensearch.ServiceOpenSearchSecuritySetOut{}, nil).
+			Once()
+
+		cfg := newConfig(t)
+		_, err := controller.Create(t.Context(), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, secretVersion(t, controller), cfg.Status.AdminPasswordSecretVersion)
+		assert.Equal(t, "true", cfg.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Unchanged admin password secret is up to date", func(t *testing.T) {
+		controller, avn := newController(t, nil)
+		expectSecurity(avn, &avnopensearch.ServiceOpenSearchSecurityGetOut{SecurityPluginAvailable: true, SecurityPluginAdminEnabled: true})
+
+		cfg := newConfig(t)
+		cfg.Status.AdminPasswordSecretVersion = secretVersion(t, controller)
+		obs, err := controller.Observe(t.Context(), cfg)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Changed admin password is reset with the previous one", func(t *testing.T) {
+		controller, avn := newController(t, map[string][]byte{"PREVIOUS_PASSWORD": []byte("MyOldPassword123!")})
+		expectSecurity(avn, &avnopensearch.ServiceOpenSearchSecurityGetOut{SecurityPluginAvailable: true, SecurityPluginAdminEnabled: true})
+		avn.EXPECT().
+			ServiceOpenSearchSecurityReset(mock.Anything, "my-aiven-project", "my-os", &avnopensearch.ServiceOpenSearchSecurityResetIn{
+				AdminPassword: "MyOldPassword123!",
+				NewPassword:   password,
+			}).
+			Return(&avnopensearch.ServiceOpenSearchSecurityResetOut{}, nil).
+			Once()
+
+		cfg := newConfig(t)
+		cfg.Spec.AdminPasswordSecretSource.PreviousPasswordKey = "PREVIOUS_PASSWORD"
+		cfg.Status.AdminPasswordSecretVersion = "1"
+
+		obs, err := controller.Observe(t.Context(), cfg)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, secretVersion(t, controller), cfg.Status.AdminPasswordSecretVersion)
+	})
+
+	t.Run("Changed secret with the same previous password keeps the admin password", func(t *testing.T) {
+		controller, _ := newController(t, map[string][]byte{"PREVIOUS_PASSWORD": []byte(password)})
+
+		cfg := newConfig(t)
+		cfg.Spec.AdminPasswordSecretSource.PreviousPasswordKey = "PREVIOUS_PASSWORD"
+		cfg.Status.AdminPasswordSecretVersion = "1"
+
+		_, err := controller.Update(t.Context(), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, secretVersion(t, controller), cfg.Status.AdminPasswordSecretVersion)
+	})
+
+	t.Run("Changed secret without previousPasswordKey is an error", func(t *testing.T) {
+		controller, _ := newController(t, nil)
+
+		cfg := newConfig(t)
+		cfg.Status.AdminPasswordSecretVersion = "1"
+		_, err := controller.Update(t.Context(), cfg)
+		require.ErrorContains(t, err, "set previousPasswordKey")
+		assert.Equal(t, "1", cfg.Status.AdminPasswordSecretVersion)
+	})
+
+	t.Run("Delete leaves security management enabled", func(t *testing.T) {
+		controller, _ := newController(t, nil)
+		require.NoError(t, controller.Delete(t.Context(), newConfig(t)))
+	})
+}
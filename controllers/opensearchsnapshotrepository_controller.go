@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	avngen "github.com/aiven/go-client-codegen"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOpenSearchSnapshotRepositoryReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OpenSearchSnapshotRepository] {
+			return &OpenSearchSnapshotRepositoryController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrepositories,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrepositories/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrepositories/finalizers,verbs=get;create;update
+
+// OpenSearchSnapshotRepositoryController reconciles an OpenSearchSnapshotRepository object.
+type OpenSearchSnapshotRepositoryController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+// openSearchSnapshotRepository is a repository of the snapshot API
+type openSearchSnapshotRepository struct {
+	Type     string            `json:"type"`
+	Settings map[string]string `json:"settings"`
+}
+
+func (r *OpenSearchSnapshotRepositoryController) Observe(ctx context.Context, repo *v1alpha1.OpenSearchSnapshotRepository) (Observation, error) {
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, repo.Namespace, repo.Spec.ServiceDependant)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	name := repo.GetRepositoryName()
+	var repos map[string]json.RawMessage
+	found, err := c.do(ctx, http.MethodGet, nil, nil, &repos, "_snapshot", name)
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting OpenSearch snapshot repository: %w", err)
+	}
+	raw, ok := repos[name]
+	if !found || !ok {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	actual, err := parseOpenSearchJSON(string(raw))
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot parse OpenSearch snapshot repository: %w", err)
+	}
+
+	b, err := json.Marshal(openSearchSnapshotRepositoryFromSpec(repo.Spec))
+	if err != nil {
+		return Observation{}, err
+	}
+	desired, err := parseOpenSearchJSON(string(b))
+	if err != nil {
+		return Observation{}, err
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions, getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
+	metav1.SetMetaDataAnnotation(&repo.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(repo) && openSearchJSONContains(desired, actual),
+	}, nil
+}
+
+func (r *OpenSearchSnapshotRepositoryController) Create(ctx context.Context, repo *v1alpha1.OpenSearchSnapshotRepository) (CreateResult, error) {
+	if err := r.putRepository(ctx, repo); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(repo)
+	return CreateResult{}, nil
+}
+
+func (r *OpenSearchSnapshotRepositoryController) Update(ctx context.Context, repo *v1alpha1.OpenSearchSnapshotRepository) (UpdateResult, error) {
+	if err := r.putRepository(ctx, repo); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(repo)
+	return UpdateResult{}, nil
+}
+
+func (r *OpenSearchSnapshotRepositoryController) Delete(ctx context.Context, repo *v1alpha1.OpenSearchSnapshotRepository) error {
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, repo.Namespace, repo.Spec.ServiceDependant)
+	if err != nil {
+		// Nothing to delete from when the service or its OpenSearch resource are gone.
+		if apierrors.IsNotFound(err) || errors.Is(err, errPreconditionNotMet) {
+			return nil
+		}
+		return err
+	}
+
+	// Unregisters the repository, the snapshots stay in the storage
+	if _, err := c.do(ctx, http.MethodDelete, nil, nil, nil, "_snapshot", repo.GetRepositoryName()); err != nil {
+		return fmt.Errorf("deleting OpenSearch snapshot repository: %w", err)
+	}
+	return nil
+}
+
+// putRepository registers the repository or replaces its settings.
+func (r *OpenSearchSnapshotRepositoryController) putRepository(ctx context.Context, repo *v1alpha1.OpenSearchSnapshotRepository) error {
+	c, err := newOpenSearchClient(ctx, r.Client, r.avnGen, repo.Namespace, repo.Spec.ServiceDependant)
+	if err != nil {
+		return err
+	}
+
+	body := openSearchSnapshotRepositoryFromSpec(repo.Spec)
+	if _, err := c.do(ctx, http.MethodPut, nil, body, nil, "_snapshot", repo.GetRepositoryName()); err != nil {
+		return fmt.Errorf("putting OpenSearch snapshot repository: %w", err)
+	}
+	return nil
+}
+
+func openSearchSnapshotRepositoryFromSpec(spec v1alpha1.OpenSearchSnapshotRepositorySpec) *openSearchSnapshotRepository {
+	settings := spec.Settings
+	if settings == nil {
+		settings = map[string]string{}
+	}
+	return &openSearchSnapshotRepository{Type: spec.Type, Settings: settings}
+}
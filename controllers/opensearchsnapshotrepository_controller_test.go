@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOpenSearchSnapshotRepositoryController(t *testing.T) {
+	t.Parallel()
+
+	const repoPath = "/_snapshot/my-snapshots"
+
+	newRepository := func(t *testing.T) *v1alpha1.OpenSearchSnapshotRepository {
+		t.Helper()
+		repo := newObjectFromExampleYAML[v1alpha1.OpenSearchSnapshotRepository](t, "opensearchsnapshotrepository")
+		repo.Namespace = "default"
+		repo.Generation = 1
+		repo.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return repo
+	}
+
+	serveRepository := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != repoPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = io.WriteString(w, `{"my-snapshots": `+body+`}`)
+		}
+	}
+
+	t.Run("Repository with the settings added by OpenSearch is up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, serveRepository(`{
+			"type": "s3",
+			"settings": {
+				"bucket": "my-snapshots-bucket",
+				"base_path": "opensearch",
+				"region": "eu-west-1",
+				"compress": "false"
+			}
+		}`))
+
+		repo := newRepository(t)
+		obs, err := (&OpenSearchSnapshotRepositoryController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), repo)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, "true", repo.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Changed settings are not up to date", func(t *testing.T) {
+		k8sClient, avn := newOpenSearchTestCluster(t, serveRepository(`{
+			"type": "s3",
+			"settings": {"bucket": "other-bucket", "base_path": "opensearch", "region": "eu-west-1"}
+		}`))
+
+		obs, err := (&OpenSearchSnapshotRepositoryController{Client: k8sClient, avnGen: avn}).Observe(t.Context(), newRepository(t))
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.False(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Missing repository is registered", func(t *testing.T) {
+		var put openSearchSnapshotRepository
+		k8sClient, avn := newOpenSearchTestCluster(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut && r.URL.Path == repoPath {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		controller := &OpenSearchSnapshotRepositoryController{Client: k8sClient, avnGen: avn}
+
+		repo := newRepository(t)
+		obs, err := controller.Observe(t.Context(), repo)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), repo)
+		require.NoError(t, err)
+		assert.Equal(t, "s3", put.Type)
+		assert.Equal(t, "my-snapshots-bucket", put.Settings["bucket"])
+	})
+
+	t.Run("Update replaces the settings", func(t *testing.T) {
+		var put openSearchSnapshotRepository
+		k8sClient, avn := newOpenSearchTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, repoPath, r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+		})
+
+		repo := newRepository(t)
+		repo.Spec.Settings["base_path"] = "opensearch-v2"
+		_, err := (&OpenSearchSnapshotRepositoryController{Client: k8sClient, avnGen: avn}).Update(t.Context(), repo)
+		require.NoError(t, err)
+		assert.Equal(t, "opensearch-v2", put.Settings["base_path"])
+	})
+
+	t.Run("Delete unregisters the repository", func(t *testing.T) {
+		deleted := false
+		k8sClient, avn := newOpenSearchTestCluster(t, func(_ http.ResponseWriter, r *http.Request) {
+			deleted = r.Method == http.MethodDelete && r.URL.Path == repoPath
+		})
+
+		require.NoError(t, (&OpenSearchSnapshotRepositoryController{Client: k8sClient, avnGen: avn}).Delete(t.Context(), newRepository(t)))
+		assert.True(t, deleted)
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/applicationuser"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOrganizationApplicationUserReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OrganizationApplicationUser] {
+			return &OrganizationApplicationUserController{
+				Client: c.Client,
+				avnGen: avnGen,
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusers/finalizers,verbs=get;create;update
+
+// OrganizationApplicationUserController reconciles an OrganizationApplicationUser object.
+type OrganizationApplicationUserController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OrganizationApplicationUserController) Observe(ctx context.Context, cr *v1alpha1.OrganizationApplicationUser) (Observation, error) {
+	if cr.Status.UserID == "" {
+		// Adopts the application user with the same name, the ID is generated by Aiven
+		users, err := r.avnGen.ApplicationUsersList(ctx, cr.Spec.OrganizationID)
+		if err != nil {
+			return Observation{}, fmt.Errorf("listing organization application users: %w", err)
+		}
+		for _, u := range users {
+			if u.Name == cr.Spec.Name {
+				cr.Status.UserID = u.UserId
+				break
+			}
+		}
+		if cr.Status.UserID == "" {
+			return Observation{ResourceExists: false}, nil
+		}
+	}
+
+	got, err := r.avnGen.ApplicationUserGet(ctx, cr.Spec.OrganizationID, cr.Status.UserID)
+	if err != nil {
+		if isNotFound(err) {
+			cr.Status.UserID = ""
+			return Observation{ResourceExists: false}, nil
+		}
+		return Observation{}, fmt.Errorf("getting organization application user: %w", err)
+	}
+
+	cr.Status.Email = got.Email
+	upToDate := hasLatestGeneration(cr) &&
+		got.Name == cr.Spec.Name &&
+		got.IsSuperAdmin == cr.Spec.IsSuperAdmin
+	if upToDate {
+		markInstanceRunning(cr)
+	}
+
+	return Observation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *OrganizationApplicationUserController) Create(ctx context.Context, cr *v1alpha1.OrganizationApplicationUser) (CreateResult, error) {
+	in := &applicationuser.ApplicationUserCreateIn{
+		Name:         cr.Spec.Name,
+		IsSuperAdmin: &cr.Spec.IsSuperAdmin,
+	}
+
+	out, err := r.avnGen.ApplicationUserCreate(ctx, cr.Spec.OrganizationID, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("creating organization application user: %w", err)
+	}
+
+	cr.Status.UserID = out.UserId
+	cr.Status.Email = out.Email
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{}, nil
+}
+
+func (r *OrganizationApplicationUserController) Update(ctx context.Context, cr *v1alpha1.OrganizationApplicationUser) (UpdateResult, error) {
+	in := &applicationuser.ApplicationUserUpdateIn{
+		Name:         cr.Spec.Name,
+		IsSuperAdmin: &cr.Spec.IsSuperAdmin,
+	}
+
+	if _, err := r.avnGen.ApplicationUserUpdate(ctx, cr.Spec.OrganizationID, cr.Status.UserID, in); err != nil {
+		return UpdateResult{}, fmt.Errorf("updating organization application user: %w", err)
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return UpdateResult{}, nil
+}
+
+func (r *OrganizationApplicationUserController) Delete(ctx context.Context, cr *v1alpha1.OrganizationApplicationUser) error {
+	if cr.Status.UserID == "" {
+		return nil
+	}
+
+	err := r.avnGen.ApplicationUserDelete(ctx, cr.Spec.OrganizationID, cr.Status.UserID)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting organization application user: %w", err)
+	}
+	return nil
+}
+
+// getOrganizationApplicationUserID returns the ID of the referenced OrganizationApplicationUser.
+// The application user must belong to the same organization.
+func getOrganizationApplicationUserID(ctx context.Context, c client.Reader, namespace, organizationID string, ref *v1alpha1.ResourceReference) (string, error) {
+	key := ref.OrganizationApplicationUser(namespace).NamespacedName
+
+	user := &v1alpha1.OrganizationApplicationUser{}
+	if err := c.Get(ctx, key, user); err != nil {
+		return "", fmt.Errorf("cannot get OrganizationApplicationUser %q: %w", key, err)
+	}
+	if user.Spec.OrganizationID != organizationID {
+		return "", fmt.Errorf("OrganizationApplicationUser %q belongs to organization %q, not %q", key, user.Spec.OrganizationID, organizationID)
+	}
+	if user.Status.UserID == "" {
+		return "", fmt.Errorf("%w: OrganizationApplicationUser %q is not created yet", errPreconditionNotMet, key)
+	}
+	return user.Status.UserID, nil
+}
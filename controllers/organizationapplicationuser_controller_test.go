@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/applicationuser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOrganizationApplicationUserController(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userID = "u1a2b3c4d5e6"
+		email  = "kubernetes-operator@application-users.aiven.io"
+	)
+
+	newUser := func(t *testing.T) *v1alpha1.OrganizationApplicationUser {
+		t.Helper()
+		user := newObjectFromExampleYAML[v1alpha1.OrganizationApplicationUser](t, "organizationapplicationuser")
+		user.Namespace = "default"
+		user.Generation = 1
+		user.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return user
+	}
+
+	t.Run("Adopts the application user with the same name", func(t *testing.T) {
+		user := newUser(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUsersList(mock.Anything, user.Spec.OrganizationID).
+			Return([]applicationuser.ApplicationUserOut{
+				{UserId: "u0000", Name: "ci"},
+				{UserId: userID, Name: user.Spec.Name},
+			}, nil).Once()
+		avn.EXPECT().
+			ApplicationUserGet(mock.Anything, user.Spec.OrganizationID, userID).
+			Return(&applicationuser.ApplicationUserGetOut{UserId: userID, Name: user.Spec.Name, Email: email}, nil).Once()
+
+		obs, err := (&OrganizationApplicationUserController{avnGen: avn}).Observe(t.Context(), user)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+		assert.Equal(t, userID, user.Status.UserID)
+		assert.Equal(t, email, user.Status.Email)
+		assert.Equal(t, "true", user.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Creates the application user when there is none with the same name", func(t *testing.T) {
+		user := newUser(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUsersList(mock.Anything, user.Spec.OrganizationID).
+			Return([]applicationuser.ApplicationUserOut{{UserId: "u0000", Name: "ci"}}, nil).Once()
+		avn.EXPECT().
+			ApplicationUserCreate(mock.Anything, user.Spec.OrganizationID, mock.MatchedBy(func(in *applicationuser.ApplicationUserCreateIn) bool {
+				return in.Name == user.Spec.Name && in.IsSuperAdmin != nil && !*in.IsSuperAdmin
+			})).
+			Return(&applicationuser.ApplicationUserCreateOut{UserId: userID, Name: user.Spec.Name, Email: email}, nil).Once()
+
+		controller := &OrganizationApplicationUserController{avnGen: avn}
+		obs, err := controller.Observe(t.Context(), user)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), user)
+		require.NoError(t, err)
+		assert.Equal(t, userID, user.Status.UserID)
+		assert.Equal(t, email, user.Status.Email)
+		assert.True(t, meta.IsStatusConditionTrue(user.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Application user deleted outside Kubernetes is created again", func(t *testing.T) {
+		user := newUser(t)
+		user.Status.UserID = userID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUserGet(mock.Anything, user.Spec.OrganizationID, userID).
+			Return(nil, newAivenError(404, "application user not found")).Once()
+
+		obs, err := (&OrganizationApplicationUserController{avnGen: avn}).Observe(t.Context(), user)
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceExists)
+		assert.Empty(t, user.Status.UserID)
+	})
+
+	t.Run("Changed super admin flag is updated", func(t *testing.T) {
+		user := newUser(t)
+		user.Status.UserID = userID
+		user.Spec.IsSuperAdmin = true
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUserGet(mock.Anything, user.Spec.OrganizationID, userID).
+			Return(&applicationuser.ApplicationUserGetOut{UserId: userID, Name: user.Spec.Name, Email: email}, nil).Once()
+		avn.EXPECT().
+			ApplicationUserUpdate(mock.Anything, user.Spec.OrganizationID, userID, mock.MatchedBy(func(in *applicationuser.ApplicationUserUpdateIn) bool {
+				return in.Name == user.Spec.Name && in.IsSuperAdmin != nil && *in.IsSuperAdmin
+			})).
+			Return(&applicationuser.ApplicationUserUpdateOut{}, nil).Once()
+
+		controller := &OrganizationApplicationUserController{avnGen: avn}
+		obs, err := controller.Observe(t.Context(), user)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), user)
+		require.NoError(t, err)
+		assert.True(t, meta.IsStatusConditionTrue(user.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Delete removes the application user", func(t *testing.T) {
+		user := newUser(t)
+		user.Status.UserID = userID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUserDelete(mock.Anything, user.Spec.OrganizationID, userID).
+			Return(nil).Once()
+
+		require.NoError(t, (&OrganizationApplicationUserController{avnGen: avn}).Delete(t.Context(), user))
+	})
+
+	t.Run("Delete of an application user already removed is a no-op", func(t *testing.T) {
+		user := newUser(t)
+		user.Status.UserID = userID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUserDelete(mock.Anything, user.Spec.OrganizationID, userID).
+			Return(newAivenError(404, "application user not found")).Once()
+
+		require.NoError(t, (&OrganizationApplicationUserController{avnGen: avn}).Delete(t.Context(), user))
+	})
+
+	t.Run("Delete of an application user never created is a no-op", func(t *testing.T) {
+		require.NoError(t, (&OrganizationApplicationUserController{avnGen: avngen.NewMockClient(t)}).Delete(t.Context(), newUser(t)))
+	})
+}
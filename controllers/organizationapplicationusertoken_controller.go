@@ -0,0 +1,340 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/applicationuser"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// rotateTokenAnnotation rotates the token once when set to a new value.
+	rotateTokenAnnotation = "controllers.aiven.io/rotate-token"
+
+	eventTokenRotated = "TokenRotated"
+	eventTokenRevoked = "TokenRevoked"
+)
+
+func newOrganizationApplicationUserTokenReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OrganizationApplicationUserToken] {
+			return &OrganizationApplicationUserTokenController{
+				Client: c.Client,
+				avnGen: avnGen,
+				rec:    c.Recorder,
+				gc:     &SecretFinalizerGCController{Client: c.Client, Log: c.Log},
+
+				operatorToken: c.hasDefaultToken(),
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusertokens,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusertokens/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationapplicationusertokens/finalizers,verbs=get;create;update
+
+// OrganizationApplicationUserTokenController reconciles an OrganizationApplicationUserToken object.
+// The resources that use the token secret are found with the indexes of SecretFinalizerGCController.
+type OrganizationApplicationUserTokenController struct {
+	client.Client
+	avnGen avngen.Client
+	rec    record.EventRecorder
+	gc     *SecretFinalizerGCController
+
+	// operatorToken is true when the resources use the operator token instead of their auth secrets
+	operatorToken bool
+}
+
+func (r *OrganizationApplicationUserTokenController) Observe(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (Observation, error) {
+	userID, err := r.getUserID(ctx, cr)
+	if err != nil {
+		return Observation{}, err
+	}
+	cr.Status.UserID = userID
+
+	if cr.Status.TokenPrefix == "" {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	tokens, err := r.avnGen.ApplicationUserAccessTokensList(ctx, cr.Spec.OrganizationID, userID)
+	if err != nil {
+		return Observation{}, fmt.Errorf("listing application user tokens: %w", err)
+	}
+	active := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		active[t.TokenPrefix] = true
+	}
+
+	// Expired or revoked outside the operator
+	cr.Status.PreviousTokenPrefixes = slices.DeleteFunc(cr.Status.PreviousTokenPrefixes, func(prefix string) bool {
+		return !active[prefix]
+	})
+	if !active[cr.Status.TokenPrefix] {
+		cr.Status.TokenPrefix = ""
+		cr.Status.TokenFingerprint = ""
+		return Observation{ResourceExists: false}, nil
+	}
+
+	published, err := r.isTokenPublished(ctx, cr)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	now := time.Now()
+	revoke, err := r.canRevokePreviousTokens(ctx, cr, now)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	markInstanceRunning(cr)
+
+	// The spec changes are handled by rotations, the token itself can't be changed.
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: published && !revoke && !isTokenRotationDue(cr, now),
+	}, nil
+}
+
+func (r *OrganizationApplicationUserTokenController) Create(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (CreateResult, error) {
+	details, err := r.createToken(ctx, cr)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	// The rotation interval starts from the creation of the token
+	cr.Status.LastRotatedAt = &metav1.Time{Time: time.Now()}
+	cr.Status.LastRotationRequest = cr.GetAnnotations()[rotateTokenAnnotation]
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{SecretDetails: details}, nil
+}
+
+func (r *OrganizationApplicationUserTokenController) Update(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (UpdateResult, error) {
+	now := time.Now()
+	revoke, err := r.canRevokePreviousTokens(ctx, cr, now)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+	if revoke {
+		if err := r.revokeTokens(ctx, cr, cr.Status.PreviousTokenPrefixes); err != nil {
+			return UpdateResult{}, err
+		}
+		cr.Status.PreviousTokenPrefixes = nil
+	}
+
+	published, err := r.isTokenPublished(ctx, cr)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+
+	var details SecretDetails
+	if !published || isTokenRotationDue(cr, now) {
+		// The current token stays valid until the resources that use it have the new one
+		previous := cr.Status.TokenPrefix
+		details, err = r.createToken(ctx, cr)
+		if err != nil {
+			return UpdateResult{}, err
+		}
+		if previous != "" {
+			cr.Status.PreviousTokenPrefixes = append(cr.Status.PreviousTokenPrefixes, previous)
+		}
+		markTokenRotated(r.rec, cr, now)
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return UpdateResult{SecretDetails: details}, nil
+}
+
+func (r *OrganizationApplicationUserTokenController) Delete(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) error {
+	if cr.Status.UserID == "" {
+		return nil
+	}
+
+	dependants, err := r.secretDependants(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	// The resources need the token to delete their Aiven resources
+	names := make([]string, 0, len(dependants))
+	for _, o := range dependants {
+		if o.GetUID() != cr.GetUID() {
+			names = append(names, o.GetNamespace()+"/"+o.GetName())
+		}
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("%w: the token secret is used by %s", v1alpha1.ErrDeleteDependencies, strings.Join(names, ", "))
+	}
+
+	prefixes := slices.Clone(cr.Status.PreviousTokenPrefixes)
+	if cr.Status.TokenPrefix != "" {
+		prefixes = append(prefixes, cr.Status.TokenPrefix)
+	}
+	return r.revokeTokens(ctx, cr, prefixes)
+}
+
+func (r *OrganizationApplicationUserTokenController) getUserID(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (string, error) {
+	if cr.Spec.ApplicationUserRef == nil {
+		return cr.Spec.UserID, nil
+	}
+	return getOrganizationApplicationUserID(ctx, r.Client, cr.Namespace, cr.Spec.OrganizationID, cr.Spec.ApplicationUserRef)
+}
+
+// createToken creates a new current token and returns the secret details with it
+func (r *OrganizationApplicationUserTokenController) createToken(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (SecretDetails, error) {
+	in := &applicationuser.ApplicationUserAccessTokenCreateIn{
+		Description:    cr.Spec.Description,
+		ExtendWhenUsed: &cr.Spec.ExtendWhenUsed,
+		MaxAgeSeconds:  cr.Spec.MaxAgeSeconds,
+	}
+	if len(cr.Spec.Scopes) > 0 {
+		in.Scopes = &cr.Spec.Scopes
+	}
+	if len(cr.Spec.IPAllowlist) > 0 {
+		in.IpAllowlist = &cr.Spec.IPAllowlist
+	}
+
+	out, err := r.avnGen.ApplicationUserAccessTokenCreate(ctx, cr.Spec.OrganizationID, cr.Status.UserID, in)
+	if err != nil {
+		return nil, fmt.Errorf("creating application user token: %w", err)
+	}
+
+	cr.Status.TokenPrefix = out.TokenPrefix
+	cr.Status.TokenFingerprint = tokenFingerprint([]byte(out.FullToken))
+	return SecretDetails{getSecretPrefix(cr) + "TOKEN": out.FullToken}, nil
+}
+
+// revokeTokens revokes the tokens, the tokens that are already gone are skipped
+func (r *OrganizationApplicationUserTokenController) revokeTokens(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken, prefixes []string) error {
+	for _, prefix := range prefixes {
+		err := r.avnGen.ApplicationUserAccessTokenDelete(ctx, cr.Spec.OrganizationID, cr.Status.UserID, prefix)
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("revoking application user token %q: %w", prefix, err)
+		}
+		r.rec.Eventf(cr, corev1.EventTypeNormal, eventTokenRevoked, "token %q revoked", prefix)
+	}
+	return nil
+}
+
+// isTokenPublished returns true when the connection secret has the current token.
+// The token can't be read from Aiven again, a missing or changed secret is fixed with a new token.
+func (r *OrganizationApplicationUserTokenController) isTokenPublished(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) (bool, error) {
+	if cr.NoSecret() || !usesKubernetesSecretSink(cr) {
+		return true, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: connectionSecretName(cr), Namespace: cr.Namespace}, secret)
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("cannot get connection secret: %w", err)
+	}
+	return tokenFingerprint(secret.Data[getSecretPrefix(cr)+"TOKEN"]) == cr.Status.TokenFingerprint, nil
+}
+
+// canRevokePreviousTokens returns true when the rotation overlap has passed
+// and all resources that use the connection secret have reconciled with the current token.
+// The resources that still have the previous token are queued for reconciliation.
+func (r *OrganizationApplicationUserTokenController) canRevokePreviousTokens(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken, now time.Time) (bool, error) {
+	if len(cr.Status.PreviousTokenPrefixes) == 0 {
+		return false, nil
+	}
+
+	if rotation := cr.Spec.Rotation; rotation != nil && rotation.MinOverlap != nil && cr.Status.LastRotatedAt != nil {
+		if now.Before(cr.Status.LastRotatedAt.Add(rotation.MinOverlap.Duration)) {
+			return false, nil
+		}
+	}
+
+	dependants, err := r.secretDependants(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+
+	waiting := 0
+	for _, o := range dependants {
+		fingerprint, ok := o.GetAnnotations()[authTokenAnnotation]
+		if fingerprint == cr.Status.TokenFingerprint {
+			continue
+		}
+		waiting++
+
+		// Removing the stale fingerprint triggers a reconcile, the resources that are ready skip it otherwise
+		if ok {
+			patch := client.MergeFrom(o.DeepCopyObject().(client.Object))
+			delete(o.GetAnnotations(), authTokenAnnotation)
+			if err := r.Patch(ctx, o, patch); client.IgnoreNotFound(err) != nil && !apierrors.IsConflict(err) {
+				return false, fmt.Errorf("cannot queue %s/%s for reconciliation: %w", o.GetNamespace(), o.GetName(), err)
+			}
+		}
+	}
+
+	if waiting > 0 {
+		logr.FromContextOrDiscard(ctx).Info("waiting for resources to reconcile with the new token", "count", waiting)
+		return false, nil
+	}
+	return true, nil
+}
+
+// secretDependants returns the resources that use the connection secret of the token.
+// None do with the operator token, they are reconciled with it instead.
+func (r *OrganizationApplicationUserTokenController) secretDependants(ctx context.Context, cr *v1alpha1.OrganizationApplicationUserToken) ([]v1alpha1.AivenManagedObject, error) {
+	if cr.NoSecret() || !usesKubernetesSecretSink(cr) || r.operatorToken {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: connectionSecretName(cr), Namespace: cr.Namespace}}
+	return r.gc.secretDependants(ctx, secret)
+}
+
+// isTokenRotationDue returns true when the rotate-token annotation has a new value,
+// or when the rotation interval has passed and the maintenance window is open.
+func isTokenRotationDue(cr *v1alpha1.OrganizationApplicationUserToken, now time.Time) bool {
+	if req := cr.GetAnnotations()[rotateTokenAnnotation]; req != "" && req != cr.Status.LastRotationRequest {
+		return true
+	}
+
+	rotation := cr.Spec.Rotation
+	if rotation == nil {
+		return false
+	}
+
+	last := cr.GetCreationTimestamp().Time
+	if cr.Status.LastRotatedAt != nil {
+		last = cr.Status.LastRotatedAt.Time
+	}
+	if now.Before(last.Add(rotation.Interval.Duration)) {
+		return false
+	}
+	return isRotationWindowOpen(rotation.MaintenanceWindow, now)
+}
+
+// markTokenRotated records the rotation in the status and emits an event.
+func markTokenRotated(rec record.EventRecorder, cr *v1alpha1.OrganizationApplicationUserToken, now time.Time) {
+	cr.Status.LastRotatedAt = &metav1.Time{Time: now}
+	cr.Status.LastRotationRequest = cr.GetAnnotations()[rotateTokenAnnotation]
+	rec.Event(cr, corev1.EventTypeNormal, eventTokenRotated, "token rotated")
+}
@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/applicationuser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOrganizationApplicationUserTokenController(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userID      = "u1a2b3c4d5e6"
+		newToken    = "new-full-token"
+		oldToken    = "old-full-token"
+		newPrefix   = "new-prefix"
+		oldPrefix   = "old-prefix"
+		tokenSecret = "operator-token"
+	)
+
+	newController := func(t *testing.T, avn avngen.Client, objects ...client.Object) *OrganizationApplicationUserTokenController {
+		t.Helper()
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+		instanceTypes := (&SecretFinalizerGCController{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}).knownInstanceTypes()
+		builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...)
+		for _, obj := range instanceTypes {
+			builder.WithIndex(obj, secretRefIndexKey, secretRefIndexFunc)
+			builder.WithIndex(obj, credentialsRefIndexKey, credentialsRefIndexFunc)
+		}
+		builder.WithIndex(&v1alpha1.AivenCredentials{}, credentialsSecretIndexKey, credentialsSecretIndexFunc)
+		builder.WithIndex(&v1alpha1.AivenNamespaceCredentials{}, credentialsSecretIndexKey, credentialsSecretIndexFunc)
+
+		k8s := builder.Build()
+		return &OrganizationApplicationUserTokenController{
+			Client: k8s,
+			avnGen: avn,
+			rec:    record.NewFakeRecorder(10),
+			gc:     &SecretFinalizerGCController{Client: k8s},
+		}
+	}
+
+	// Returns the token rotated from oldToken to newToken, the user and a topic that uses the token secret
+	newObjects := func(t *testing.T) (*v1alpha1.OrganizationApplicationUserToken, *v1alpha1.OrganizationApplicationUser, *v1alpha1.KafkaTopic, *corev1.Secret) {
+		t.Helper()
+		const example = "organizationapplicationusertoken"
+
+		token := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationApplicationUserToken](t, example, "OrganizationApplicationUserToken")
+		token.Namespace = "default"
+		token.Status.UserID = userID
+		token.Status.TokenPrefix = newPrefix
+		token.Status.TokenFingerprint = tokenFingerprint([]byte(newToken))
+		token.Status.PreviousTokenPrefixes = []string{oldPrefix}
+		token.Status.LastRotatedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+
+		user := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationApplicationUser](t, example, "OrganizationApplicationUser")
+		user.Namespace = "default"
+		user.Status.UserID = userID
+
+		topic := newObjectFromExampleYAMLByKind[v1alpha1.KafkaTopic](t, example, "KafkaTopic")
+		topic.Namespace = "default"
+		topic.Annotations = map[string]string{authTokenAnnotation: tokenFingerprint([]byte(oldToken))}
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: tokenSecret, Namespace: "default"},
+			Data:       map[string][]byte{"AIVEN_TOKEN": []byte(newToken)},
+		}
+		return token, user, topic, secret
+	}
+
+	listTokens := func(avn *avngen.MockClient, prefixes ...string) {
+		tokens := make([]applicationuser.TokenOut, 0, len(prefixes))
+		for _, p := range prefixes {
+			tokens = append(tokens, applicationuser.TokenOut{TokenPrefix: p})
+		}
+		avn.EXPECT().
+			ApplicationUserAccessTokensList(mock.Anything, "org1a2b3c4d5e6", userID).
+			Return(tokens, nil).Once()
+	}
+
+	t.Run("Creates the token and publishes it to the secret key", func(t *testing.T) {
+		token, user, _, _ := newObjects(t)
+		token.Status = v1alpha1.OrganizationApplicationUserTokenStatus{}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			ApplicationUserAccessTokenCreate(mock.Anything, "org1a2b3c4d5e6", userID, mock.MatchedBy(func(in *applicationuser.ApplicationUserAccessTokenCreateIn) bool {
+				return in.Description == token.Spec.Description
+			})).
+			Return(&applicationuser.ApplicationUserAccessTokenCreateOut{FullToken: newToken, TokenPrefix: newPrefix}, nil).Once()
+
+		c := newController(t, avn, user)
+		obs, err := c.Observe(t.Context(), token)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		res, err := c.Create(t.Context(), token)
+		require.NoError(t, err)
+		assert.Equal(t, SecretDetails{"AIVEN_TOKEN": newToken}, res.SecretDetails)
+		assert.Equal(t, newPrefix, token.Status.TokenPrefix)
+		assert.Equal(t, tokenFingerprint([]byte(newToken)), token.Status.TokenFingerprint)
+		assert.NotNil(t, token.Status.LastRotatedAt)
+	})
+
+	t.Run("Keeps the previous token until the dependants have the new one", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		listTokens(avn, newPrefix, oldPrefix)
+
+		c := newController(t, avn, user, topic, secret)
+		obs, err := c.Observe(t.Context(), token)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+		assert.Equal(t, []string{oldPrefix}, token.Status.PreviousTokenPrefixes)
+
+		// The stale fingerprint is removed to queue the topic
+		got := &v1alpha1.KafkaTopic{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(topic), got))
+		assert.NotContains(t, got.Annotations, authTokenAnnotation)
+	})
+
+	t.Run("Revokes the previous token once the dependants have the new one", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+		topic.Annotations[authTokenAnnotation] = token.Status.TokenFingerprint
+
+		avn := avngen.NewMockClient(t)
+		listTokens(avn, newPrefix, oldPrefix)
+		avn.EXPECT().
+			ApplicationUserAccessTokenDelete(mock.Anything, "org1a2b3c4d5e6", userID, oldPrefix).
+			Return(nil).Once()
+
+		c := newController(t, avn, user, topic, secret)
+		obs, err := c.Observe(t.Context(), token)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		res, err := c.Update(t.Context(), token)
+		require.NoError(t, err)
+		assert.Empty(t, res.SecretDetails)
+		assert.Empty(t, token.Status.PreviousTokenPrefixes)
+		assert.Equal(t, newPrefix, token.Status.TokenPrefix)
+	})
+
+	t.Run("Revokes the previous token when the resources use the operator token", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		listTokens(avn, newPrefix, oldPrefix)
+		avn.EXPECT().
+			ApplicationUserAccessTokenDelete(mock.Anything, "org1a2b3c4d5e6", userID, oldPrefix).
+			Return(nil).Once()
+
+		c := newController(t, avn, user, topic, secret)
+		c.operatorToken = true
+		obs, err := c.Observe(t.Context(), token)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = c.Update(t.Context(), token)
+		require.NoError(t, err)
+		assert.Empty(t, token.Status.PreviousTokenPrefixes)
+	})
+
+	t.Run("Waits for the minimum overlap", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+		topic.Annotations[authTokenAnnotation] = token.Status.TokenFingerprint
+		token.Status.LastRotatedAt = &metav1.Time{Time: time.Now()}
+
+		avn := avngen.NewMockClient(t)
+		listTokens(avn, newPrefix, oldPrefix)
+
+		obs, err := newController(t, avn, user, topic, secret).Observe(t.Context(), token)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceUpToDate)
+	})
+
+	t.Run("Rotates the token when the secret has lost it", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+		token.Status.PreviousTokenPrefixes = nil
+		secret.Data["AIVEN_TOKEN"] = []byte("edited")
+
+		avn := avngen.NewMockClient(t)
+		listTokens(avn, newPrefix)
+		avn.EXPECT().
+			ApplicationUserAccessTokenCreate(mock.Anything, "org1a2b3c4d5e6", userID, mock.Anything).
+			Return(&applicationuser.ApplicationUserAccessTokenCreateOut{FullToken: "rotated", TokenPrefix: "rotated-prefix"}, nil).Once()
+
+		c := newController(t, avn, user, topic, secret)
+		obs, err := c.Observe(t.Context(), token)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		res, err := c.Update(t.Context(), token)
+		require.NoError(t, err)
+		assert.Equal(t, SecretDetails{"AIVEN_TOKEN": "rotated"}, res.SecretDetails)
+		assert.Equal(t, "rotated-prefix", token.Status.TokenPrefix)
+		assert.Equal(t, []string{newPrefix}, token.Status.PreviousTokenPrefixes)
+	})
+
+	t.Run("Doesn't revoke the token used by other resources", func(t *testing.T) {
+		token, user, topic, secret := newObjects(t)
+
+		err := newController(t, avngen.NewMockClient(t), user, topic, secret).Delete(t.Context(), token)
+		require.ErrorIs(t, err, v1alpha1.ErrDeleteDependencies)
+		assert.ErrorContains(t, err, "default/my-topic")
+	})
+
+	t.Run("Revokes all tokens on deletion", func(t *testing.T) {
+		token, user, _, secret := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().ApplicationUserAccessTokenDelete(mock.Anything, "org1a2b3c4d5e6", userID, oldPrefix).Return(nil).Once()
+		avn.EXPECT().ApplicationUserAccessTokenDelete(mock.Anything, "org1a2b3c4d5e6", userID, newPrefix).Return(nil).Once()
+
+		require.NoError(t, newController(t, avn, user, secret).Delete(t.Context(), token))
+	})
+}
+
+func TestIsTokenRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	token := &v1alpha1.OrganizationApplicationUserToken{}
+	token.CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Hour))
+	assert.False(t, isTokenRotationDue(token, now))
+
+	token.Spec.Rotation = &v1alpha1.TokenRotation{Interval: metav1.Duration{Duration: time.Hour}}
+	assert.True(t, isTokenRotationDue(token, now))
+
+	token.Status.LastRotatedAt = &metav1.Time{Time: now.Add(-time.Minute)}
+	assert.False(t, isTokenRotationDue(token, now))
+
+	token.Annotations = map[string]string{rotateTokenAnnotation: "now"}
+	assert.True(t, isTokenRotationDue(token, now))
+
+	token.Status.LastRotationRequest = "now"
+	assert.False(t, isTokenRotationDue(token, now))
+}
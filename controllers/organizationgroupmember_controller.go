@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/usergroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOrganizationGroupMemberReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OrganizationGroupMember] {
+			return &OrganizationGroupMemberController{
+				Client: c.Client,
+				avnGen: avnGen,
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationgroupmembers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationgroupmembers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationgroupmembers/finalizers,verbs=get;create;update
+
+// OrganizationGroupMemberController reconciles an OrganizationGroupMember object.
+type OrganizationGroupMemberController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OrganizationGroupMemberController) Observe(ctx context.Context, cr *v1alpha1.OrganizationGroupMember) (Observation, error) {
+	groupID, err := r.userGroupID(ctx, cr)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	members, err := r.avnGen.UserGroupMemberList(ctx, cr.Spec.OrganizationID, groupID)
+	if err != nil {
+		if isNotFound(err) {
+			return Observation{}, fmt.Errorf("%w: user group %q not found: %w", errPreconditionNotMet, groupID, err)
+		}
+		return Observation{}, fmt.Errorf("listing organization user group members: %w", err)
+	}
+
+	for _, m := range members {
+		if m.UserId == cr.Spec.UserID {
+			markInstanceRunning(cr)
+			return Observation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+	}
+	return Observation{ResourceExists: false}, nil
+}
+
+func (r *OrganizationGroupMemberController) Create(ctx context.Context, cr *v1alpha1.OrganizationGroupMember) (CreateResult, error) {
+	if err := r.updateMembers(ctx, cr, usergroup.OperationTypeAddMembers); err != nil {
+		return CreateResult{}, fmt.Errorf("adding organization user group member: %w", err)
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{}, nil
+}
+
+// Update is never called: all fields are immutable and the membership has no other state.
+func (r *OrganizationGroupMemberController) Update(_ context.Context, _ *v1alpha1.OrganizationGroupMember) (UpdateResult, error) {
+	return UpdateResult{}, nil
+}
+
+func (r *OrganizationGroupMemberController) Delete(ctx context.Context, cr *v1alpha1.OrganizationGroupMember) error {
+	err := r.updateMembers(ctx, cr, usergroup.OperationTypeRemoveMembers)
+	if err != nil && !isNotFound(err) && !apierrors.IsNotFound(err) && !errors.Is(err, errPreconditionNotMet) {
+		return fmt.Errorf("removing organization user group member: %w", err)
+	}
+	return nil
+}
+
+func (r *OrganizationGroupMemberController) updateMembers(ctx context.Context, cr *v1alpha1.OrganizationGroupMember, op usergroup.OperationType) error {
+	groupID, err := r.userGroupID(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	in := &usergroup.UserGroupMembersUpdateIn{
+		MemberIds: []string{cr.Spec.UserID},
+		Operation: op,
+	}
+	return r.avnGen.UserGroupMembersUpdate(ctx, cr.Spec.OrganizationID, groupID, in)
+}
+
+func (r *OrganizationGroupMemberController) userGroupID(ctx context.Context, cr *v1alpha1.OrganizationGroupMember) (string, error) {
+	if cr.Spec.UserGroupRef == nil {
+		return cr.Spec.UserGroupID, nil
+	}
+	return getOrganizationUserGroupID(ctx, r.Client, cr.Namespace, cr.Spec.OrganizationID, cr.Spec.UserGroupRef)
+}
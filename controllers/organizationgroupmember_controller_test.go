@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/usergroup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOrganizationGroupMemberController(t *testing.T) {
+	t.Parallel()
+
+	const groupID = "ug1a2b3c4d5e6"
+
+	newController := func(t *testing.T, avn avngen.Client, objects ...client.Object) *OrganizationGroupMemberController {
+		t.Helper()
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+		k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		return &OrganizationGroupMemberController{Client: k8s, avnGen: avn}
+	}
+
+	newObjects := func(t *testing.T) (*v1alpha1.OrganizationGroupMember, *v1alpha1.OrganizationUserGroup) {
+		t.Helper()
+		member := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationGroupMember](t, "organizationgroupmember", "OrganizationGroupMember")
+		member.Namespace = "default"
+		group := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationUserGroup](t, "organizationgroupmember", "OrganizationUserGroup")
+		group.Namespace = "default"
+		group.Status.ID = groupID
+		return member, group
+	}
+
+	membersUpdate := func(op usergroup.OperationType) *usergroup.UserGroupMembersUpdateIn {
+		return &usergroup.UserGroupMembersUpdateIn{MemberIds: []string{"u1a2b3c4d5e6"}, Operation: op}
+	}
+
+	t.Run("Missing member is added to the group", func(t *testing.T) {
+		member, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupMemberList(mock.Anything, member.Spec.OrganizationID, groupID).
+			Return([]usergroup.MemberOut{{UserId: "u0000"}}, nil).Once()
+		avn.EXPECT().
+			UserGroupMembersUpdate(mock.Anything, member.Spec.OrganizationID, groupID, membersUpdate(usergroup.OperationTypeAddMembers)).
+			Return(nil).Once()
+
+		c := newController(t, avn, group)
+		obs, err := c.Observe(t.Context(), member)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = c.Create(t.Context(), member)
+		require.NoError(t, err)
+		assert.True(t, meta.IsStatusConditionTrue(member.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Existing member is up to date", func(t *testing.T) {
+		member, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupMemberList(mock.Anything, member.Spec.OrganizationID, groupID).
+			Return([]usergroup.MemberOut{{UserId: "u0000"}, {UserId: member.Spec.UserID}}, nil).Once()
+
+		obs, err := newController(t, avn, group).Observe(t.Context(), member)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+	})
+
+	t.Run("User not in the organization is an error", func(t *testing.T) {
+		member, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupMembersUpdate(mock.Anything, member.Spec.OrganizationID, groupID, membersUpdate(usergroup.OperationTypeAddMembers)).
+			Return(newAivenError(400, "User u1a2b3c4d5e6 is not a member of the organization")).Once()
+
+		_, err := newController(t, avn, group).Create(t.Context(), member)
+		require.ErrorContains(t, err, "is not a member of the organization")
+		assert.False(t, meta.IsStatusConditionTrue(member.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Waits for the user group to be created", func(t *testing.T) {
+		member, group := newObjects(t)
+		group.Status.ID = ""
+
+		_, err := newController(t, avngen.NewMockClient(t), group).Observe(t.Context(), member)
+		assert.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Rejects a user group of another organization", func(t *testing.T) {
+		member, group := newObjects(t)
+		group.Spec.OrganizationID = "org0000"
+
+		_, err := newController(t, avngen.NewMockClient(t), group).Observe(t.Context(), member)
+		assert.ErrorContains(t, err, `belongs to organization "org0000"`)
+	})
+
+	t.Run("Delete removes the member from the group", func(t *testing.T) {
+		member, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupMembersUpdate(mock.Anything, member.Spec.OrganizationID, groupID, membersUpdate(usergroup.OperationTypeRemoveMembers)).
+			Return(nil).Once()
+
+		require.NoError(t, newController(t, avn, group).Delete(t.Context(), member))
+	})
+
+	t.Run("Delete of a member already removed is a no-op", func(t *testing.T) {
+		member, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupMembersUpdate(mock.Anything, member.Spec.OrganizationID, groupID, membersUpdate(usergroup.OperationTypeRemoveMembers)).
+			Return(newAivenError(404, "user not found")).Once()
+
+		require.NoError(t, newController(t, avn, group).Delete(t.Context(), member))
+	})
+
+	t.Run("Delete after the user group is gone is a no-op", func(t *testing.T) {
+		member, _ := newObjects(t)
+
+		require.NoError(t, newController(t, avngen.NewMockClient(t)).Delete(t.Context(), member))
+	})
+}
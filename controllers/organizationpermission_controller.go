@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/organization"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOrganizationPermissionReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OrganizationPermission] {
+			return &OrganizationPermissionController{
+				Client: c.Client,
+				avnGen: avnGen,
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationpermissions,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationpermissions/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationpermissions/finalizers,verbs=get;create;update
+
+// OrganizationPermissionController reconciles an OrganizationPermission object.
+type OrganizationPermissionController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OrganizationPermissionController) Observe(ctx context.Context, cr *v1alpha1.OrganizationPermission) (Observation, error) {
+	desired, err := r.desiredPermissions(ctx, cr)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	got, err := r.avnGen.PermissionsGet(ctx, cr.Spec.OrganizationID, organization.ResourceType(cr.Spec.ResourceType), cr.Spec.ResourceID)
+	if err != nil {
+		if isNotFound(err) {
+			return Observation{}, fmt.Errorf("%w: %s %q not found: %w", errPreconditionNotMet, cr.Spec.ResourceType, cr.Spec.ResourceID, err)
+		}
+		return Observation{}, fmt.Errorf("getting organization permissions: %w", err)
+	}
+
+	actual := make([]organization.PermissionIn, len(got))
+	for i, p := range got {
+		actual[i] = organization.PermissionIn{
+			PrincipalType: p.PrincipalType,
+			PrincipalId:   p.PrincipalId,
+			Permissions:   p.Permissions,
+		}
+	}
+
+	// The permissions are a full set: a resource that was never applied still has to replace the remote bindings
+	exists := wasEverApplied(cr)
+	upToDate := exists && hasLatestGeneration(cr) && organizationPermissionsEqual(desired, actual)
+	if upToDate {
+		// Resources applied before the applied bindings were stored don't set them again
+		setAppliedOrganizationPermissions(cr, desired)
+		markInstanceRunning(cr)
+	}
+	return Observation{ResourceExists: exists, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *OrganizationPermissionController) Create(ctx context.Context, cr *v1alpha1.OrganizationPermission) (CreateResult, error) {
+	if err := r.setPermissions(ctx, cr); err != nil {
+		return CreateResult{}, err
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{}, nil
+}
+
+func (r *OrganizationPermissionController) Update(ctx context.Context, cr *v1alpha1.OrganizationPermission) (UpdateResult, error) {
+	if err := r.setPermissions(ctx, cr); err != nil {
+		return UpdateResult{}, err
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return UpdateResult{}, nil
+}
+
+// Delete revokes the permissions applied by the resource, or all permissions of the resource with revokeAllOnDelete
+func (r *OrganizationPermissionController) Delete(ctx context.Context, cr *v1alpha1.OrganizationPermission) error {
+	resourceType := organization.ResourceType(cr.Spec.ResourceType)
+	remaining := []organization.PermissionIn{}
+	if !cr.Spec.RevokeAllOnDelete {
+		if len(cr.Status.AppliedPermissions) == 0 {
+			return nil
+		}
+
+		got, err := r.avnGen.PermissionsGet(ctx, cr.Spec.OrganizationID, resourceType, cr.Spec.ResourceID)
+		if isNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting organization permissions: %w", err)
+		}
+		remaining = withoutAppliedOrganizationPermissions(got, cr.Status.AppliedPermissions)
+	}
+
+	in := &organization.PermissionsSetIn{Permissions: remaining}
+	err := r.avnGen.PermissionsSet(ctx, cr.Spec.OrganizationID, resourceType, cr.Spec.ResourceID, in)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("revoking organization permissions: %w", err)
+	}
+	return nil
+}
+
+func (r *OrganizationPermissionController) setPermissions(ctx context.Context, cr *v1alpha1.OrganizationPermission) error {
+	desired, err := r.desiredPermissions(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	in := &organization.PermissionsSetIn{Permissions: desired}
+	err = r.avnGen.PermissionsSet(ctx, cr.Spec.OrganizationID, organization.ResourceType(cr.Spec.ResourceType), cr.Spec.ResourceID, in)
+	if err != nil {
+		return fmt.Errorf("setting organization permissions: %w", err)
+	}
+
+	setAppliedOrganizationPermissions(cr, desired)
+	return nil
+}
+
+func setAppliedOrganizationPermissions(cr *v1alpha1.OrganizationPermission, bindings []organization.PermissionIn) {
+	cr.Status.AppliedPermissions = make([]v1alpha1.OrganizationPermissionAppliedBinding, len(bindings))
+	for i, b := range bindings {
+		cr.Status.AppliedPermissions[i] = v1alpha1.OrganizationPermissionAppliedBinding{
+			PrincipalType: string(b.PrincipalType),
+			PrincipalID:   b.PrincipalId,
+			Permissions:   slices.Clone(b.Permissions),
+		}
+	}
+}
+
+// withoutAppliedOrganizationPermissions returns the remote bindings without the applied permissions,
+// so the permissions granted outside Kubernetes are kept.
+func withoutAppliedOrganizationPermissions(remote []organization.PermissionOut, applied []v1alpha1.OrganizationPermissionAppliedBinding) []organization.PermissionIn {
+	revoke := make(map[string][]string, len(applied))
+	for _, b := range applied {
+		key := b.PrincipalType + "/" + b.PrincipalID
+		revoke[key] = append(revoke[key], b.Permissions...)
+	}
+
+	result := []organization.PermissionIn{}
+	for _, b := range remote {
+		key := string(b.PrincipalType) + "/" + b.PrincipalId
+		permissions := slices.DeleteFunc(slices.Clone(b.Permissions), func(p string) bool {
+			return slices.Contains(revoke[key], p)
+		})
+		if len(permissions) > 0 {
+			result = append(result, organization.PermissionIn{
+				PrincipalType: b.PrincipalType,
+				PrincipalId:   b.PrincipalId,
+				Permissions:   permissions,
+			})
+		}
+	}
+	return result
+}
+
+// desiredPermissions returns the bindings of the spec with the user group references resolved to IDs
+func (r *OrganizationPermissionController) desiredPermissions(ctx context.Context, cr *v1alpha1.OrganizationPermission) ([]organization.PermissionIn, error) {
+	result := make([]organization.PermissionIn, 0, len(cr.Spec.Permissions))
+	index := make(map[string]int, len(cr.Spec.Permissions))
+	for _, p := range cr.Spec.Permissions {
+		principalID := p.PrincipalID
+		if p.UserGroupRef != nil {
+			id, err := getOrganizationUserGroupID(ctx, r.Client, cr.Namespace, cr.Spec.OrganizationID, p.UserGroupRef)
+			if err != nil {
+				return nil, err
+			}
+			principalID = id
+		}
+
+		// Merges the bindings of the same principal, e.g. a group given by ID and by reference
+		key := p.PrincipalType + "/" + principalID
+		if i, ok := index[key]; ok {
+			result[i].Permissions = append(result[i].Permissions, p.Permissions...)
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, organization.PermissionIn{
+			PrincipalType: organization.PrincipalType(p.PrincipalType),
+			PrincipalId:   principalID,
+			Permissions:   slices.Clone(p.Permissions),
+		})
+	}
+	return result, nil
+}
+
+// organizationPermissionsEqual compares the bindings ignoring the order of the bindings and of their permissions.
+func organizationPermissionsEqual(a, b []organization.PermissionIn) bool {
+	return cmp.Equal(groupOrganizationPermissions(a), groupOrganizationPermissions(b), cmpopts.EquateEmpty())
+}
+
+func groupOrganizationPermissions(bindings []organization.PermissionIn) map[string][]string {
+	result := make(map[string][]string, len(bindings))
+	for _, b := range bindings {
+		key := string(b.PrincipalType) + "/" + b.PrincipalId
+		result[key] = append(result[key], b.Permissions...)
+	}
+	for k, v := range result {
+		slices.Sort(v)
+		result[k] = slices.Compact(v)
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"slices"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/organization"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOrganizationPermissionController(t *testing.T) {
+	t.Parallel()
+
+	const groupID = "ug1a2b3c4d5e6"
+
+	newController := func(t *testing.T, avn avngen.Client, objects ...client.Object) *OrganizationPermissionController {
+		t.Helper()
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+		k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		return &OrganizationPermissionController{Client: k8s, avnGen: avn}
+	}
+
+	newObjects := func(t *testing.T) (*v1alpha1.OrganizationPermission, *v1alpha1.OrganizationUserGroup) {
+		t.Helper()
+		perm := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationPermission](t, "organizationpermission", "OrganizationPermission")
+		perm.Namespace = "default"
+		group := newObjectFromExampleYAMLByKind[v1alpha1.OrganizationUserGroup](t, "organizationpermission", "OrganizationUserGroup")
+		group.Namespace = "default"
+		group.Status.ID = groupID
+		return perm, group
+	}
+
+	remote := []organization.PermissionOut{
+		{PrincipalType: "user", PrincipalId: "u1a2b3c4d5e6", Permissions: []string{"admin"}},
+		{PrincipalType: "user_group", PrincipalId: groupID, Permissions: []string{"developer"}},
+	}
+
+	t.Run("Sets the full set of permissions with the user group resolved", func(t *testing.T) {
+		perm, group := newObjects(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			PermissionsSet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project", mock.MatchedBy(func(in *organization.PermissionsSetIn) bool {
+				return assert.Equal(t, []organization.PermissionIn{
+					{PrincipalType: "user_group", PrincipalId: groupID, Permissions: []string{"developer"}},
+					{PrincipalType: "user", PrincipalId: "u1a2b3c4d5e6", Permissions: []string{"admin"}},
+				}, in.Permissions)
+			})).
+			Return(nil).Once()
+
+		_, err := newController(t, avn, group).Create(t.Context(), perm)
+		require.NoError(t, err)
+		assert.True(t, meta.IsStatusConditionTrue(perm.Status.Conditions, conditionTypeRunning))
+		assert.Equal(t, []v1alpha1.OrganizationPermissionAppliedBinding{
+			{PrincipalType: "user_group", PrincipalID: groupID, Permissions: []string{"developer"}},
+			{PrincipalType: "user", PrincipalID: "u1a2b3c4d5e6", Permissions: []string{"admin"}},
+		}, perm.Status.AppliedPermissions)
+	})
+
+	t.Run("Detects permissions changed outside Kubernetes", func(t *testing.T) {
+		perm, group := newObjects(t)
+		perm.Generation = 1
+		perm.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+
+		drifted := append(slices.Clone(remote), organization.PermissionOut{PrincipalType: "user", PrincipalId: "u0000", Permissions: []string{"admin"}})
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			PermissionsGet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project").
+			Return(remote, nil).Once()
+		avn.EXPECT().
+			PermissionsGet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project").
+			Return(drifted, nil).Once()
+
+		c := newController(t, avn, group)
+
+		obs, err := c.Observe(t.Context(), perm)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+
+		obs, err = c.Observe(t.Context(), perm)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: false}, obs)
+	})
+
+	t.Run("Waits for the user group to be created", func(t *testing.T) {
+		perm, group := newObjects(t)
+		group.Status.ID = ""
+
+		_, err := newController(t, avngen.NewMockClient(t), group).Observe(t.Context(), perm)
+		assert.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Revokes only the applied permissions on delete", func(t *testing.T) {
+		perm, group := newObjects(t)
+		perm.Status.AppliedPermissions = []v1alpha1.OrganizationPermissionAppliedBinding{
+			{PrincipalType: "user_group", PrincipalID: groupID, Permissions: []string{"developer"}},
+			{PrincipalType: "user", PrincipalID: "u1a2b3c4d5e6", Permissions: []string{"admin"}},
+		}
+
+		granted := []organization.PermissionOut{
+			{PrincipalType: "user", PrincipalId: "u1a2b3c4d5e6", Permissions: []string{"admin", "project:services:read"}},
+			{PrincipalType: "user_group", PrincipalId: groupID, Permissions: []string{"developer"}},
+			{PrincipalType: "user", PrincipalId: "u0000", Permissions: []string{"admin"}},
+		}
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			PermissionsGet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project").
+			Return(granted, nil).Once()
+		avn.EXPECT().
+			PermissionsSet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project", &organization.PermissionsSetIn{Permissions: []organization.PermissionIn{
+				{PrincipalType: "user", PrincipalId: "u1a2b3c4d5e6", Permissions: []string{"project:services:read"}},
+				{PrincipalType: "user", PrincipalId: "u0000", Permissions: []string{"admin"}},
+			}}).
+			Return(nil).Once()
+
+		require.NoError(t, newController(t, avn, group).Delete(t.Context(), perm))
+	})
+
+	t.Run("Delete of permissions never applied is a no-op", func(t *testing.T) {
+		perm, group := newObjects(t)
+
+		require.NoError(t, newController(t, avngen.NewMockClient(t), group).Delete(t.Context(), perm))
+	})
+
+	t.Run("Revokes all permissions on delete with revokeAllOnDelete", func(t *testing.T) {
+		perm, group := newObjects(t)
+		perm.Spec.RevokeAllOnDelete = true
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			PermissionsSet(mock.Anything, perm.Spec.OrganizationID, organization.ResourceType("project"), "my-project", &organization.PermissionsSetIn{Permissions: []organization.PermissionIn{}}).
+			Return(nil).Once()
+
+		require.NoError(t, newController(t, avn, group).Delete(t.Context(), perm))
+	})
+}
+
+func TestOrganizationPermissionsEqual(t *testing.T) {
+	t.Parallel()
+
+	a := []organization.PermissionIn{
+		{PrincipalType: "user", PrincipalId: "u1", Permissions: []string{"admin", "developer"}},
+		{PrincipalType: "user_group", PrincipalId: "ug1", Permissions: []string{"read_only"}},
+	}
+	b := []organization.PermissionIn{
+		{PrincipalType: "user_group", PrincipalId: "ug1", Permissions: []string{"read_only"}},
+		{PrincipalType: "user", PrincipalId: "u1", Permissions: []string{"developer", "admin"}},
+	}
+
+	assert.True(t, organizationPermissionsEqual(a, b))
+	assert.True(t, organizationPermissionsEqual(nil, []organization.PermissionIn{}))
+	assert.False(t, organizationPermissionsEqual(a, b[:1]))
+	assert.False(t, organizationPermissionsEqual(a, []organization.PermissionIn{
+		{PrincipalType: "user_group", PrincipalId: "u1", Permissions: []string{"admin", "developer"}},
+		{PrincipalType: "user_group", PrincipalId: "ug1", Permissions: []string{"read_only"}},
+	}))
+}
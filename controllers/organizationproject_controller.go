@@ -98,10 +98,15 @@ func (r *OrganizationProjectController) Create(ctx context.Context, cr *v1alpha1
 		return CreateResult{}, err
 	}
 
+	billingGroupID, err := r.resolveBillingGroupID(ctx, cr)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
 	techEmails := organizationProjectTechEmails(cr.Spec.TechnicalEmails)
 	in := &organizationprojects.OrganizationProjectsCreateIn{
 		ProjectId:      cr.Spec.ProjectID,
-		BillingGroupId: cr.Spec.BillingGroupID,
+		BillingGroupId: billingGroupID,
 		ParentId:       NilIfZero(parentID),
 		BasePort:       cr.Spec.BasePort,
 		Tags:           emptyIfNil(cr.Spec.Tags),
@@ -130,10 +135,15 @@ func (r *OrganizationProjectController) Update(ctx context.Context, cr *v1alpha1
 		return UpdateResult{}, err
 	}
 
+	billingGroupID, err := r.resolveBillingGroupID(ctx, cr)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+
 	techEmails := organizationProjectTechEmails(cr.Spec.TechnicalEmails)
 	tags := emptyIfNil(cr.Spec.Tags)
 	in := &organizationprojects.OrganizationProjectsUpdateIn{
-		BillingGroupId: NilIfZero(cr.Spec.BillingGroupID),
+		BillingGroupId: NilIfZero(billingGroupID),
 		ParentId:       NilIfZero(parentID),
 		BasePort:       cr.Spec.BasePort,
 		Tags:           &tags,
@@ -165,7 +175,11 @@ func (r *OrganizationProjectController) Delete(ctx context.Context, cr *v1alpha1
 
 // orgProjectMatchesSpec reports whether the remote project matches the spec.
 func (r *OrganizationProjectController) orgProjectMatchesSpec(ctx context.Context, got *organizationprojects.OrganizationProjectsGetOut, cr *v1alpha1.OrganizationProject) (bool, error) {
-	if cr.Spec.BillingGroupID != fromAnyPointer(got.BillingGroupId) {
+	billingGroupID, err := r.resolveBillingGroupID(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	if billingGroupID != fromAnyPointer(got.BillingGroupId) {
 		return false, nil
 	}
 
@@ -194,6 +208,14 @@ func (r *OrganizationProjectController) orgProjectMatchesSpec(ctx context.Contex
 	return parentID == got.ParentId, nil
 }
 
+// resolveBillingGroupID returns the billing group ID from the spec or the referenced BillingGroup.
+func (r *OrganizationProjectController) resolveBillingGroupID(ctx context.Context, cr *v1alpha1.OrganizationProject) (string, error) {
+	if cr.Spec.BillingGroupRef == nil {
+		return cr.Spec.BillingGroupID, nil
+	}
+	return getBillingGroupID(ctx, r.Client, cr.Namespace, cr.Spec.BillingGroupRef)
+}
+
 // resolveParentID converts an organization ID to its account ID form.
 // Account IDs are passed through unchanged.
 func (r *OrganizationProjectController) resolveParentID(ctx context.Context, parentID string) (string, error) {
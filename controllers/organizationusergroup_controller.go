@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/usergroup"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newOrganizationUserGroupReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.OrganizationUserGroup] {
+			return &OrganizationUserGroupController{
+				Client: c.Client,
+				avnGen: avnGen,
+			}
+		},
+		nil,
+	)
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationusergroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationusergroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=organizationusergroups/finalizers,verbs=get;create;update
+
+// OrganizationUserGroupController reconciles an OrganizationUserGroup object.
+type OrganizationUserGroupController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *OrganizationUserGroupController) Observe(ctx context.Context, cr *v1alpha1.OrganizationUserGroup) (Observation, error) {
+	if cr.Status.ID == "" {
+		// Adopts the group with the same name, the ID is generated by Aiven
+		groups, err := r.avnGen.UserGroupsList(ctx, cr.Spec.OrganizationID)
+		if err != nil {
+			return Observation{}, fmt.Errorf("listing organization user groups: %w", err)
+		}
+		for _, g := range groups {
+			if g.UserGroupName == cr.Spec.Name {
+				cr.Status.ID = g.UserGroupId
+				break
+			}
+		}
+		if cr.Status.ID == "" {
+			return Observation{ResourceExists: false}, nil
+		}
+	}
+
+	got, err := r.avnGen.UserGroupGet(ctx, cr.Spec.OrganizationID, cr.Status.ID)
+	if err != nil {
+		if isNotFound(err) {
+			cr.Status.ID = ""
+			return Observation{ResourceExists: false}, nil
+		}
+		return Observation{}, fmt.Errorf("getting organization user group: %w", err)
+	}
+
+	upToDate := hasLatestGeneration(cr) &&
+		got.UserGroupName == cr.Spec.Name &&
+		got.Description == cr.Spec.Description
+	if upToDate {
+		markInstanceRunning(cr)
+	}
+
+	return Observation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *OrganizationUserGroupController) Create(ctx context.Context, cr *v1alpha1.OrganizationUserGroup) (CreateResult, error) {
+	in := &usergroup.UserGroupCreateIn{
+		UserGroupName: cr.Spec.Name,
+		Description:   cr.Spec.Description,
+	}
+
+	out, err := r.avnGen.UserGroupCreate(ctx, cr.Spec.OrganizationID, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("creating organization user group: %w", err)
+	}
+
+	cr.Status.ID = out.UserGroupId
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return CreateResult{}, nil
+}
+
+func (r *OrganizationUserGroupController) Update(ctx context.Context, cr *v1alpha1.OrganizationUserGroup) (UpdateResult, error) {
+	in := &usergroup.UserGroupUpdateIn{
+		UserGroupName: &cr.Spec.Name,
+		Description:   &cr.Spec.Description,
+	}
+
+	if _, err := r.avnGen.UserGroupUpdate(ctx, cr.Spec.OrganizationID, cr.Status.ID, in); err != nil {
+		return UpdateResult{}, fmt.Errorf("updating organization user group: %w", err)
+	}
+
+	meta.SetStatusCondition(&cr.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(cr)
+	return UpdateResult{}, nil
+}
+
+func (r *OrganizationUserGroupController) Delete(ctx context.Context, cr *v1alpha1.OrganizationUserGroup) error {
+	if cr.Status.ID == "" {
+		return nil
+	}
+
+	err := r.avnGen.UserGroupDelete(ctx, cr.Spec.OrganizationID, cr.Status.ID)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting organization user group: %w", err)
+	}
+	return nil
+}
+
+// getOrganizationUserGroupID returns the ID of the referenced OrganizationUserGroup.
+// The group must belong to the same organization.
+func getOrganizationUserGroupID(ctx context.Context, c client.Reader, namespace, organizationID string, ref *v1alpha1.ResourceReference) (string, error) {
+	key := ref.OrganizationUserGroup(namespace).NamespacedName
+
+	group := &v1alpha1.OrganizationUserGroup{}
+	if err := c.Get(ctx, key, group); err != nil {
+		return "", fmt.Errorf("cannot get OrganizationUserGroup %q: %w", key, err)
+	}
+	if group.Spec.OrganizationID != organizationID {
+		return "", fmt.Errorf("OrganizationUserGroup %q belongs to organization %q, not %q", key, group.Spec.OrganizationID, organizationID)
+	}
+	if group.Status.ID == "" {
+		return "", fmt.Errorf("%w: OrganizationUserGroup %q is not created yet", errPreconditionNotMet, key)
+	}
+	return group.Status.ID, nil
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/usergroup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOrganizationUserGroupController(t *testing.T) {
+	t.Parallel()
+
+	const groupID = "ug1a2b3c4d5e6"
+
+	newGroup := func(t *testing.T) *v1alpha1.OrganizationUserGroup {
+		t.Helper()
+		group := newObjectFromExampleYAML[v1alpha1.OrganizationUserGroup](t, "organizationusergroup")
+		group.Namespace = "default"
+		group.Generation = 1
+		group.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		return group
+	}
+
+	t.Run("Adopts the group with the same name", func(t *testing.T) {
+		group := newGroup(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupsList(mock.Anything, group.Spec.OrganizationID).
+			Return([]usergroup.UserGroupOut{
+				{UserGroupId: "ug0000", UserGroupName: "admins"},
+				{UserGroupId: groupID, UserGroupName: group.Spec.Name},
+			}, nil).Once()
+		avn.EXPECT().
+			UserGroupGet(mock.Anything, group.Spec.OrganizationID, groupID).
+			Return(&usergroup.UserGroupGetOut{
+				UserGroupId:   groupID,
+				UserGroupName: group.Spec.Name,
+				Description:   group.Spec.Description,
+			}, nil).Once()
+
+		obs, err := (&OrganizationUserGroupController{avnGen: avn}).Observe(t.Context(), group)
+		require.NoError(t, err)
+		assert.Equal(t, Observation{ResourceExists: true, ResourceUpToDate: true}, obs)
+		assert.Equal(t, groupID, group.Status.ID)
+		assert.Equal(t, "true", group.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Creates the group when there is none with the same name", func(t *testing.T) {
+		group := newGroup(t)
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupsList(mock.Anything, group.Spec.OrganizationID).
+			Return([]usergroup.UserGroupOut{{UserGroupId: "ug0000", UserGroupName: "admins"}}, nil).Once()
+		avn.EXPECT().
+			UserGroupCreate(mock.Anything, group.Spec.OrganizationID, &usergroup.UserGroupCreateIn{
+				UserGroupName: group.Spec.Name,
+				Description:   group.Spec.Description,
+			}).
+			Return(&usergroup.UserGroupCreateOut{UserGroupId: groupID}, nil).Once()
+
+		controller := &OrganizationUserGroupController{avnGen: avn}
+		obs, err := controller.Observe(t.Context(), group)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), group)
+		require.NoError(t, err)
+		assert.Equal(t, groupID, group.Status.ID)
+		assert.True(t, meta.IsStatusConditionTrue(group.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Group deleted outside Kubernetes is created again", func(t *testing.T) {
+		group := newGroup(t)
+		group.Status.ID = groupID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupGet(mock.Anything, group.Spec.OrganizationID, groupID).
+			Return(nil, newAivenError(404, "user group not found")).Once()
+
+		obs, err := (&OrganizationUserGroupController{avnGen: avn}).Observe(t.Context(), group)
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceExists)
+		assert.Empty(t, group.Status.ID)
+	})
+
+	t.Run("Changed description is updated", func(t *testing.T) {
+		group := newGroup(t)
+		group.Status.ID = groupID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupGet(mock.Anything, group.Spec.OrganizationID, groupID).
+			Return(&usergroup.UserGroupGetOut{
+				UserGroupId:   groupID,
+				UserGroupName: group.Spec.Name,
+				Description:   "Changed in the console",
+			}, nil).Once()
+		avn.EXPECT().
+			UserGroupUpdate(mock.Anything, group.Spec.OrganizationID, groupID, mock.MatchedBy(func(in *usergroup.UserGroupUpdateIn) bool {
+				return *in.UserGroupName == group.Spec.Name && *in.Description == group.Spec.Description
+			})).
+			Return(&usergroup.UserGroupUpdateOut{}, nil).Once()
+
+		controller := &OrganizationUserGroupController{avnGen: avn}
+		obs, err := controller.Observe(t.Context(), group)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), group)
+		require.NoError(t, err)
+		assert.True(t, meta.IsStatusConditionTrue(group.Status.Conditions, conditionTypeRunning))
+	})
+
+	t.Run("Delete removes the group", func(t *testing.T) {
+		group := newGroup(t)
+		group.Status.ID = groupID
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			UserGroupDelete(mock.Anything, group.Spec.OrganizationID, groupID).
+			Return(nil).Once()
+
+		require.NoError(t, (&OrganizationUserGroupController{avnGen: avn}).Delete(t.Context(), group))
+	})
+
+	t.Run("Delete of a group never created is a no-op", func(t *testing.T) {
+		require.NoError(t, (&OrganizationUserGroupController{avnGen: avngen.NewMockClient(t)}).Delete(t.Context(), newGroup(t)))
+	})
+}
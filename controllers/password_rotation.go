@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// rotatePasswordAnnotation rotates the password once when set to a new value.
+	rotatePasswordAnnotation = "controllers.aiven.io/rotate-password"
+
+	eventPasswordRotated = "PasswordRotated"
+
+	defaultRotationWindowDuration = 4 * time.Hour
+)
+
+// passwordRotator is a user with an operator-generated password.
+type passwordRotator interface {
+	client.Object
+	GetConnInfoSecretTarget() v1alpha1.ConnInfoSecretTarget
+	GetPasswordRotation() *v1alpha1.PasswordRotation
+	GetPasswordRotationStatus() *v1alpha1.PasswordRotationStatus
+}
+
+// isPasswordRotationDue returns true when the rotate-password annotation has a new value,
+// or when the rotation interval has passed and the maintenance window is open.
+func isPasswordRotationDue(o passwordRotator, now time.Time) bool {
+	status := o.GetPasswordRotationStatus()
+	if req := o.GetAnnotations()[rotatePasswordAnnotation]; req != "" && req != status.LastRotationRequest {
+		return true
+	}
+
+	rotation := o.GetPasswordRotation()
+	if rotation == nil {
+		return false
+	}
+
+	last := o.GetCreationTimestamp().Time
+	if status.LastRotatedAt != nil {
+		last = status.LastRotatedAt.Time
+	}
+	if now.Before(last.Add(rotation.Interval.Duration)) {
+		return false
+	}
+	return isRotationWindowOpen(rotation.MaintenanceWindow, now)
+}
+
+// isRotationWindowOpen returns true when now is within the weekly window, or the window is not set.
+func isRotationWindowOpen(w *v1alpha1.PasswordRotationWindow, now time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	start, err := time.Parse(time.TimeOnly, w.Time)
+	if err != nil {
+		return false
+	}
+
+	length := defaultRotationWindowDuration
+	if w.Duration != nil {
+		length = w.Duration.Duration
+	}
+
+	// The window can start on one of the previous days and span midnight.
+	now = now.UTC()
+	for days := 0; days <= 7; days++ {
+		day := now.AddDate(0, 0, -days)
+		if w.Dow != "" && !strings.EqualFold(day.Weekday().String(), w.Dow) {
+			continue
+		}
+		begin := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+		if !now.Before(begin) && now.Before(begin.Add(length)) {
+			return true
+		}
+	}
+	return false
+}
+
+// generatePassword returns a random password for the rotation.
+func generatePassword() string {
+	return rand.Text()
+}
+
+// markPasswordRotated records the rotation in the status and emits an event.
+// It is called once the new password is published, see Observation.SecretPublished.
+func markPasswordRotated(rec record.EventRecorder, o passwordRotator, now time.Time) {
+	status := o.GetPasswordRotationStatus()
+	status.LastRotatedAt = &metav1.Time{Time: now}
+	status.LastRotationRequest = o.GetAnnotations()[rotatePasswordAnnotation]
+	rec.Event(o, corev1.EventTypeNormal, eventPasswordRotated, "password rotated")
+}
+
+// readConnectionSecretPassword returns the password published to the Kubernetes connection secret.
+// Returns an empty string when the secret doesn't exist or is written to an external sink.
+func readConnectionSecretPassword(ctx context.Context, k8s client.Reader, o objWithSecret) (string, error) {
+	if !usesKubernetesSecretSink(o) {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := k8s.Get(ctx, types.NamespacedName{Name: connectionSecretName(o), Namespace: o.GetNamespace()}, secret)
+	switch {
+	case apierrors.IsNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("cannot get connection secret: %w", err)
+	}
+	return string(secret.Data[getSecretPrefix(o)+"PASSWORD"]), nil
+}
+
+// setPreviousPasswordDetails keeps the previous password in the connection secret for rotation.keepPreviousFor.
+// The previous password is set while rotating, before the rotation is recorded in the status.
+// The connection secret keeps the keys missing from the details, so the key is emptied once the grace period is over.
+func setPreviousPasswordDetails(details SecretDetails, o passwordRotator, previous string, now time.Time) {
+	rotation := o.GetPasswordRotation()
+	if rotation == nil || rotation.KeepPreviousFor == nil {
+		return
+	}
+
+	key := getSecretPrefix(o) + "PREVIOUS_PASSWORD"
+	lastRotatedAt := o.GetPasswordRotationStatus().LastRotatedAt
+	switch {
+	case previous != "":
+		details[key] = previous
+	case lastRotatedAt != nil && now.After(lastRotatedAt.Add(rotation.KeepPreviousFor.Duration)):
+		details[key] = ""
+	}
+}
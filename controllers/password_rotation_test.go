@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestIsPasswordRotationDue(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newUser := func(rotation *v1alpha1.PasswordRotation) *v1alpha1.ServiceUser {
+		user := newObjectFromYAML[v1alpha1.ServiceUser](t, yamlServiceUser)
+		user.CreationTimestamp = metav1.NewTime(created)
+		user.Spec.Rotation = rotation
+		return user
+	}
+	every90Days := &v1alpha1.PasswordRotation{Interval: metav1.Duration{Duration: 90 * 24 * time.Hour}}
+
+	t.Run("Not due without rotation", func(t *testing.T) {
+		assert.False(t, isPasswordRotationDue(newUser(nil), created.AddDate(1, 0, 0)))
+	})
+
+	t.Run("Due after the interval since creation or the last rotation", func(t *testing.T) {
+		user := newUser(every90Days)
+		assert.False(t, isPasswordRotationDue(user, created.AddDate(0, 0, 89)))
+		assert.True(t, isPasswordRotationDue(user, created.AddDate(0, 0, 90)))
+
+		user.Status.LastRotatedAt = &metav1.Time{Time: created.AddDate(0, 0, 90)}
+		assert.False(t, isPasswordRotationDue(user, created.AddDate(0, 0, 100)))
+	})
+
+	t.Run("Due when the annotation has a new value", func(t *testing.T) {
+		user := newUser(nil)
+		user.Annotations = map[string]string{rotatePasswordAnnotation: "2026-01-02T10:00:00Z"}
+		assert.True(t, isPasswordRotationDue(user, created))
+
+		user.Status.LastRotationRequest = "2026-01-02T10:00:00Z"
+		assert.False(t, isPasswordRotationDue(user, created))
+	})
+
+	t.Run("Waits for the maintenance window", func(t *testing.T) {
+		rotation := every90Days.DeepCopy()
+		rotation.MaintenanceWindow = &v1alpha1.PasswordRotationWindow{Dow: "sunday", Time: "22:00:00"}
+		user := newUser(rotation)
+
+		// 2026-04-01 is a Wednesday.
+		assert.False(t, isPasswordRotationDue(user, time.Date(2026, 4, 1, 22, 30, 0, 0, time.UTC)))
+		assert.True(t, isPasswordRotationDue(user, time.Date(2026, 4, 5, 22, 30, 0, 0, time.UTC)))
+		// The window spans midnight.
+		assert.True(t, isPasswordRotationDue(user, time.Date(2026, 4, 6, 1, 59, 0, 0, time.UTC)))
+		assert.False(t, isPasswordRotationDue(user, time.Date(2026, 4, 6, 2, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestSetPreviousPasswordDetails(t *testing.T) {
+	rotatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := newObjectFromYAML[v1alpha1.ServiceUser](t, yamlServiceUser)
+	user.Spec.Rotation = &v1alpha1.PasswordRotation{
+		Interval:        metav1.Duration{Duration: 90 * 24 * time.Hour},
+		KeepPreviousFor: &metav1.Duration{Duration: 24 * time.Hour},
+	}
+
+	details := SecretDetails{}
+	setPreviousPasswordDetails(details, user, "old-password", rotatedAt)
+	assert.Equal(t, SecretDetails{"SERVICEUSER_PREVIOUS_PASSWORD": "old-password"}, details, "the first rotation isn't recorded until the secret is published")
+
+	user.Status.LastRotatedAt = &metav1.Time{Time: rotatedAt}
+
+	details = SecretDetails{}
+	setPreviousPasswordDetails(details, user, "", rotatedAt.Add(time.Hour))
+	assert.Empty(t, details, "the secret keeps the previous password")
+
+	details = SecretDetails{}
+	setPreviousPasswordDetails(details, user, "", rotatedAt.Add(25*time.Hour))
+	assert.Equal(t, SecretDetails{"SERVICEUSER_PREVIOUS_PASSWORD": ""}, details)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+// openPostgreSQLDatabase connects to the database with the admin credentials from the connection secret of the PostgreSQL resource.
+// The resource must have the same name as the service.
+func openPostgreSQLDatabase(ctx context.Context, k8s client.Client, namespace string, spec v1alpha1.ServiceDependant, database string) (*sql.DB, error) {
+	uri, err := getServiceConnectionURI(ctx, k8s, &v1alpha1.PostgreSQL{}, "PostgreSQL", namespace, spec.ServiceName, "DATABASE_URI")
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := pgUtils.Open(ctx, uri, database)
+	if errors.Is(err, pgUtils.ErrDatabaseNotFound) {
+		// The Database resource might not be ready yet
+		return nil, fmt.Errorf("%w: %w", errPreconditionNotMet, err)
+	}
+	return db, err
+}
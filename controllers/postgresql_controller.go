@@ -75,6 +75,10 @@ func (a *postgreSQLAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
 	return &a.Spec.ServiceCommonSpec
 }
 
+func (a *postgreSQLAdapter) getServiceFork() *v1alpha1.ServiceForkFields {
+	return &a.Spec.ServiceForkFields
+}
+
 func (a *postgreSQLAdapter) getUserConfig() any {
 	return a.Spec.UserConfig
 }
@@ -99,6 +103,8 @@ func (a *postgreSQLAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 		"DATABASE_URI": s.ServiceUri,
 	}
 
+	addPrivatelinkEndpointDetails(stringData, s.Components, "pg", prefix)
+
 	return newSecret(a, stringData, false)
 }
 
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+func newPostgreSQLExtensionReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.PostgreSQLExtension] {
+			return &PostgreSQLExtensionController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlextensions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlextensions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlextensions/finalizers,verbs=get;create;update
+
+// PostgreSQLExtensionController reconciles a PostgreSQLExtension object.
+type PostgreSQLExtensionController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *PostgreSQLExtensionController) Observe(ctx context.Context, ext *v1alpha1.PostgreSQLExtension) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, ext.Spec.Project, ext.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	db, err := openPostgreSQLDatabase(ctx, r.Client, ext.Namespace, ext.Spec.ServiceDependant, ext.Spec.Database)
+	if err != nil {
+		return Observation{}, err
+	}
+	defer db.Close()
+
+	installed, err := pgUtils.GetExtension(ctx, db, ext.GetExtensionName())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting PostgreSQL extension: %w", err)
+	}
+	if installed == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	ext.Status.Version = installed.Version
+	markInstanceRunning(ext)
+
+	upToDate := hasLatestGeneration(ext) &&
+		(ext.Spec.Schema == "" || ext.Spec.Schema == installed.Schema) &&
+		(ext.Spec.Version == "" || ext.Spec.Version == installed.Version)
+	return Observation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (r *PostgreSQLExtensionController) Create(ctx context.Context, ext *v1alpha1.PostgreSQLExtension) (CreateResult, error) {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, ext.Namespace, ext.Spec.ServiceDependant, ext.Spec.Database)
+	if err != nil {
+		return CreateResult{}, err
+	}
+	defer db.Close()
+
+	name := ext.GetExtensionName()
+	available, err := pgUtils.IsExtensionAvailable(ctx, db, name)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("getting available PostgreSQL extensions: %w", err)
+	}
+	if !available {
+		return CreateResult{}, fmt.Errorf("PostgreSQL extension %q is not available on the service", name)
+	}
+
+	if err := pgUtils.CreateExtension(ctx, db, name, ext.Spec.Schema, ext.Spec.Version); err != nil {
+		return CreateResult{}, fmt.Errorf("creating PostgreSQL extension: %w", err)
+	}
+
+	installed, err := pgUtils.GetExtension(ctx, db, name)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("getting PostgreSQL extension: %w", err)
+	}
+	if installed != nil {
+		ext.Status.Version = installed.Version
+	}
+
+	meta.SetStatusCondition(&ext.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(ext)
+	return CreateResult{}, nil
+}
+
+func (r *PostgreSQLExtensionController) Update(ctx context.Context, ext *v1alpha1.PostgreSQLExtension) (UpdateResult, error) {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, ext.Namespace, ext.Spec.ServiceDependant, ext.Spec.Database)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+	defer db.Close()
+
+	name := ext.GetExtensionName()
+	installed, err := pgUtils.GetExtension(ctx, db, name)
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("getting PostgreSQL extension: %w", err)
+	}
+	if installed == nil {
+		return UpdateResult{}, fmt.Errorf("PostgreSQL extension %q is not installed", name)
+	}
+
+	// ALTER EXTENSION fails when the extension is already in the schema or at the version
+	schema, version := ext.Spec.Schema, ext.Spec.Version
+	if schema == installed.Schema {
+		schema = ""
+	}
+	if version == installed.Version {
+		version = ""
+	}
+
+	if err := pgUtils.AlterExtension(ctx, db, name, schema, version); err != nil {
+		return UpdateResult{}, fmt.Errorf("updating PostgreSQL extension: %w", err)
+	}
+	if version != "" {
+		ext.Status.Version = version
+	}
+
+	meta.SetStatusCondition(&ext.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(ext)
+	return UpdateResult{}, nil
+}
+
+func (r *PostgreSQLExtensionController) Delete(ctx context.Context, ext *v1alpha1.PostgreSQLExtension) error {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, ext.Namespace, ext.Spec.ServiceDependant, ext.Spec.Database)
+	if err != nil {
+		if isServiceConnectionGone(err) {
+			return nil
+		}
+		return err
+	}
+	defer db.Close()
+
+	if err := pgUtils.DropExtension(ctx, db, ext.GetExtensionName()); err != nil {
+		return fmt.Errorf("dropping PostgreSQL extension: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+// newPostgreSQLTestService returns a k8s client with the "my-postgresql" resource of the example,
+// whose connection secret points to the local PostgreSQL from POSTGRESQL_TEST_URI, see "task test:postgresql".
+// The returned database is the one the resources connect to, for checking the results.
+func newPostgreSQLTestService(t *testing.T, exampleName string) (client.Client, *avngen.MockClient, *sql.DB) {
+	t.Helper()
+
+	raw := os.Getenv("POSTGRESQL_TEST_URI")
+	if raw == "" {
+		t.Skip("POSTGRESQL_TEST_URI is not set")
+	}
+	uri, err := url.Parse(raw)
+	require.NoError(t, err)
+
+	db, err := pgUtils.Open(t.Context(), uri, "postgres")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	pg := newObjectFromExampleYAMLByKind[v1alpha1.PostgreSQL](t, exampleName, "PostgreSQL")
+	pg.Namespace = "default"
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "postgresql-secret", Namespace: "default"},
+		Data:       map[string][]byte{"POSTGRESQL_DATABASE_URI": []byte(raw)},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pg, secret).Build()
+
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServiceGet(mock.Anything, "aiven-project-name", "my-postgresql", mock.Anything).
+		Return(runningService(), nil).
+		Maybe()
+	return k8sClient, avn, db
+}
+
+func TestPostgreSQLExtensionController(t *testing.T) {
+	newExtension := func(t *testing.T) *v1alpha1.PostgreSQLExtension {
+		t.Helper()
+		ext := newObjectFromExampleYAMLByKind[v1alpha1.PostgreSQLExtension](t, "postgresqlextension", "PostgreSQLExtension")
+		ext.Namespace = "default"
+		ext.Generation = 1
+		ext.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		// The extension of the example isn't in the PostgreSQL image
+		ext.Spec.Database = "postgres"
+		ext.Spec.ExtensionName = "pg_trgm"
+		return ext
+	}
+
+	t.Run("Extension is created, updated and dropped on delete", func(t *testing.T) {
+		k8sClient, avn, db := newPostgreSQLTestService(t, "postgresqlextension")
+		require.NoError(t, pgUtils.DropExtension(t.Context(), db, "pg_trgm"))
+		require.NoError(t, pgUtils.CreateSchema(t.Context(), db, "ext_test", ""))
+		t.Cleanup(func() { pgUtils.DropSchema(context.Background(), db, "ext_test") }) //nolint:errcheck
+		controller := &PostgreSQLExtensionController{Client: k8sClient, avnGen: avn}
+
+		ext := newExtension(t)
+		obs, err := controller.Observe(t.Context(), ext)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), ext)
+		require.NoError(t, err)
+		assert.NotEmpty(t, ext.Status.Version)
+
+		obs, err = controller.Observe(t.Context(), ext)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+
+		ext.Spec.Schema = "ext_test"
+		obs, err = controller.Observe(t.Context(), ext)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), ext)
+		require.NoError(t, err)
+		installed, err := pgUtils.GetExtension(t.Context(), db, "pg_trgm")
+		require.NoError(t, err)
+		require.NotNil(t, installed)
+		assert.Equal(t, "ext_test", installed.Schema)
+
+		require.NoError(t, controller.Delete(t.Context(), ext))
+		installed, err = pgUtils.GetExtension(t.Context(), db, "pg_trgm")
+		require.NoError(t, err)
+		assert.Nil(t, installed)
+	})
+
+	t.Run("Errors when the extension isn't available", func(t *testing.T) {
+		k8sClient, avn, _ := newPostgreSQLTestService(t, "postgresqlextension")
+
+		ext := newExtension(t)
+		ext.Spec.ExtensionName = "does_not_exist"
+		_, err := (&PostgreSQLExtensionController{Client: k8sClient, avnGen: avn}).Create(t.Context(), ext)
+		require.ErrorContains(t, err, `PostgreSQL extension "does_not_exist" is not available on the service`)
+	})
+}
+
+func TestPostgreSQLExtensionControllerDeleteWithoutService(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ext := newObjectFromExampleYAMLByKind[v1alpha1.PostgreSQLExtension](t, "postgresqlextension", "PostgreSQLExtension")
+	ext.Namespace = "default"
+
+	// Nothing to drop the extension from when the PostgreSQL resource is gone
+	controller := &PostgreSQLExtensionController{Client: k8sClient, avnGen: avngen.NewMockClient(t)}
+	require.NoError(t, controller.Delete(t.Context(), ext))
+}
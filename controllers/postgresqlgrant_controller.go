@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	"github.com/aiven/aiven-operator/utils"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlgrants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlgrants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlgrants/finalizers,verbs=get;create;update
+
+// PostgreSQLGrantController reconciles a PostgreSQLGrant object.
+type PostgreSQLGrantController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newPostgreSQLGrantReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.PostgreSQLGrant] {
+			return &PostgreSQLGrantController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *PostgreSQLGrantController) Observe(ctx context.Context, g *v1alpha1.PostgreSQLGrant) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, g.Spec.Project, g.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	exists := wasEverApplied(g)
+	if exists && hasLatestGeneration(g) {
+		markInstanceRunning(g)
+		return Observation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	db, err := openPostgreSQLDatabase(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant, g.Spec.Database)
+	if err != nil {
+		return Observation{}, err
+	}
+	defer db.Close()
+
+	if err := r.checkPreconditions(ctx, db, g); err != nil {
+		return Observation{}, err
+	}
+
+	return Observation{ResourceExists: exists, ResourceUpToDate: false}, nil
+}
+
+func (r *PostgreSQLGrantController) Create(ctx context.Context, g *v1alpha1.PostgreSQLGrant) (CreateResult, error) {
+	return CreateResult{}, r.applyGrants(ctx, g)
+}
+
+func (r *PostgreSQLGrantController) Update(ctx context.Context, g *v1alpha1.PostgreSQLGrant) (UpdateResult, error) {
+	return UpdateResult{}, r.applyGrants(ctx, g)
+}
+
+func (r *PostgreSQLGrantController) Delete(ctx context.Context, g *v1alpha1.PostgreSQLGrant) error {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant, g.Spec.Database)
+	if err != nil {
+		if isServiceConnectionGone(err) {
+			return nil
+		}
+		return err
+	}
+	defer db.Close()
+
+	// Revokes the latest grants from the spec.
+	return pgUtils.ApplyGrants(ctx, db, g.Spec.BuildStatements(g.Spec.Database, pgUtils.REVOKE), nil)
+}
+
+// applyGrants revokes the previously applied grants (if any) and grants declared privileges in the spec in one transaction.
+func (r *PostgreSQLGrantController) applyGrants(ctx context.Context, g *v1alpha1.PostgreSQLGrant) error {
+	delete(g.GetAnnotations(), instanceIsRunningAnnotation)
+
+	db, err := openPostgreSQLDatabase(ctx, r.Client, g.Namespace, g.Spec.ServiceDependant, g.Spec.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var revokes []string
+	if g.Status.State != nil {
+		revokes = g.Status.State.BuildStatements(g.Spec.Database, pgUtils.REVOKE)
+	}
+
+	if err := pgUtils.ApplyGrants(ctx, db, revokes, g.Spec.BuildStatements(g.Spec.Database, pgUtils.GRANT)); err != nil {
+		return err
+	}
+
+	// Stores the applied grants so the next reconciliation can revoke them before re-granting.
+	g.Status.State = g.Spec.PostgreSQLGrants.DeepCopy()
+	markInstanceRunning(g)
+	return nil
+}
+
+// checkPreconditions verifies that all roles and schemas referenced in the spec already exist.
+// Missing references are reported as errPreconditionNotMet.
+func (r *PostgreSQLGrantController) checkPreconditions(ctx context.Context, db *sql.DB, g *v1alpha1.PostgreSQLGrant) error {
+	roles, err := pgUtils.QueryRoles(ctx, db)
+	if err != nil {
+		return err
+	}
+	if err := checkPostgreSQLPrecondition(g, g.Spec.CollectRoles(), roles, "missing roles defined in spec: %v"); err != nil {
+		return err
+	}
+
+	schemas, err := pgUtils.QuerySchemas(ctx, db)
+	if err != nil {
+		return err
+	}
+	return checkPostgreSQLPrecondition(g, g.Spec.CollectSchemas(), schemas, "missing schemas defined in spec: %v")
+}
+
+func checkPostgreSQLPrecondition(g *v1alpha1.PostgreSQLGrant, itemsInSpec, itemsInDB []string, errorMsgFormat string) error {
+	missingItems := utils.CheckSliceContainment(itemsInSpec, itemsInDB)
+	if len(missingItems) > 0 {
+		err := fmt.Errorf(errorMsgFormat, missingItems)
+		meta.SetStatusCondition(&g.Status.Conditions, getErrorCondition(errConditionPreconditions, err))
+		return fmt.Errorf("%w: %w", errPreconditionNotMet, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+func TestPostgreSQLGrantsBuildStatements(t *testing.T) {
+	t.Parallel()
+
+	grants := &v1alpha1.PostgreSQLGrants{
+		PrivilegeGrants: []v1alpha1.PostgreSQLPrivilegeGrant{
+			{Grantees: []string{"app"}, Privileges: []string{"CONNECT"}, ObjectType: "database"},
+			{Grantees: []string{"app", "reader"}, Privileges: []string{"USAGE"}, ObjectType: "schema", Schema: "public"},
+			{Grantees: []string{"app"}, Privileges: []string{"SELECT", "INSERT"}, ObjectType: "table", Schema: "public", WithGrantOption: true},
+			{Grantees: []string{"reader"}, Privileges: []string{"SELECT"}, ObjectType: "table", Schema: "app", Objects: []string{"users", "orders"}},
+			{Grantees: []string{"app"}, Privileges: []string{"EXECUTE"}, ObjectType: "function", Schema: "public"},
+		},
+		RoleGrants: []v1alpha1.PostgreSQLRoleGrant{
+			{Grantees: []string{"app"}, Roles: []string{"pg_read_all_stats"}, WithAdminOption: true},
+		},
+	}
+
+	assert.Equal(t, []string{
+		`GRANT CONNECT ON DATABASE "my-db" TO "app"`,
+		`GRANT USAGE ON SCHEMA "public" TO "app", "reader"`,
+		`GRANT SELECT, INSERT ON ALL TABLES IN SCHEMA "public" TO "app" WITH GRANT OPTION`,
+		`GRANT SELECT ON TABLE "app"."users", "app"."orders" TO "reader"`,
+		`GRANT EXECUTE ON ALL FUNCTIONS IN SCHEMA "public" TO "app"`,
+		`GRANT "pg_read_all_stats" TO "app" WITH ADMIN OPTION`,
+	}, grants.BuildStatements("my-db", pgUtils.GRANT))
+
+	assert.Equal(t, []string{
+		`REVOKE CONNECT ON DATABASE "my-db" FROM "app"`,
+		`REVOKE USAGE ON SCHEMA "public" FROM "app", "reader"`,
+		`REVOKE SELECT, INSERT ON ALL TABLES IN SCHEMA "public" FROM "app"`,
+		`REVOKE SELECT ON TABLE "app"."users", "app"."orders" FROM "reader"`,
+		`REVOKE EXECUTE ON ALL FUNCTIONS IN SCHEMA "public" FROM "app"`,
+		`REVOKE "pg_read_all_stats" FROM "app"`,
+	}, grants.BuildStatements("my-db", pgUtils.REVOKE))
+}
+
+func TestPostgreSQLGrantSpecCollect(t *testing.T) {
+	t.Parallel()
+
+	spec := &v1alpha1.PostgreSQLGrantSpec{
+		PostgreSQLGrants: v1alpha1.PostgreSQLGrants{
+			PrivilegeGrants: []v1alpha1.PostgreSQLPrivilegeGrant{
+				{Grantees: []string{"app"}, ObjectType: "database"},
+				{Grantees: []string{"app", "reader"}, ObjectType: "schema", Schema: "public"},
+			},
+			RoleGrants: []v1alpha1.PostgreSQLRoleGrant{
+				{Grantees: []string{"reader"}, Roles: []string{"pg_monitor"}},
+			},
+		},
+	}
+
+	assert.ElementsMatch(t, []string{"app", "reader", "pg_monitor"}, spec.CollectRoles())
+	assert.Equal(t, []string{"public"}, spec.CollectSchemas())
+}
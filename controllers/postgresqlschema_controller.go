@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+func newPostgreSQLSchemaReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.PostgreSQLSchema] {
+			return &PostgreSQLSchemaController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlschemas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlschemas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=postgresqlschemas/finalizers,verbs=get;create;update
+
+// PostgreSQLSchemaController reconciles a PostgreSQLSchema object.
+type PostgreSQLSchemaController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func (r *PostgreSQLSchemaController) Observe(ctx context.Context, s *v1alpha1.PostgreSQLSchema) (Observation, error) {
+	if _, err := getServiceIfOperational(ctx, r.avnGen, s.Spec.Project, s.Spec.ServiceName); err != nil {
+		return Observation{}, err
+	}
+
+	db, err := openPostgreSQLDatabase(ctx, r.Client, s.Namespace, s.Spec.ServiceDependant, s.Spec.Database)
+	if err != nil {
+		return Observation{}, err
+	}
+	defer db.Close()
+
+	owner, exists, err := pgUtils.GetSchemaOwner(ctx, db, s.GetSchemaName())
+	if err != nil {
+		return Observation{}, fmt.Errorf("getting PostgreSQL schema: %w", err)
+	}
+	if !exists {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	markInstanceRunning(s)
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(s) && (s.Spec.Owner == "" || s.Spec.Owner == owner),
+	}, nil
+}
+
+func (r *PostgreSQLSchemaController) Create(ctx context.Context, s *v1alpha1.PostgreSQLSchema) (CreateResult, error) {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, s.Namespace, s.Spec.ServiceDependant, s.Spec.Database)
+	if err != nil {
+		return CreateResult{}, err
+	}
+	defer db.Close()
+
+	if err := pgUtils.CreateSchema(ctx, db, s.GetSchemaName(), s.Spec.Owner); err != nil {
+		return CreateResult{}, schemaOwnerError("creating PostgreSQL schema", err)
+	}
+
+	meta.SetStatusCondition(&s.Status.Conditions, getInitializedCondition("Created", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(s)
+	return CreateResult{}, nil
+}
+
+func (r *PostgreSQLSchemaController) Update(ctx context.Context, s *v1alpha1.PostgreSQLSchema) (UpdateResult, error) {
+	if s.Spec.Owner != "" {
+		db, err := openPostgreSQLDatabase(ctx, r.Client, s.Namespace, s.Spec.ServiceDependant, s.Spec.Database)
+		if err != nil {
+			return UpdateResult{}, err
+		}
+		defer db.Close()
+
+		if err := pgUtils.AlterSchemaOwner(ctx, db, s.GetSchemaName(), s.Spec.Owner); err != nil {
+			return UpdateResult{}, schemaOwnerError("changing PostgreSQL schema owner", err)
+		}
+	}
+
+	meta.SetStatusCondition(&s.Status.Conditions, getInitializedCondition("Updated", "Successfully created or updated the instance in Aiven"))
+	markInstanceRunning(s)
+	return UpdateResult{}, nil
+}
+
+func (r *PostgreSQLSchemaController) Delete(ctx context.Context, s *v1alpha1.PostgreSQLSchema) error {
+	db, err := openPostgreSQLDatabase(ctx, r.Client, s.Namespace, s.Spec.ServiceDependant, s.Spec.Database)
+	if err != nil {
+		if isServiceConnectionGone(err) {
+			return nil
+		}
+		return err
+	}
+	defer db.Close()
+
+	if err := pgUtils.DropSchema(ctx, db, s.GetSchemaName()); err != nil {
+		return fmt.Errorf("dropping PostgreSQL schema: %w", err)
+	}
+	return nil
+}
+
+// schemaOwnerError waits for the owner when the role doesn't exist yet, e.g. its ServiceUser isn't ready
+func schemaOwnerError(msg string, err error) error {
+	if pgUtils.IsMissingObject(err) {
+		return fmt.Errorf("%w: %s: %w", errPreconditionNotMet, msg, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
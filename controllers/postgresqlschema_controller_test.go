@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pgUtils "github.com/aiven/aiven-operator/utils/postgresql"
+)
+
+func TestPostgreSQLSchemaController(t *testing.T) {
+	newSchema := func(t *testing.T) *v1alpha1.PostgreSQLSchema {
+		t.Helper()
+		s := newObjectFromExampleYAMLByKind[v1alpha1.PostgreSQLSchema](t, "postgresqlschema", "PostgreSQLSchema")
+		s.Namespace = "default"
+		s.Generation = 1
+		s.Annotations = map[string]string{processedGenerationAnnotation: "1"}
+		s.Spec.Database = "postgres"
+		s.Spec.SchemaName = "schema_controller_test"
+		return s
+	}
+
+	t.Run("Schema is created, changes owner and is dropped on delete", func(t *testing.T) {
+		k8sClient, avn, db := newPostgreSQLTestService(t, "postgresqlschema")
+		for _, role := range []string{"my-service-user", "other-user"} {
+			_, err := db.ExecContext(t.Context(), "CREATE ROLE "+pgUtils.QuoteIdentifier(role))
+			require.NoError(t, err)
+			t.Cleanup(func() { db.ExecContext(context.Background(), "DROP ROLE "+pgUtils.QuoteIdentifier(role)) }) //nolint:errcheck
+		}
+		t.Cleanup(func() { pgUtils.DropSchema(context.Background(), db, "schema_controller_test") }) //nolint:errcheck
+		controller := &PostgreSQLSchemaController{Client: k8sClient, avnGen: avn}
+
+		s := newSchema(t)
+		obs, err := controller.Observe(t.Context(), s)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = controller.Create(t.Context(), s)
+		require.NoError(t, err)
+
+		obs, err = controller.Observe(t.Context(), s)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceExists)
+		assert.True(t, obs.ResourceUpToDate)
+
+		s.Spec.Owner = "other-user"
+		obs, err = controller.Observe(t.Context(), s)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceUpToDate)
+
+		_, err = controller.Update(t.Context(), s)
+		require.NoError(t, err)
+		owner, _, err := pgUtils.GetSchemaOwner(t.Context(), db, "schema_controller_test")
+		require.NoError(t, err)
+		assert.Equal(t, "other-user", owner)
+
+		require.NoError(t, controller.Delete(t.Context(), s))
+		_, exists, err := pgUtils.GetSchemaOwner(t.Context(), db, "schema_controller_test")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Waits for the owner role", func(t *testing.T) {
+		k8sClient, avn, _ := newPostgreSQLTestService(t, "postgresqlschema")
+
+		s := newSchema(t)
+		s.Spec.Owner = "missing-user"
+		_, err := (&PostgreSQLSchemaController{Client: k8sClient, avnGen: avn}).Create(t.Context(), s)
+		require.ErrorIs(t, err, errPreconditionNotMet)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	privateLinkStateActive                        = "active"
+	privateLinkConnectionStatePendingUserApproval = "pending-user-approval"
+)
+
+// setPrivateLinkState marks the privatelink running once it is active.
+// The connections are only listed for active privatelinks, so they are reset otherwise.
+func setPrivateLinkState(obj v1alpha1.AivenManagedObject, status *v1alpha1.PrivateLinkStatus, state string) {
+	status.State = state
+	if state == privateLinkStateActive {
+		markInstanceRunning(obj)
+		return
+	}
+
+	status.Connections = nil
+	meta.SetStatusCondition(&status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", fmt.Sprintf("Privatelink is %s", state)))
+}
+
+func setPrivateLinkCreated(status *v1alpha1.PrivateLinkStatus, state string) {
+	status.State = state
+
+	const reason = "Created"
+	meta.SetStatusCondition(&status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+}
+
+// findPrivateLinkConnection returns the observed connection of the user endpoint.
+func findPrivateLinkConnection(connections []v1alpha1.PrivateLinkConnectionStatus, endpointID string) *v1alpha1.PrivateLinkConnectionStatus {
+	i := slices.IndexFunc(connections, func(c v1alpha1.PrivateLinkConnectionStatus) bool {
+		return c.EndpointID == endpointID
+	})
+	if i < 0 {
+		return nil
+	}
+	return &connections[i]
+}
+
+// sameStringSet returns true when both lists have the same values, regardless of the order.
+func sameStringSet(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
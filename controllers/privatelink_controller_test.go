@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/privatelink"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestAzurePrivateLinkController(t *testing.T) {
+	t.Parallel()
+
+	const endpointID = "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/my-resource-group/providers/Microsoft.Network/privateEndpoints/my-endpoint"
+
+	newPrivateLink := func(t *testing.T, state, userIPAddress string) *v1alpha1.AzurePrivateLink {
+		t.Helper()
+		pl := newObjectFromExampleYAMLByKind[v1alpha1.AzurePrivateLink](t, "azureprivatelink", "AzurePrivateLink")
+		pl.Status.State = privateLinkStateActive
+		pl.Status.Connections = []v1alpha1.PrivateLinkConnectionStatus{
+			{ID: "plc-other", EndpointID: "other-endpoint", State: privateLinkConnectionStatePendingUserApproval},
+			{ID: "plc-1", EndpointID: endpointID, State: state, UserIPAddress: userIPAddress},
+		}
+		return pl
+	}
+
+	expectUpdate := func(avn *avngen.MockClient) {
+		avn.EXPECT().
+			ServicePrivatelinkAzureUpdate(mock.Anything, "aiven-project-name", "my-pg", mock.MatchedBy(func(in *privatelink.ServicePrivatelinkAzureUpdateIn) bool {
+				return assert.ObjectsAreEqual([]string{"00000000-0000-0000-0000-000000000001"}, in.UserSubscriptionIds)
+			})).
+			Return(nil, nil).Once()
+	}
+
+	t.Run("Approves only the pending connections of the spec", func(t *testing.T) {
+		pl := newPrivateLink(t, privateLinkConnectionStatePendingUserApproval, "")
+		require.True(t, azurePrivateLinkHasPendingConnections(pl))
+
+		avn := avngen.NewMockClient(t)
+		expectUpdate(avn)
+		avn.EXPECT().
+			ServicePrivatelinkAzureConnectionApproval(mock.Anything, "aiven-project-name", "my-pg", "plc-1").
+			Return(nil, nil).Once()
+
+		_, err := (&AzurePrivateLinkController{avnGen: avn}).Update(t.Context(), pl)
+		require.NoError(t, err)
+	})
+
+	t.Run("Sets the IP address of an approved connection", func(t *testing.T) {
+		pl := newPrivateLink(t, "user-approved", "")
+		require.True(t, azurePrivateLinkHasPendingConnections(pl))
+
+		avn := avngen.NewMockClient(t)
+		expectUpdate(avn)
+		avn.EXPECT().
+			ServicePrivatelinkAzureConnectionUpdate(mock.Anything, "aiven-project-name", "my-pg", "plc-1", &privatelink.ServicePrivatelinkAzureConnectionUpdateIn{
+				UserIpAddress: "10.0.0.4",
+			}).
+			Return(nil, nil).Once()
+
+		_, err := (&AzurePrivateLinkController{avnGen: avn}).Update(t.Context(), pl)
+		require.NoError(t, err)
+	})
+
+	t.Run("Has nothing to approve once connected", func(t *testing.T) {
+		pl := newPrivateLink(t, privateLinkStateActive, "10.0.0.4")
+		assert.False(t, azurePrivateLinkHasPendingConnections(pl))
+	})
+}
+
+func TestGCPPrivateLinkController(t *testing.T) {
+	t.Parallel()
+
+	pl := newObjectFromExampleYAMLByKind[v1alpha1.GCPPrivateLink](t, "gcpprivatelink", "GCPPrivateLink")
+	pl.Status.State = privateLinkStateActive
+	pl.Status.Connections = []v1alpha1.PrivateLinkConnectionStatus{
+		{ID: "plc-1", EndpointID: "12345678901234567", State: privateLinkConnectionStatePendingUserApproval},
+		{ID: "plc-2", EndpointID: "76543210987654321", State: privateLinkConnectionStatePendingUserApproval},
+	}
+	require.True(t, gcpPrivateLinkHasPendingConnections(pl))
+
+	avn := avngen.NewMockClient(t)
+	avn.EXPECT().
+		ServicePrivatelinkGoogleConnectionApproval(mock.Anything, "aiven-project-name", "my-kafka", "plc-1", &privatelink.ServicePrivatelinkGoogleConnectionApprovalIn{
+			UserIpAddress: "10.0.0.4",
+		}).
+		Return(nil, nil).Once()
+
+	_, err := (&GCPPrivateLinkController{avnGen: avn}).Update(t.Context(), pl)
+	require.NoError(t, err)
+}
+
+func TestPrivatelinkEndpointDetails(t *testing.T) {
+	t.Parallel()
+
+	components := []service.ComponentOut{
+		{Component: "kafka", Host: "kafka.aiven", Port: 1, Route: service.RouteTypeDynamic, Usage: service.UsageTypePrimary},
+		{Component: "kafka", Host: "privatelink-kafka.aiven", Port: 2, Route: service.RouteTypePrivatelink, Usage: service.UsageTypePrimary},
+		{
+			Component: "kafka", Host: "kafka.aiven", Port: 3, Route: service.RouteTypeDynamic, Usage: service.UsageTypePrimary,
+			KafkaAuthenticationMethod: service.KafkaAuthenticationMethodTypeSasl,
+		},
+		{
+			Component: "kafka", Host: "privatelink-kafka.aiven", Port: 4, Route: service.RouteTypePrivatelink, Usage: service.UsageTypePrimary,
+			KafkaAuthenticationMethod: service.KafkaAuthenticationMethodTypeSasl,
+		},
+		{Component: "schema_registry", Host: "kafka.aiven", Port: 5, Route: service.RouteTypeDynamic, Usage: service.UsageTypePrimary},
+		{Component: "schema_registry", Host: "privatelink-kafka.aiven", Port: 6, Route: service.RouteTypePrivatelink, Usage: service.UsageTypePrimary},
+	}
+
+	t.Run("Adds the Kafka privatelink variants", func(t *testing.T) {
+		details := SecretDetails{}
+		addKafkaEndpointDetails(details, components, "KAFKA_")
+		assert.Equal(t, SecretDetails{
+			"KAFKA_PRIVATELINK_HOST":                 "privatelink-kafka.aiven",
+			"KAFKA_PRIVATELINK_PORT":                 "2",
+			"KAFKA_SASL_HOST":                        "kafka.aiven",
+			"KAFKA_SASL_PORT":                        "3",
+			"KAFKA_PRIVATELINK_SASL_HOST":            "privatelink-kafka.aiven",
+			"KAFKA_PRIVATELINK_SASL_PORT":            "4",
+			"KAFKA_SCHEMA_REGISTRY_HOST":             "kafka.aiven",
+			"KAFKA_SCHEMA_REGISTRY_PORT":             "5",
+			"KAFKA_PRIVATELINK_SCHEMA_REGISTRY_HOST": "privatelink-kafka.aiven",
+			"KAFKA_PRIVATELINK_SCHEMA_REGISTRY_PORT": "6",
+		}, details)
+	})
+
+	t.Run("Adds the primary privatelink endpoint of the component", func(t *testing.T) {
+		details := map[string]string{}
+		addPrivatelinkEndpointDetails(details, []service.ComponentOut{
+			{Component: "pg", Host: "pg.aiven", Port: 1, Route: service.RouteTypeDynamic, Usage: service.UsageTypePrimary},
+			{Component: "pg", Host: "replica-pg.aiven", Port: 2, Route: service.RouteTypePrivatelink, Usage: service.UsageTypeReplica},
+			{Component: "pg", Host: "privatelink-pg.aiven", Port: 3, Route: service.RouteTypePrivatelink, Usage: service.UsageTypePrimary},
+		}, "pg", "PG_")
+		assert.Equal(t, map[string]string{"PG_PRIVATELINK_HOST": "privatelink-pg.aiven", "PG_PRIVATELINK_PORT": "3"}, details)
+	})
+}
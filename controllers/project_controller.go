@@ -77,11 +77,19 @@ func (r *ProjectController) Observe(ctx context.Context, cr *v1alpha1.Project) (
 func (r *ProjectController) Create(ctx context.Context, cr *v1alpha1.Project) (CreateResult, error) {
 	delete(cr.GetAnnotations(), instanceIsRunningAnnotation)
 
-	cardID, err := r.getLongCardID(ctx, cr.Spec.CardID)
+	cardID, err := getLongCardID(ctx, r.avnGen, cr.Spec.CardID)
 	if err != nil {
 		return CreateResult{}, fmt.Errorf("getting long card id: %w", err)
 	}
 
+	billingGroupID := cr.Spec.BillingGroupID
+	if cr.Spec.BillingGroupRef != nil {
+		billingGroupID, err = getBillingGroupID(ctx, r.Client, cr.Namespace, cr.Spec.BillingGroupRef)
+		if err != nil {
+			return CreateResult{}, err
+		}
+	}
+
 	billingEmails := projectBillingEmails(cr.Spec.BillingEmails)
 	technicalEmails := projectTechnicalEmails(cr.Spec.TechnicalEmails)
 
@@ -97,7 +105,7 @@ func (r *ProjectController) Create(ctx context.Context, cr *v1alpha1.Project) (C
 		Cloud:            NilIfZero(cr.Spec.Cloud),
 		CountryCode:      NilIfZero(cr.Spec.CountryCode),
 		AccountId:        NilIfZero(cr.Spec.AccountID),
-		BillingGroupId:   NilIfZero(cr.Spec.BillingGroupID),
+		BillingGroupId:   NilIfZero(billingGroupID),
 		CopyFromProject:  NilIfZero(cr.Spec.CopyFromProject),
 	})
 	if err != nil {
@@ -120,7 +128,7 @@ func (r *ProjectController) Create(ctx context.Context, cr *v1alpha1.Project) (C
 func (r *ProjectController) Update(ctx context.Context, cr *v1alpha1.Project) (UpdateResult, error) {
 	delete(cr.GetAnnotations(), instanceIsRunningAnnotation)
 
-	cardID, err := r.getLongCardID(ctx, cr.Spec.CardID)
+	cardID, err := getLongCardID(ctx, r.avnGen, cr.Spec.CardID)
 	if err != nil {
 		return UpdateResult{}, fmt.Errorf("getting long card id: %w", err)
 	}
@@ -166,13 +174,14 @@ func (r *ProjectController) Delete(ctx context.Context, project *v1alpha1.Projec
 	return nil
 }
 
-func (r *ProjectController) getLongCardID(ctx context.Context, cardID string) (*string, error) {
+// getLongCardID returns the ID of the card given by its last 4 digits or the ID
+func getLongCardID(ctx context.Context, avnGen avngen.Client, cardID string) (*string, error) {
 	if cardID == "" {
 		return nil, nil
 	}
 
 	// Uses the deprecated UserCreditCardsList method to retrieve credit cards.
-	cards, err := r.avnGen.UserCreditCardsList(ctx) // nolint:staticcheck
+	cards, err := avnGen.UserCreditCardsList(ctx) // nolint:staticcheck
 	if err != nil {
 		return nil, err
 	}
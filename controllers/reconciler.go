@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -100,6 +99,9 @@ type managedAnnotationsPatchMetadata struct {
 func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 	obj := r.newObj()
 	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			objectConditions.forget(objectKind(obj), req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	ctx = logr.NewContext(ctx, setupLogger(r.Log, obj))
@@ -117,6 +119,7 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 		meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionPreconditions, err))
 		return ctrl.Result{}, fmt.Errorf("unable to resolve references: %w", err)
 	} else if requeue {
+		recordPreconditionWait(obj)
 		r.Recorder.Event(obj, corev1.EventTypeNormal, eventWaitingForPreconditions, "waiting for referenced resources to be ready")
 		return ctrl.Result{RequeueAfter: requeueTimeout}, nil
 	}
@@ -125,6 +128,8 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 		return ctrl.Result{}, err
 	}
 
+	// Read before the client is created: a token rotated in between is marked on the next reconcile
+	fingerprint := r.authTokenFingerprint(ctx, obj)
 	avnGen, err := r.newAivenClient(ctx, obj)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -134,6 +139,7 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 	orig := obj.DeepCopyObject().(v1alpha1.AivenManagedObject)
 	defer func() {
 		err = errors.Join(err, r.persistReconcileState(ctx, orig, obj))
+		objectConditions.observe(obj)
 	}()
 
 	meta.SetStatusCondition(obj.Conditions(), getInitializedCondition("Preconditions", "Checking preconditions"))
@@ -141,6 +147,23 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 	if err != nil {
 		return r.handleObserveError(ctx, obj, err)
 	}
+	markAuthToken(obj, fingerprint)
+
+	// The spec is the same as at the last reconcile, so the remote resource was changed outside the operator.
+	if obs.ResourceExists && !obs.ResourceUpToDate && hasLatestGeneration(obj) {
+		recordDriftDetection(obj)
+	}
+
+	if isDryRun(obj, r.DryRun) {
+		return r.planResource(ctx, obj, obs)
+	}
+	meta.RemoveStatusCondition(obj.Conditions(), conditionTypePlanned)
+
+	if obs.ResourceExists && needsAdoption(obj) {
+		if err := adoptResource(ctx, r.Recorder, obj, r.AdoptionPolicy, obs.Diff, obs.Compared); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 
 	if !obs.ResourceExists {
 		return r.createResource(ctx, controller, obj)
@@ -150,13 +173,28 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 		return r.updateResource(ctx, controller, obj)
 	}
 
-	if err := r.publishSecretDetails(ctx, obj, obs.SecretDetails); err != nil {
+	if err := r.publishResult(ctx, obj, obs); err != nil {
 		return ctrl.Result{}, err
 	}
 
 	return r.completeReconcileSuccess(obj)
 }
 
+// planResource records what the reconciler would do with the observed resource, without calling Create or Update.
+// The processed generation is left untouched, so the change is applied once dry-run mode is turned off.
+func (r *Reconciler[T]) planResource(ctx context.Context, obj T, obs Observation) (ctrl.Result, error) {
+	plan := plannedAction{Action: plannedActionNone}
+	switch {
+	case !obs.ResourceExists:
+		plan = plannedAction{Action: plannedActionCreate, Diff: obs.Diff}
+	case !obs.ResourceUpToDate:
+		plan = plannedAction{Action: plannedActionUpdate, Diff: obs.Diff}
+	}
+
+	recordPlannedAction(ctx, r.Recorder, obj, plan)
+	return ctrl.Result{RequeueAfter: r.PollInterval}, nil
+}
+
 func (r *Reconciler[T]) ensureFinalizer(ctx context.Context, obj client.Object) error {
 	if controllerutil.ContainsFinalizer(obj, instanceDeletionFinalizer) {
 		return nil
@@ -172,17 +210,12 @@ func (r *Reconciler[T]) ensureFinalizer(ctx context.Context, obj client.Object)
 }
 
 func (r *Reconciler[T]) ensureAuthSecretFinalizer(ctx context.Context, obj T) error {
-	if r.DefaultToken != "" {
-		return nil
-	}
-
-	auth := obj.AuthSecretRef()
-	if auth == nil {
+	if r.hasDefaultToken() {
 		return nil
 	}
 
-	secret, err := r.getAuthSecret(ctx, obj, auth)
-	if err != nil {
+	secret, _, err := r.resolveAuthSecret(ctx, obj)
+	if err != nil || secret == nil {
 		return err
 	}
 
@@ -205,6 +238,10 @@ func (r *Reconciler[T]) handleObserveError(ctx context.Context, obj T, err error
 		return ctrl.Result{RequeueAfter: requeueTimeout}, nil
 	}
 
+	if requeue, ok := r.handleThrottled(ctx, obj, err); ok {
+		return requeue, nil
+	}
+
 	if errors.Is(err, errServicePoweredOff) {
 		r.Recorder.Event(obj, corev1.EventTypeWarning, eventUnableToWaitForPreconditions, err.Error())
 		meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionPreconditions, err))
@@ -213,6 +250,7 @@ func (r *Reconciler[T]) handleObserveError(ctx context.Context, obj T, err error
 
 	if errors.Is(err, errPreconditionNotMet) {
 		const msg = "preconditions are not met, requeue"
+		recordPreconditionWait(obj)
 		r.Recorder.Event(obj, corev1.EventTypeNormal, eventPreconditionsNotMet, msg)
 		logr.FromContextOrDiscard(ctx).V(1).Info(msg)
 		return ctrl.Result{RequeueAfter: requeueTimeout}, nil
@@ -327,7 +365,12 @@ func (r *Reconciler[T]) newAivenClient(ctx context.Context, obj T) (avngen.Clien
 		return nil, err
 	}
 
-	avnGen, err := r.newAivenGeneratedClient(token, r.KubeVersion, r.OperatorVersion)
+	newClient := r.newAivenGeneratedClient
+	if r.TokenProvider != nil {
+		newClient = newTokenProviderClientFunc(r.TokenProvider)
+	}
+
+	avnGen, err := newClient(token, r.KubeVersion, r.OperatorVersion)
 	if err != nil {
 		r.Recorder.Event(obj, corev1.EventTypeWarning, eventUnableToCreateClient, err.Error())
 		return nil, fmt.Errorf("cannot initialize aiven generated client: %w", err)
@@ -337,31 +380,23 @@ func (r *Reconciler[T]) newAivenClient(ctx context.Context, obj T) (avngen.Clien
 }
 
 func (r *Reconciler[T]) resolveToken(ctx context.Context, obj T) (string, error) {
-	if r.DefaultToken != "" {
-		return r.DefaultToken, nil
+	if r.TokenProvider != nil {
+		return r.providerToken(ctx, obj)
 	}
 
-	auth := obj.AuthSecretRef()
-	if auth == nil {
-		return "", errNoTokenProvided
+	if r.DefaultToken != "" {
+		return r.DefaultToken, nil
 	}
 
-	clientAuthSecret, err := r.getAuthSecret(ctx, obj, auth)
+	clientAuthSecret, key, err := r.resolveAuthSecret(ctx, obj)
 	if err != nil {
 		return "", err
 	}
-
-	return string(clientAuthSecret.Data[auth.Key]), nil
-}
-
-func (r *Reconciler[T]) getAuthSecret(ctx context.Context, obj T, auth *v1alpha1.AuthSecretReference) (*corev1.Secret, error) {
-	secret := &corev1.Secret{}
-	if err := r.Get(ctx, types.NamespacedName{Name: auth.Name, Namespace: obj.GetNamespace()}, secret); err != nil {
-		r.Recorder.Eventf(obj, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
-		return nil, fmt.Errorf("cannot get secret %q: %w", auth.Name, err)
+	if clientAuthSecret == nil {
+		return "", errNoTokenProvided
 	}
 
-	return secret, nil
+	return string(clientAuthSecret.Data[key]), nil
 }
 
 func (r *Reconciler[T]) createResource(ctx context.Context, controller AivenController[T], obj T) (ctrl.Result, error) {
@@ -372,13 +407,17 @@ func (r *Reconciler[T]) createResource(ctx context.Context, controller AivenCont
 		if requeue, ok := r.handlePreconditionNotMet(ctx, obj, err); ok {
 			return requeue, nil
 		}
+		if requeue, ok := r.handleThrottled(ctx, obj, err); ok {
+			return requeue, nil
+		}
 		r.Recorder.Event(obj, corev1.EventTypeWarning, eventUnableToCreateOrUpdateAtAiven, err.Error())
 		meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionCreateOrUpdate, err))
 		return ctrl.Result{}, fmt.Errorf("unable to create or update instance at aiven: %w", err)
 	}
+	markCreatedAtAiven(obj)
 	r.Recorder.Event(obj, corev1.EventTypeNormal, eventCreatedOrUpdatedAtAiven, "instance was created at aiven but may not be running yet")
 
-	if err := r.publishSecretDetails(ctx, obj, res.SecretDetails); err != nil {
+	if err := r.publishResult(ctx, obj, res); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -397,12 +436,15 @@ func (r *Reconciler[T]) updateResource(ctx context.Context, controller AivenCont
 		if requeue, ok := r.handlePreconditionNotMet(ctx, obj, err); ok {
 			return requeue, nil
 		}
+		if requeue, ok := r.handleThrottled(ctx, obj, err); ok {
+			return requeue, nil
+		}
 
 		r.Recorder.Event(obj, corev1.EventTypeWarning, eventUnableToWaitForInstanceToBeRunning, err.Error())
 		return ctrl.Result{}, fmt.Errorf("unable to wait until instance is running: %w", err)
 	}
 
-	if err := r.publishSecretDetails(ctx, obj, res.SecretDetails); err != nil {
+	if err := r.publishResult(ctx, obj, res); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -412,6 +454,7 @@ func (r *Reconciler[T]) updateResource(ctx context.Context, controller AivenCont
 func (r *Reconciler[T]) completeReconcileSuccess(obj v1alpha1.AivenManagedObject) (ctrl.Result, error) {
 	// Reconciliation succeeded, remove any Error condition from a previous failed attempt.
 	meta.RemoveStatusCondition(obj.Conditions(), ConditionTypeError)
+	meta.RemoveStatusCondition(obj.Conditions(), conditionTypeThrottled)
 
 	metav1.SetMetaDataAnnotation(
 		obj.GetObjectMeta(),
@@ -428,6 +471,17 @@ func (r *Reconciler[T]) completeReconcileSuccess(obj v1alpha1.AivenManagedObject
 	return ctrl.Result{RequeueAfter: requeueTimeout}, nil
 }
 
+// publishResult publishes the connection details of the result and notifies the controller.
+func (r *Reconciler[T]) publishResult(ctx context.Context, obj T, res Observation) error {
+	if err := r.publishSecretDetails(ctx, obj, res.SecretDetails); err != nil {
+		return err
+	}
+	if res.SecretPublished != nil {
+		res.SecretPublished()
+	}
+	return nil
+}
+
 // publishSecretDetails publishes connection details to the connection secret if present.
 // It emits appropriate events when secret creation is disabled or when syncing fails.
 func (r *Reconciler[T]) publishSecretDetails(ctx context.Context, obj T, details map[string]string) error {
@@ -453,33 +507,12 @@ func (r *Reconciler[T]) publishSecretDetails(ctx context.Context, obj T, details
 	}
 
 	goalSecret := r.newSecret(withSecret, details, false)
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      goalSecret.Name,
-			Namespace: goalSecret.Namespace,
-		},
+	sink, err := newSecretSink(ctx, r.Client, r.Scheme, obj, true)
+	if err == nil {
+		err = sink.Write(ctx, goalSecret)
 	}
-	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
-		if len(goalSecret.Data) > 0 {
-			if secret.Data == nil {
-				secret.Data = map[string][]byte{}
-			}
-			maps.Copy(secret.Data, goalSecret.Data)
-		}
-		if goalSecret.StringData != nil {
-			if secret.Data == nil {
-				secret.Data = map[string][]byte{}
-			}
-			for key, value := range goalSecret.StringData {
-				secret.Data[key] = []byte(value)
-			}
-		}
-
-		secret.Labels = goalSecret.Labels
-		secret.Annotations = goalSecret.Annotations
-
-		return controllerutil.SetControllerReference(obj, secret, r.Scheme)
-	}); err != nil {
+	if err != nil {
+		recordSecretPublishFailure(obj)
 		r.Recorder.Event(obj, corev1.EventTypeWarning, eventCannotPublishConnectionDetails, err.Error())
 		meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionConnInfoSecret, err))
 		return fmt.Errorf("unable to sync connection secret: %w", err)
@@ -508,6 +541,10 @@ func (r *Reconciler[T]) reconcileDeletion(ctx context.Context, obj T) (ctrl.Resu
 	// Parse the annotations for the deletion policy. For simplicity, we only allow 'Orphan'.
 	// If set will skip the deletion of the remote object. Disable by removing the annotation.
 	switch policy, hasDeletionPolicy := obj.GetAnnotations()[deletionPolicyAnnotation]; {
+	case !hasDeletionPolicy && isDryRun(obj, r.DryRun):
+		// Keep the finalizer: the object stays in deletion until dry-run mode is turned off.
+		recordPlannedAction(ctx, r.Recorder, obj, plannedAction{Action: plannedActionDelete})
+		return ctrl.Result{RequeueAfter: r.PollInterval}, r.persistReconcileState(ctx, orig, obj)
 	case !hasDeletionPolicy:
 		avnGen, err := r.newAivenClient(ctx, obj)
 		if err != nil {
@@ -555,6 +592,10 @@ func (r *Reconciler[T]) reconcileDeletion(ctx context.Context, obj T) (ctrl.Resu
 
 // handleDeleteError handles errors returned from Delete during deletion reconciliation.
 func (r *Reconciler[T]) handleDeleteError(ctx context.Context, orig v1alpha1.AivenManagedObject, obj T, err error) (ctrl.Result, error) {
+	if requeue, ok := r.handleThrottled(ctx, obj, err); ok {
+		return requeue, r.persistReconcileState(ctx, orig, obj)
+	}
+
 	meta.SetStatusCondition(obj.Conditions(), getErrorCondition(errConditionDelete, err))
 
 	// There are dependencies on Aiven side.
@@ -595,11 +636,23 @@ func (r *Reconciler[T]) handlePreconditionNotMet(ctx context.Context, obj T, err
 		return ctrl.Result{}, false
 	}
 	const msg = "preconditions are not met, requeue"
+	recordPreconditionWait(obj)
 	r.Recorder.Event(obj, corev1.EventTypeNormal, eventPreconditionsNotMet, msg)
 	logr.FromContextOrDiscard(ctx).V(1).Info(msg, "error", err)
 	return ctrl.Result{RequeueAfter: requeueTimeout}, true
 }
 
+// handleThrottled matches the rate limit error returned when the client retries are exhausted,
+// and returns (soft-requeue result, true); for any other error it returns (zero, false).
+func (r *Reconciler[T]) handleThrottled(ctx context.Context, obj T, err error) (ctrl.Result, bool) {
+	if !isThrottled(err) {
+		return ctrl.Result{}, false
+	}
+	logr.FromContextOrDiscard(ctx).Info("throttled by Aiven API, requeue", "error", err)
+	markThrottled(r.Recorder, obj, err)
+	return ctrl.Result{RequeueAfter: requeueTimeout}, true
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler[T]) SetupWithManager(mgr ctrl.Manager) error {
 	// Indexers must be registered before the cache starts, so they run first.
@@ -626,10 +679,14 @@ func (r *Reconciler[T]) SetupWithManager(mgr ctrl.Manager) error {
 	return b.Complete(r)
 }
 
-// isInvalidTokenError checks if the error is related to invalid token
+// isInvalidTokenError checks if the error is related to invalid token.
+// With a token provider, the request has already been retried with a refreshed token.
 func isInvalidTokenError(err error) bool {
 	// When an instance was created but pointing to an invalid API token
 	// and no generation was ever processed, allow deleting such instance
-	msg := err.Error()
+	return isInvalidTokenMessage(err.Error())
+}
+
+func isInvalidTokenMessage(msg string) bool {
 	return strings.Contains(msg, "Invalid token") || strings.Contains(msg, "Missing (expired) db token")
 }
@@ -489,6 +489,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 		require.Equal(t, 1, newClientCalls)
 		require.Equal(t, []string{
 			"Normal InstanceFinalizerAdded instance finalizer added",
+			"Normal AdoptedAtAiven the resource already existed at aiven and was adopted",
 		}, recorderEvents(recorder))
 	})
 
@@ -1021,6 +1022,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 		require.Equal(t, ctrl.Result{RequeueAfter: requeueTimeout}, res)
 		require.Equal(t, []string{
 			"Normal InstanceFinalizerAdded instance finalizer added",
+			"Normal AdoptedAtAiven the resource already existed at aiven and was adopted",
 			"Normal WaitingForInstanceToBeRunning waiting for the instance to be running",
 		}, recorderEvents(recorder))
 	})
@@ -1140,6 +1142,203 @@ func TestReconciler_Reconcile(t *testing.T) {
 	})
 }
 
+func TestReconciler_DryRun(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newDryRunReconciler := func(t *testing.T, obj *v1alpha1.ClickhouseUser, c AivenController[*v1alpha1.ClickhouseUser], dryRun bool) (*Reconciler[*v1alpha1.ClickhouseUser], crclient.Client, *record.FakeRecorder) {
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&v1alpha1.ClickhouseUser{}).
+			WithObjects(obj).
+			Build()
+		recorder := record.NewFakeRecorder(10)
+
+		m := &mock.Mock{}
+		t.Cleanup(func() { m.AssertExpectations(t) })
+		m.On("newAivenGeneratedClient", "default-token", "v1.30.0", "v0.0.0-test").
+			Return(avngen.NewMockClient(t), nil).
+			Maybe()
+
+		r := &Reconciler[*v1alpha1.ClickhouseUser]{
+			Controller: Controller{
+				Client:          k8sClient,
+				Scheme:          scheme,
+				Recorder:        recorder,
+				DefaultToken:    "default-token",
+				KubeVersion:     "v1.30.0",
+				OperatorVersion: "v0.0.0-test",
+				PollInterval:    testPollInterval,
+				DryRun:          dryRun,
+			},
+			newAivenGeneratedClient: mockNewAivenGeneratedClient(m),
+			newController: func(avngen.Client) AivenController[*v1alpha1.ClickhouseUser] {
+				return c
+			},
+			newObj: func() *v1alpha1.ClickhouseUser { return &v1alpha1.ClickhouseUser{} },
+		}
+		return r, k8sClient, recorder
+	}
+
+	t.Run("Plans Create without calling it", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		delete(obj.GetAnnotations(), processedGenerationAnnotation)
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		c.EXPECT().
+			Observe(mock.Anything, mock.Anything).
+			Return(Observation{ResourceExists: false}, nil).
+			Once()
+
+		r, k8sClient, recorder := newDryRunReconciler(t, obj, c, true)
+		res, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{RequeueAfter: testPollInterval}, res)
+		require.Equal(t, []string{
+			"Normal InstanceFinalizerAdded instance finalizer added",
+			"Normal DryRunPlannedAction would create the resource at Aiven",
+		}, recorderEvents(recorder))
+
+		got := &v1alpha1.ClickhouseUser{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, got))
+		require.False(t, hasLatestGeneration(got))
+		cond := meta.FindStatusCondition(got.Status.Conditions, conditionTypePlanned)
+		require.NotNil(t, cond)
+		require.Equal(t, metav1.ConditionTrue, cond.Status)
+		require.Equal(t, string(plannedActionCreate), cond.Reason)
+	})
+
+	t.Run("Plans Update with the field diff", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		c.EXPECT().
+			Observe(mock.Anything, mock.Anything).
+			Return(Observation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+				Diff:             []FieldDiff{{Path: "spec.partitions", Desired: "3", Actual: "1"}},
+			}, nil).
+			Once()
+
+		r, k8sClient, recorder := newDryRunReconciler(t, obj, c, true)
+		_, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Normal InstanceFinalizerAdded instance finalizer added",
+			`Normal DryRunPlannedAction would update the resource at Aiven: spec.partitions: "1" -> "3"`,
+		}, recorderEvents(recorder))
+
+		got := &v1alpha1.ClickhouseUser{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, got))
+		require.Equal(t, string(plannedActionUpdate), meta.FindStatusCondition(got.Status.Conditions, conditionTypePlanned).Reason)
+	})
+
+	t.Run("Reports no changes when resource is up to date", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		c.EXPECT().
+			Observe(mock.Anything, mock.Anything).
+			Return(Observation{ResourceExists: true, ResourceUpToDate: true}, nil).
+			Once()
+
+		r, k8sClient, recorder := newDryRunReconciler(t, obj, c, true)
+		_, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Normal InstanceFinalizerAdded instance finalizer added",
+		}, recorderEvents(recorder))
+
+		got := &v1alpha1.ClickhouseUser{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, got))
+		require.False(t, meta.IsStatusConditionTrue(got.Status.Conditions, conditionTypePlanned))
+	})
+
+	t.Run("Annotation enables dry-run for a single object", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, dryRunAnnotation, "true")
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		c.EXPECT().
+			Observe(mock.Anything, mock.Anything).
+			Return(Observation{ResourceExists: false}, nil).
+			Once()
+
+		r, _, recorder := newDryRunReconciler(t, obj, c, false)
+		_, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+
+		require.NoError(t, err)
+		require.Contains(t, recorderEvents(recorder), "Normal DryRunPlannedAction would create the resource at Aiven")
+	})
+
+	t.Run("Annotation disables operator-wide dry-run and clears the Planned condition", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, dryRunAnnotation, "false")
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:   conditionTypePlanned,
+			Status: metav1.ConditionTrue,
+			Reason: string(plannedActionUpdate),
+		})
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		c.EXPECT().
+			Observe(mock.Anything, mock.Anything).
+			Return(Observation{ResourceExists: true, ResourceUpToDate: false}, nil).
+			Once()
+		c.EXPECT().
+			Update(mock.Anything, mock.Anything).
+			Return(UpdateResult{}, nil).
+			Once()
+
+		r, k8sClient, _ := newDryRunReconciler(t, obj, c, true)
+		_, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+		require.NoError(t, err)
+
+		got := &v1alpha1.ClickhouseUser{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, got))
+		require.Nil(t, meta.FindStatusCondition(got.Status.Conditions, conditionTypePlanned))
+	})
+
+	t.Run("Plans Delete and keeps the finalizer", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		obj.DeletionTimestamp = new(metav1.Now())
+		obj.Finalizers = []string{instanceDeletionFinalizer}
+
+		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
+		r, k8sClient, recorder := newDryRunReconciler(t, obj, c, true)
+		res, err := r.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{RequeueAfter: testPollInterval}, res)
+		require.Equal(t, []string{
+			"Normal DryRunPlannedAction would delete the resource at Aiven",
+		}, recorderEvents(recorder))
+
+		got := &v1alpha1.ClickhouseUser{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, got))
+		require.Contains(t, got.Finalizers, instanceDeletionFinalizer)
+	})
+}
+
 func TestReconciler_ensureFinalizer(t *testing.T) {
 	t.Parallel()
 
@@ -1437,6 +1636,31 @@ func TestReconciler_handleObserveError(t *testing.T) {
 		require.Empty(t, normalizedConditions(obj.Status.Conditions))
 	})
 
+	t.Run("Sets Throttled condition when rate limited", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := &Reconciler[*v1alpha1.ClickhouseUser]{
+			Controller: Controller{
+				Recorder: recorder,
+			},
+		}
+
+		obj := &v1alpha1.ClickhouseUser{}
+		aivenErr := newAivenError(http.StatusTooManyRequests, "rate limit exceeded")
+		res, err := r.handleObserveError(t.Context(), obj, aivenErr)
+
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{RequeueAfter: requeueTimeout}, res)
+		require.Equal(t, []string{"Warning ThrottledByAiven " + aivenErr.Error()}, recorderEvents(recorder))
+		require.ElementsMatch(t, []metav1.Condition{
+			{
+				Type:    conditionTypeThrottled,
+				Status:  metav1.ConditionTrue,
+				Reason:  "RateLimited",
+				Message: aivenErr.Error(),
+			},
+		}, normalizedConditions(obj.Status.Conditions))
+	})
+
 	t.Run("Returns error on non-retryable error", func(t *testing.T) {
 		recorder := record.NewFakeRecorder(10)
 		r := &Reconciler[*v1alpha1.ClickhouseUser]{
@@ -2259,6 +2483,10 @@ func TestReconciler_createResource(t *testing.T) {
 		err = k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, secret)
 		require.True(t, apierrors.IsNotFound(err))
 
+		// The next reconcile must not see the created resource as a foreign one
+		require.False(t, hasLatestGeneration(obj))
+		require.False(t, needsAdoption(obj))
+
 		require.Equal(t, []metav1.Condition{
 			{
 				Type:    ConditionTypeError,
@@ -2431,7 +2659,10 @@ func TestReconciler_updateResource(t *testing.T) {
 		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
 		c.EXPECT().
 			Update(mock.Anything, mock.Anything).
-			Return(UpdateResult{SecretDetails: map[string]string{"BAR": "bar"}}, nil).
+			Return(UpdateResult{
+				SecretDetails:   map[string]string{"BAR": "bar"},
+				SecretPublished: func() { t.Error("SecretPublished is called, but the secret wasn't published") },
+			}, nil).
 			Once()
 
 		res, err := r.updateResource(t.Context(), c, obj)
@@ -2476,15 +2707,20 @@ func TestReconciler_updateResource(t *testing.T) {
 			newSecret: newSecret,
 		}
 
+		published := 0
 		c := NewMockAivenController[*v1alpha1.ClickhouseUser](t)
 		c.EXPECT().
 			Update(mock.Anything, mock.Anything).
-			Return(UpdateResult{SecretDetails: map[string]string{"BAR": "bar"}}, nil).
+			Return(UpdateResult{
+				SecretDetails:   map[string]string{"BAR": "bar"},
+				SecretPublished: func() { published++ },
+			}, nil).
 			Once()
 
 		res, err := r.updateResource(t.Context(), c, obj)
 		require.NoError(t, err)
 		require.Equal(t, ctrl.Result{RequeueAfter: requeueTimeout}, res)
+		require.Equal(t, 1, published)
 
 		secret := &corev1.Secret{}
 		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, secret))
@@ -2518,6 +2754,10 @@ func (t *testManagedWithoutSecretTarget) AuthSecretRef() *v1alpha1.AuthSecretRef
 	panic("not implemented")
 }
 
+func (t *testManagedWithoutSecretTarget) CredentialsRef() *v1alpha1.CredentialsReference {
+	panic("not implemented")
+}
+
 func (t *testManagedWithoutSecretTarget) Conditions() *[]metav1.Condition {
 	panic("not implemented")
 }
@@ -39,6 +39,9 @@ func (c *SecretFinalizerGCController) SetupWithManager(mgr ctrl.Manager, hasDefa
 	if err := indexClientSecretRefFields(context.Background(), mgr, aivenManagedTypes...); err != nil {
 		return fmt.Errorf("unable to add index for secret ref fields: %w", err)
 	}
+	if err := indexCredentialsFields(context.Background(), mgr, aivenManagedTypes...); err != nil {
+		return fmt.Errorf("unable to add index for credentials fields: %w", err)
+	}
 	builder := ctrl.NewControllerManagedBy(mgr)
 	builder.Named("secret-finalizer-gc")
 	builder.For(&corev1.Secret{})
@@ -50,9 +53,23 @@ func (c *SecretFinalizerGCController) SetupWithManager(mgr ctrl.Manager, hasDefa
 	for i := range aivenManagedTypes {
 		builder.Watches(
 			aivenManagedTypes[i],
-			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, a client.Object) []reconcile.Request {
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
 				ao := a.(v1alpha1.AivenManagedObject)
-				if auth := ao.AuthSecretRef(); auth != nil {
+				if creds := ao.CredentialsRef(); creds != nil {
+					secretRef, err := getCredentialsSecretRef(ctx, c, ao.GetNamespace(), creds)
+					if err != nil {
+						c.Log.Info("unable to get the secret of the credentials", "error", err.Error())
+						return nil
+					}
+					return []reconcile.Request{
+						{
+							NamespacedName: types.NamespacedName{
+								Name:      secretRef.Name,
+								Namespace: secretRef.Namespace,
+							},
+						},
+					}
+				} else if auth := ao.AuthSecretRef(); auth != nil {
 					return []reconcile.Request{
 						{
 							NamespacedName: types.NamespacedName{
@@ -74,6 +91,22 @@ func (c *SecretFinalizerGCController) SetupWithManager(mgr ctrl.Manager, hasDefa
 		)
 	}
 
+	// watch credentials to queue the previous secret when the credentials change
+	builder.Watches(
+		&v1alpha1.AivenCredentials{},
+		handler.EnqueueRequestsFromMapFunc(func(_ context.Context, a client.Object) []reconcile.Request {
+			ref := a.(*v1alpha1.AivenCredentials).Spec.SecretRef
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}}}
+		}),
+	)
+	builder.Watches(
+		&v1alpha1.AivenNamespaceCredentials{},
+		handler.EnqueueRequestsFromMapFunc(func(_ context.Context, a client.Object) []reconcile.Request {
+			ref := a.(*v1alpha1.AivenNamespaceCredentials).Spec.SecretRef
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ref.Name, Namespace: a.GetNamespace()}}}
+		}),
+	)
+
 	return builder.Complete(c)
 }
 
@@ -104,18 +137,22 @@ func authSecretRefChanged(oldObj, newObj client.Object) bool {
 	return authSecretRefName(oldObj) != authSecretRefName(newObj)
 }
 
+// authSecretRefName returns the reference to the token secret of managed objects and credentials
 func authSecretRefName(obj client.Object) string {
-	aivenObj, ok := obj.(v1alpha1.AivenManagedObject)
-	if !ok {
-		return ""
-	}
-
-	auth := aivenObj.AuthSecretRef()
-	if auth == nil {
-		return ""
+	switch o := obj.(type) {
+	case *v1alpha1.AivenCredentials:
+		return o.Spec.SecretRef.Namespace + "/" + o.Spec.SecretRef.Name
+	case *v1alpha1.AivenNamespaceCredentials:
+		return o.Spec.SecretRef.Name
+	case v1alpha1.AivenManagedObject:
+		if creds := o.CredentialsRef(); creds != nil {
+			return credentialsRefIndexValue(creds)
+		}
+		if auth := o.AuthSecretRef(); auth != nil {
+			return auth.Name
+		}
 	}
-
-	return auth.Name
+	return ""
 }
 
 func (c *SecretFinalizerGCController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -151,11 +188,19 @@ func (c *SecretFinalizerGCController) Reconcile(ctx context.Context, req ctrl.Re
 	return ctrl.Result{}, nil
 }
 
+// knownListTypes returns the list types of the aiven managed objects
 func (c *SecretFinalizerGCController) knownListTypes() []client.ObjectList {
 	res := make([]client.ObjectList, 0)
 
-	for _, t := range c.Scheme().KnownTypes(v1alpha1.GroupVersion) {
-		if list, ok := reflect.New(t).Interface().(client.ObjectList); ok {
+	for kind, t := range c.Scheme().KnownTypes(v1alpha1.GroupVersion) {
+		if _, ok := reflect.New(t).Interface().(v1alpha1.AivenManagedObject); !ok {
+			continue
+		}
+		obj, err := c.Scheme().New(v1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err != nil {
+			continue
+		}
+		if list, ok := obj.(client.ObjectList); ok {
 			res = append(res, list)
 		}
 	}
@@ -175,19 +220,103 @@ func (c *SecretFinalizerGCController) knownInstanceTypes() []v1alpha1.AivenManag
 
 func (c *SecretFinalizerGCController) secretIsStillNeeded(ctx context.Context, secret *corev1.Secret) (bool, error) {
 	for _, listType := range c.knownListTypes() {
-		if needed, err := c.secretIsStillNeededBy(ctx, secret, listType); err != nil {
+		if needed, err := c.secretIsStillNeededBy(ctx, listType, instancesThatUseThisSecret(secret)); err != nil {
 			return false, fmt.Errorf("unable to decide if secret is still used by some aiven resource: %w", err)
 		} else if needed {
 			return true, nil
 		}
 	}
+
+	// the secret can also be used by the objects that reference credentials with the secret
+	credentials, err := c.credentialsThatUseThisSecret(ctx, secret)
+	if err != nil {
+		return false, fmt.Errorf("unable to list credentials that use the secret: %w", err)
+	}
+	for _, opts := range credentials {
+		for _, listType := range c.knownListTypes() {
+			if needed, err := c.secretIsStillNeededBy(ctx, listType, opts); err != nil {
+				return false, fmt.Errorf("unable to decide if secret is still used by some aiven resource: %w", err)
+			} else if needed {
+				return true, nil
+			}
+		}
+	}
 	return false, nil
 }
 
+// secretDependants returns the objects that use the secret, directly or through credentials
+func (c *SecretFinalizerGCController) secretDependants(ctx context.Context, secret *corev1.Secret) ([]v1alpha1.AivenManagedObject, error) {
+	credentials, err := c.credentialsThatUseThisSecret(ctx, secret)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list credentials that use the secret: %w", err)
+	}
+
+	res := make([]v1alpha1.AivenManagedObject, 0)
+	for _, opts := range append([]*client.ListOptions{instancesThatUseThisSecret(secret)}, credentials...) {
+		// all objects are needed, not only the first one
+		opts.Limit = 0
+		for _, list := range c.knownListTypes() {
+			if err := c.List(ctx, list, opts); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("unable to list aiven resources that use the secret: %w", err)
+			}
+
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				if o, ok := item.(v1alpha1.AivenManagedObject); ok {
+					res = append(res, o)
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// credentialsThatUseThisSecret returns the list options to find the instances that use credentials with this secret
+func (c *SecretFinalizerGCController) credentialsThatUseThisSecret(ctx context.Context, secret *corev1.Secret) ([]*client.ListOptions, error) {
+	selector := client.MatchingFields{credentialsSecretIndexKey: secret.GetNamespace() + "/" + secret.GetName()}
+	res := make([]*client.ListOptions, 0)
+
+	clusterCredentials := &v1alpha1.AivenCredentialsList{}
+	if err := c.List(ctx, clusterCredentials, selector); err != nil {
+		return nil, err
+	}
+	for _, creds := range clusterCredentials.Items {
+		// objects in any namespace may use the cluster credentials
+		res = append(res, instancesThatUseCredentials("", &v1alpha1.CredentialsReference{
+			Kind: credentialsKindCluster,
+			Name: creds.Name,
+		}))
+	}
+
+	namespaceCredentials := &v1alpha1.AivenNamespaceCredentialsList{}
+	if err := c.List(ctx, namespaceCredentials, selector, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil, err
+	}
+	for _, creds := range namespaceCredentials.Items {
+		res = append(res, instancesThatUseCredentials(creds.Namespace, &v1alpha1.CredentialsReference{
+			Kind: credentialsKindNamespace,
+			Name: creds.Name,
+		}))
+	}
+	return res, nil
+}
+
 const (
 	// secretRefIndexKey is the key we index the name of the secret with
 	// so we can efficiently list all resources that use this secret
 	secretRefIndexKey = "spec.auth_secret_ref.name"
+
+	// credentialsRefIndexKey is the key we index the credentials references with
+	credentialsRefIndexKey = "spec.credentials_ref"
+
+	// credentialsSecretIndexKey is the key we index the secrets of the credentials with
+	credentialsSecretIndexKey = "spec.secret_ref"
 )
 
 // secretRefIndexFunc indexes the client token secret names of aiven managed objects
@@ -209,6 +338,51 @@ func indexClientSecretRefFields(ctx context.Context, mgr ctrl.Manager, objs ...v
 	return nil
 }
 
+// credentialsRefIndexValue identifies the credentials,
+// AivenNamespaceCredentials are in the namespace of the object that references them
+func credentialsRefIndexValue(ref *v1alpha1.CredentialsReference) string {
+	kind := ref.Kind
+	if kind == "" {
+		kind = credentialsKindCluster
+	}
+	return kind + "/" + ref.Name
+}
+
+// credentialsRefIndexFunc indexes the credentials references of aiven managed objects
+func credentialsRefIndexFunc(o client.Object) []string {
+	if aivenObj, ok := o.(v1alpha1.AivenManagedObject); ok {
+		if creds := aivenObj.CredentialsRef(); creds != nil {
+			return []string{credentialsRefIndexValue(creds)}
+		}
+	}
+	return nil
+}
+
+// credentialsSecretIndexFunc indexes the token secrets of credentials as "namespace/name"
+func credentialsSecretIndexFunc(o client.Object) []string {
+	switch creds := o.(type) {
+	case *v1alpha1.AivenCredentials:
+		return []string{creds.Spec.SecretRef.Namespace + "/" + creds.Spec.SecretRef.Name}
+	case *v1alpha1.AivenNamespaceCredentials:
+		return []string{creds.Namespace + "/" + creds.Spec.SecretRef.Name}
+	}
+	return nil
+}
+
+func indexCredentialsFields(ctx context.Context, mgr ctrl.Manager, objs ...v1alpha1.AivenManagedObject) error {
+	for i := range objs {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, objs[i], credentialsRefIndexKey, credentialsRefIndexFunc); err != nil {
+			return err
+		}
+	}
+	for _, obj := range []client.Object{&v1alpha1.AivenCredentials{}, &v1alpha1.AivenNamespaceCredentials{}} {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, obj, credentialsSecretIndexKey, credentialsSecretIndexFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // check if an instance uses this secret
 func instancesThatUseThisSecret(secret *corev1.Secret) *client.ListOptions {
 	return &client.ListOptions{
@@ -218,8 +392,17 @@ func instancesThatUseThisSecret(secret *corev1.Secret) *client.ListOptions {
 	}
 }
 
-func (c *SecretFinalizerGCController) secretIsStillNeededBy(ctx context.Context, secret *corev1.Secret, list client.ObjectList) (bool, error) {
-	if err := c.List(ctx, list, instancesThatUseThisSecret(secret)); err != nil {
+// check if an instance uses these credentials, in all namespaces when the namespace is empty
+func instancesThatUseCredentials(namespace string, ref *v1alpha1.CredentialsReference) *client.ListOptions {
+	return &client.ListOptions{
+		Namespace:     namespace,
+		FieldSelector: fields.OneTermEqualSelector(credentialsRefIndexKey, credentialsRefIndexValue(ref)),
+		Limit:         1,
+	}
+}
+
+func (c *SecretFinalizerGCController) secretIsStillNeededBy(ctx context.Context, list client.ObjectList, opts *client.ListOptions) (bool, error) {
+	if err := c.List(ctx, list, opts); err != nil {
 		return false, client.IgnoreNotFound(err)
 	}
 	return meta.LenList(list) > 0, nil
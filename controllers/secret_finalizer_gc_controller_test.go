@@ -6,6 +6,10 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
@@ -48,6 +52,84 @@ func TestSecretFinalizerGCEventPredicate(t *testing.T) {
 	require.True(t, pred.Update(event.UpdateEvent{ObjectOld: crWithOldAuth, ObjectNew: crWithNewAuth}))
 	require.True(t, pred.Update(event.UpdateEvent{ObjectOld: crWithoutAuth, ObjectNew: crWithOldAuth}))
 	require.True(t, pred.Update(event.UpdateEvent{ObjectOld: crWithOldAuth, ObjectNew: crWithoutAuth}))
+	crWithCredentials := crWithOldAuth.DeepCopy()
+	crWithCredentials.Spec.CredentialsRef = &v1alpha1.CredentialsReference{Name: "shared-token"}
+	credentials := newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials)
+	credentialsWithNewSecret := credentials.DeepCopy()
+	credentialsWithNewSecret.Spec.SecretRef.Name = "new-token"
+	require.True(t, pred.Update(event.UpdateEvent{ObjectOld: crWithOldAuth, ObjectNew: crWithCredentials}))
+	require.False(t, pred.Update(event.UpdateEvent{ObjectOld: credentials, ObjectNew: credentials.DeepCopy()}))
+	require.True(t, pred.Update(event.UpdateEvent{ObjectOld: credentials, ObjectNew: credentialsWithNewSecret}))
 	require.True(t, pred.Delete(event.DeleteEvent{Object: deletingProtectedSecret}))
 	require.False(t, pred.Generic(event.GenericEvent{Object: deletingProtectedSecret}))
 }
+
+func TestSecretFinalizerGCController_secretIsStillNeeded(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	instanceTypes := (&SecretFinalizerGCController{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}).knownInstanceTypes()
+	newController := func(objs ...crclient.Object) *SecretFinalizerGCController {
+		builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
+		for _, obj := range instanceTypes {
+			builder.WithIndex(obj, secretRefIndexKey, secretRefIndexFunc)
+			builder.WithIndex(obj, credentialsRefIndexKey, credentialsRefIndexFunc)
+		}
+		builder.WithIndex(&v1alpha1.AivenCredentials{}, credentialsSecretIndexKey, credentialsSecretIndexFunc)
+		builder.WithIndex(&v1alpha1.AivenNamespaceCredentials{}, credentialsSecretIndexKey, credentialsSecretIndexFunc)
+		return &SecretFinalizerGCController{Client: builder.Build()}
+	}
+
+	sharedSecret := newObjectFromYAML[corev1.Secret](t, yamlSharedAuthSecret)
+	secret := newObjectFromYAML[corev1.Secret](t, yamlAuthSecret)
+
+	t.Run("Needed by authSecretRef", func(t *testing.T) {
+		c := newController(newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUserWithAuth))
+
+		needed, err := c.secretIsStillNeeded(t.Context(), secret)
+		require.NoError(t, err)
+		require.True(t, needed)
+
+		needed, err = c.secretIsStillNeeded(t.Context(), sharedSecret)
+		require.NoError(t, err)
+		require.False(t, needed)
+	})
+
+	t.Run("Needed by AivenCredentials from another namespace", func(t *testing.T) {
+		user := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		user.Spec.CredentialsRef = &v1alpha1.CredentialsReference{Name: "shared-token"}
+		c := newController(user, newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials))
+
+		needed, err := c.secretIsStillNeeded(t.Context(), sharedSecret)
+		require.NoError(t, err)
+		require.True(t, needed)
+	})
+
+	t.Run("Needed by AivenNamespaceCredentials", func(t *testing.T) {
+		user := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		user.Spec.CredentialsRef = &v1alpha1.CredentialsReference{Kind: credentialsKindNamespace, Name: "team-token"}
+		c := newController(user, newObjectFromYAML[v1alpha1.AivenNamespaceCredentials](t, yamlAivenNamespaceCredentials))
+
+		needed, err := c.secretIsStillNeeded(t.Context(), secret)
+		require.NoError(t, err)
+		require.True(t, needed)
+	})
+
+	t.Run("Not needed by unused credentials", func(t *testing.T) {
+		c := newController(
+			newObjectFromYAML[v1alpha1.AivenCredentials](t, yamlAivenCredentials),
+			newObjectFromYAML[v1alpha1.AivenNamespaceCredentials](t, yamlAivenNamespaceCredentials),
+		)
+
+		needed, err := c.secretIsStillNeeded(t.Context(), sharedSecret)
+		require.NoError(t, err)
+		require.False(t, needed)
+
+		needed, err = c.secretIsStillNeeded(t.Context(), secret)
+		require.NoError(t, err)
+		require.False(t, needed)
+	})
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// SecretSink writes the connection details of a resource.
+// The details are written on every reconcile, so writes must be idempotent.
+type SecretSink interface {
+	Write(ctx context.Context, goal *corev1.Secret) error
+}
+
+// secretSinkHTTPClient is used by the Vault and HTTP sinks.
+var secretSinkHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// newSecretSink returns the sink selected in connInfoSecretTarget.sink of the owner.
+// When merge is true, the Kubernetes and Vault sinks keep the keys of the existing secret that are not in the goal secret.
+// The services that keep the admin secret write to the Kubernetes secret too.
+func newSecretSink(ctx context.Context, k8s client.Client, scheme *runtime.Scheme, owner client.Object, merge bool) (SecretSink, error) {
+	var target v1alpha1.ConnInfoSecretTarget
+	if withSecret, ok := owner.(objWithSecret); ok {
+		target = withSecret.GetConnInfoSecretTarget()
+	}
+
+	k8sSink := &kubernetesSecretSink{
+		k8s:      k8s,
+		scheme:   scheme,
+		owner:    owner,
+		merge:    merge,
+		template: target.Template,
+	}
+
+	external, err := newExternalSecretSink(ctx, k8s, owner.GetNamespace(), target, merge)
+	switch {
+	case err != nil:
+		return nil, err
+	case external == nil:
+		return k8sSink, nil
+	case keepsAdminSecret(owner):
+		return multiSecretSink{k8sSink, external}, nil
+	}
+	return external, nil
+}
+
+// newExternalSecretSink returns the Vault or HTTP sink, or nil for the Kubernetes sink.
+func newExternalSecretSink(ctx context.Context, k8s client.Client, namespace string, target v1alpha1.ConnInfoSecretTarget, merge bool) (SecretSink, error) {
+	switch target.GetSinkType() {
+	case v1alpha1.ConnInfoSecretSinkVault:
+		if target.Sink.Vault == nil {
+			return nil, fmt.Errorf("connInfoSecretTarget.sink.vault is required for the Vault sink")
+		}
+		token, err := readSecretSinkToken(ctx, k8s, namespace, &target.Sink.Vault.TokenSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return &vaultSecretSink{
+			client:   secretSinkHTTPClient,
+			config:   target.Sink.Vault,
+			token:    token,
+			merge:    merge,
+			template: target.Template,
+		}, nil
+	case v1alpha1.ConnInfoSecretSinkHTTP:
+		if target.Sink.HTTP == nil {
+			return nil, fmt.Errorf("connInfoSecretTarget.sink.http is required for the HTTP sink")
+		}
+		var token string
+		if ref := target.Sink.HTTP.AuthSecretRef; ref != nil {
+			t, err := readSecretSinkToken(ctx, k8s, namespace, ref)
+			if err != nil {
+				return nil, err
+			}
+			token = t
+		}
+		return &httpSecretSink{
+			client:   secretSinkHTTPClient,
+			url:      target.Sink.HTTP.URL,
+			token:    token,
+			template: target.Template,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// keepsAdminSecret returns true for the services whose Kubernetes secret is used by other resources
+// to connect to the service as the admin user, e.g. MySQLGrant reads the URI of the MySQL secret.
+// The secret is written with any sink, so these resources keep working with Vault or HTTP sinks.
+func keepsAdminSecret(o any) bool {
+	switch o.(type) {
+	case *v1alpha1.PostgreSQL, *v1alpha1.MySQL, *v1alpha1.OpenSearch:
+		return true
+	}
+	return false
+}
+
+// usesKubernetesSecretSink returns true when the connection details are written to a Kubernetes secret.
+func usesKubernetesSecretSink(o objWithSecret) bool {
+	target := o.GetConnInfoSecretTarget()
+	return target.GetSinkType() == v1alpha1.ConnInfoSecretSinkKubernetes || keepsAdminSecret(o)
+}
+
+// multiSecretSink writes the connection details to every sink.
+type multiSecretSink []SecretSink
+
+func (s multiSecretSink) Write(ctx context.Context, goal *corev1.Secret) error {
+	for _, sink := range s {
+		if err := sink.Write(ctx, goal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSecretSinkToken(ctx context.Context, k8s client.Reader, namespace string, ref *v1alpha1.AuthSecretReference) (string, error) {
+	secret := &corev1.Secret{}
+	if err := k8s.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("cannot get secret sink token %q: %w", ref.Name, err)
+	}
+	token := strings.TrimSpace(string(secret.Data[ref.Key]))
+	if token == "" {
+		return "", fmt.Errorf("secret sink token %q has no key %q", ref.Name, ref.Key)
+	}
+	return token, nil
+}
+
+// secretSinkData returns the base data updated with the goal secret data and the keys rendered from the template.
+func secretSinkData(base map[string]string, goal *corev1.Secret, tmpl *v1alpha1.ConnInfoSecretTemplate) (map[string]string, error) {
+	data := make(map[string][]byte, len(base)+len(goal.Data)+len(goal.StringData))
+	for k, v := range base {
+		data[k] = []byte(v)
+	}
+	maps.Copy(data, goal.Data)
+	for k, v := range goal.StringData {
+		data[k] = []byte(v)
+	}
+
+	if err := applySecretTemplate(tmpl, data); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(data))
+	for k, v := range data {
+		res[k] = string(v)
+	}
+	return res, nil
+}
+
+// kubernetesSecretSink writes the connection details to a secret owned by the resource.
+type kubernetesSecretSink struct {
+	k8s      client.Client
+	scheme   *runtime.Scheme
+	owner    client.Object
+	merge    bool
+	template *v1alpha1.ConnInfoSecretTemplate
+}
+
+func (s *kubernetesSecretSink) Write(ctx context.Context, goal *corev1.Secret) error {
+	// `CreateOrUpdate` will populate this secret by calling Get.
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      goal.Name,
+			Namespace: goal.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, s.k8s, secret, func() error {
+		if secret.Data == nil || (!s.merge && goal.StringData != nil) {
+			secret.Data = make(map[string][]byte)
+		}
+
+		// This handles both Create and Update.
+		maps.Copy(secret.Data, goal.Data)
+		for key, value := range goal.StringData {
+			secret.Data[key] = []byte(value)
+		}
+
+		if err := applySecretTemplate(s.template, secret.Data); err != nil {
+			return err
+		}
+
+		secret.Labels = goal.Labels
+		secret.Annotations = goal.Annotations
+
+		return controllerutil.SetControllerReference(s.owner, secret, s.scheme)
+	})
+	return err
+}
+
+// vaultSecretSink writes the connection details to a Vault KV v2 secret.
+// A new secret version is written only when the details change.
+type vaultSecretSink struct {
+	client   *http.Client
+	config   *v1alpha1.VaultSecretSink
+	token    string
+	merge    bool
+	template *v1alpha1.ConnInfoSecretTemplate
+}
+
+func (s *vaultSecretSink) Write(ctx context.Context, goal *corev1.Secret) error {
+	var current struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	found, err := s.do(ctx, http.MethodGet, nil, &current)
+	if err != nil {
+		return err
+	}
+
+	// Like the Kubernetes secret, keeps the keys that are not in the goal secret.
+	base := make(map[string]string)
+	if s.merge {
+		for k, v := range current.Data.Data {
+			if str, ok := v.(string); ok {
+				base[k] = str
+			}
+		}
+	}
+
+	data, err := secretSinkData(base, goal, s.template)
+	if err != nil {
+		return err
+	}
+	if found && vaultDataEqual(current.Data.Data, data) {
+		return nil
+	}
+
+	_, err = s.do(ctx, http.MethodPost, map[string]any{"data": data}, nil)
+	return err
+}
+
+// vaultDataEqual compares the stored secret with the details.
+// The stored values can be of any JSON type if the secret was written by something else.
+func vaultDataEqual(current map[string]any, data map[string]string) bool {
+	if len(current) != len(data) {
+		return false
+	}
+	for k, v := range data {
+		if s, ok := current[k].(string); !ok || s != v {
+			return false
+		}
+	}
+	return true
+}
+
+// do sends a request to the secret data endpoint. Returns false if the secret doesn't exist.
+func (s *vaultSecretSink) do(ctx context.Context, method string, in, out any) (bool, error) {
+	mount := s.config.Mount
+	if mount == "" {
+		mount = "secret"
+	}
+	u, err := url.JoinPath(s.config.Address, "v1", mount, "data", strings.Trim(s.config.Path, "/"))
+	if err != nil {
+		return false, fmt.Errorf("invalid vault secret path: %w", err)
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	if s.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.config.Namespace)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("vault request failed: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case rsp.StatusCode == http.StatusNotFound && method == http.MethodGet:
+		return false, nil
+	case rsp.StatusCode >= http.StatusMultipleChoices:
+		return false, fmt.Errorf("vault request %s %s failed with status %d: %s", method, u, rsp.StatusCode, bytes.TrimSpace(b))
+	case out != nil:
+		if err := json.Unmarshal(b, out); err != nil {
+			return false, fmt.Errorf("cannot parse vault response: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// httpSecretSink sends the connection details to an HTTP endpoint with PUT.
+type httpSecretSink struct {
+	client   *http.Client
+	url      string
+	token    string
+	template *v1alpha1.ConnInfoSecretTemplate
+}
+
+func (s *httpSecretSink) Write(ctx context.Context, goal *corev1.Secret) error {
+	data, err := secretSinkData(nil, goal, s.template)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(map[string]any{
+		"name":      goal.Name,
+		"namespace": goal.Namespace,
+		"data":      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http sink request failed: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("http sink request failed with status %d: %s", rsp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeVault is an in-process Vault KV v2 secrets engine mounted at "secret".
+type fakeVault struct {
+	mu       sync.Mutex
+	token    string
+	secrets  map[string]map[string]any
+	versions map[string]int
+}
+
+func newFakeVault(t *testing.T, token string) (*fakeVault, *httptest.Server) {
+	v := &fakeVault{token: token, secrets: map[string]map[string]any{}, versions: map[string]int{}}
+	srv := httptest.NewServer(v)
+	t.Cleanup(srv.Close)
+	return v, srv
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	path, ok := strings.CutPrefix(r.URL.Path, "/v1/secret/data/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":["no handler for route"]}`))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := v.secrets[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"data": data, "metadata": map[string]any{"version": v.versions[path]}},
+		})
+	case http.MethodPost, http.MethodPut:
+		var in struct {
+			Data map[string]any `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.secrets[path] = in.Data
+		v.versions[path]++
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"version": v.versions[path]}})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestSecretSink(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newSinkToken := func(name, token string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte(token)},
+		}
+	}
+
+	newReconciler := func(t *testing.T, recorder *record.FakeRecorder) *Reconciler[*v1alpha1.ClickhouseUser] {
+		return &Reconciler[*v1alpha1.ClickhouseUser]{
+			Controller: Controller{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(newSinkToken("vault-token", "s.root")).Build(),
+				Scheme:   scheme,
+				Recorder: recorder,
+			},
+			newSecret: newSecret,
+		}
+	}
+
+	t.Run("Kubernetes secret is the default", func(t *testing.T) {
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		sink, err := newSecretSink(t.Context(), k8sClient, scheme, obj, true)
+		require.NoError(t, err)
+		require.IsType(t, &kubernetesSecretSink{}, sink)
+		require.NoError(t, sink.Write(t.Context(), newSecret(obj, map[string]string{"FOO": "foo"}, false)))
+
+		secret := &corev1.Secret{}
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, secret))
+		assert.Equal(t, "foo", string(secret.Data["FOO"]))
+	})
+
+	t.Run("Vault sink writes a new version only when the details change", func(t *testing.T) {
+		vault, srv := newFakeVault(t, "s.root")
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		obj.Spec.ConnInfoSecretTarget = v1alpha1.ConnInfoSecretTarget{
+			Name: "test-user",
+			Template: &v1alpha1.ConnInfoSecretTemplate{
+				Data: map[string]string{"URL": "clickhouse://{{ .HOST }}"},
+			},
+			Sink: &v1alpha1.ConnInfoSecretSink{
+				Type: v1alpha1.ConnInfoSecretSinkVault,
+				Vault: &v1alpha1.VaultSecretSink{
+					Address:        srv.URL,
+					Path:           "/apps/test-user/",
+					TokenSecretRef: v1alpha1.AuthSecretReference{Name: "vault-token", Key: "token"},
+				},
+			},
+		}
+		r := newReconciler(t, record.NewFakeRecorder(10))
+
+		for range 3 {
+			require.NoError(t, r.publishSecretDetails(t.Context(), obj, map[string]string{"HOST": "host-1"}))
+		}
+		assert.Equal(t, 1, vault.versions["apps/test-user"])
+		assert.Equal(t, map[string]any{"HOST": "host-1", "URL": "clickhouse://host-1"}, vault.secrets["apps/test-user"])
+
+		require.NoError(t, r.publishSecretDetails(t.Context(), obj, map[string]string{"HOST": "host-2"}))
+		assert.Equal(t, 2, vault.versions["apps/test-user"])
+		assert.Equal(t, "clickhouse://host-2", vault.secrets["apps/test-user"]["URL"])
+
+		// The Kubernetes secret is not created.
+		err := r.Client.Get(t.Context(), types.NamespacedName{Name: "test-user", Namespace: "default"}, &corev1.Secret{})
+		require.Error(t, err)
+	})
+
+	t.Run("Vault errors are reported in the conditions", func(t *testing.T) {
+		_, srv := newFakeVault(t, "another-token")
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		obj.Spec.ConnInfoSecretTarget = v1alpha1.ConnInfoSecretTarget{
+			Name: "test-user",
+			Sink: &v1alpha1.ConnInfoSecretSink{
+				Type: v1alpha1.ConnInfoSecretSinkVault,
+				Vault: &v1alpha1.VaultSecretSink{
+					Address:        srv.URL,
+					Path:           "apps/test-user",
+					TokenSecretRef: v1alpha1.AuthSecretReference{Name: "vault-token", Key: "token"},
+				},
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+		r := newReconciler(t, recorder)
+
+		err := r.publishSecretDetails(t.Context(), obj, map[string]string{"HOST": "host-1"})
+		require.ErrorContains(t, err, "failed with status 403")
+		assert.True(t, hasConnectionSecretPublishError(obj))
+		events := recorderEvents(recorder)
+		require.Len(t, events, 1)
+		assert.Contains(t, events[0], "Warning "+eventCannotPublishConnectionDetails)
+	})
+
+	t.Run("HTTP sink sends the details with PUT", func(t *testing.T) {
+		var got struct {
+			Name      string            `json:"name"`
+			Namespace string            `json:"namespace"`
+			Data      map[string]string `json:"data"`
+		}
+		var auth string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			auth = r.Header.Get("Authorization")
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		}))
+		defer srv.Close()
+
+		obj := newObjectFromYAML[v1alpha1.ClickhouseUser](t, yamlClickhouseUser)
+		obj.Spec.ConnInfoSecretTarget = v1alpha1.ConnInfoSecretTarget{
+			Name: "test-user",
+			Sink: &v1alpha1.ConnInfoSecretSink{
+				Type: v1alpha1.ConnInfoSecretSinkHTTP,
+				HTTP: &v1alpha1.HTTPSecretSink{
+					URL:           srv.URL,
+					AuthSecretRef: &v1alpha1.AuthSecretReference{Name: "vault-token", Key: "token"},
+				},
+			},
+		}
+		r := newReconciler(t, record.NewFakeRecorder(10))
+
+		require.NoError(t, r.publishSecretDetails(t.Context(), obj, map[string]string{"HOST": "host-1"}))
+		assert.Equal(t, "Bearer s.root", auth)
+		assert.Equal(t, "test-user", got.Name)
+		assert.Equal(t, "default", got.Namespace)
+		assert.Equal(t, map[string]string{"HOST": "host-1"}, got.Data)
+	})
+
+	t.Run("Services used by other resources keep the Kubernetes secret with any sink", func(t *testing.T) {
+		vault, srv := newFakeVault(t, "s.root")
+		pg := newObjectFromYAML[v1alpha1.PostgreSQL](t, yamlPostgres)
+		pg.Spec.ConnInfoSecretTarget = v1alpha1.ConnInfoSecretTarget{
+			Name: "pg-secret",
+			Sink: &v1alpha1.ConnInfoSecretSink{
+				Type: v1alpha1.ConnInfoSecretSinkVault,
+				Vault: &v1alpha1.VaultSecretSink{
+					Address:        srv.URL,
+					Path:           "apps/pg",
+					TokenSecretRef: v1alpha1.AuthSecretReference{Name: "vault-token", Key: "token"},
+				},
+			},
+		}
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newSinkToken("vault-token", "s.root"), pg).Build()
+		assert.True(t, usesKubernetesSecretSink(pg))
+
+		sink, err := newSecretSink(t.Context(), k8sClient, scheme, pg, false)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(t.Context(), newSecret(pg, map[string]string{"DATABASE_URI": "postgres://avnadmin@host/defaultdb"}, true)))
+		assert.Equal(t, "postgres://avnadmin@host/defaultdb", vault.secrets["apps/pg"]["POSTGRESQL_DATABASE_URI"])
+
+		uri, err := getServiceConnectionURI(t.Context(), k8sClient, &v1alpha1.PostgreSQL{}, "PostgreSQL", pg.Namespace, pg.Name, "DATABASE_URI")
+		require.NoError(t, err)
+		assert.Equal(t, "host", uri.Host)
+	})
+}
@@ -37,6 +37,7 @@ const (
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aiven.io,resources=serviceusers,verbs=patch
 // +kubebuilder:rbac:groups=aiven.io,resources=clickhouseusers,verbs=patch
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsecurityconfigs,verbs=patch
 
 func (c *SecretWatchController) SetupWithManager(mgr ctrl.Manager) error {
 	resourcesWithSecretSource := c.getResourcesWithSecretSource()
@@ -106,6 +107,7 @@ func (c *SecretWatchController) getResourcesWithSecretSource() []SecretSourceRes
 	return []SecretSourceResource{
 		&v1alpha1.ServiceUser{},
 		&v1alpha1.ClickhouseUser{},
+		&v1alpha1.OpenSearchSecurityConfig{},
 	}
 }
 
@@ -171,6 +173,18 @@ func (c *SecretWatchController) findResourcesUsingSecret(ctx context.Context, se
 		allResources = append(allResources, &clickhouseUserList.Items[i])
 	}
 
+	securityConfigList := &v1alpha1.OpenSearchSecurityConfigList{}
+	err = c.List(ctx, securityConfigList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(connInfoSecretRefIndexKey, secretKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OpenSearchSecurityConfigs: %w", err)
+	}
+
+	for i := range securityConfigList.Items {
+		allResources = append(allResources, &securityConfigList.Items[i])
+	}
+
 	return allResources, nil
 }
 
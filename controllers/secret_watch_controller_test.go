@@ -101,6 +101,7 @@ func TestSecretWatchController_getResourcesWithSecretSource(t *testing.T) {
 
 	assert.True(t, foundTypes[reflect.TypeFor[*v1alpha1.ServiceUser]()], "should find ServiceUser")
 	assert.True(t, foundTypes[reflect.TypeFor[*v1alpha1.ClickhouseUser]()], "should find ClickhouseUser")
+	assert.True(t, foundTypes[reflect.TypeFor[*v1alpha1.OpenSearchSecurityConfig]()], "should find OpenSearchSecurityConfig")
 }
 
 func TestConnInfoSecretRefIndexFunc(t *testing.T) {
@@ -75,9 +75,20 @@ func (r *ServiceUserController) Observe(ctx context.Context, user *v1alpha1.Serv
 		return Observation{ResourceExists: true, ResourceUpToDate: false, SecretDetails: details}, nil
 	}
 
+	now := time.Now()
+	setPreviousPasswordDetails(details, user, "", now)
+
+	// The password is rotated in Update.
+	rotate := user.Spec.ConnInfoSecretSource == nil && isPasswordRotationDue(user, now)
+
+	upToDate := IsReadyToUse(user) &&
+		accessControlMatches(user.Spec.AccessControl, u.AccessControl) &&
+		(user.Spec.Authentication == "" || user.Spec.Authentication == u.Authentication) &&
+		!rotate
+
 	return Observation{
 		ResourceExists:   true,
-		ResourceUpToDate: IsReadyToUse(user) && accessControlMatches(user.Spec.AccessControl, u.AccessControl),
+		ResourceUpToDate: upToDate,
 		SecretDetails:    details,
 	}, nil
 }
@@ -93,8 +104,9 @@ func (r *ServiceUserController) Create(ctx context.Context, user *v1alpha1.Servi
 		user.Spec.Project,
 		user.Spec.ServiceName,
 		&service.ServiceUserCreateIn{
-			Username:      user.GetUsername(),
-			AccessControl: buildServiceUserAccessControlIn(user.Spec.AccessControl),
+			Username:       user.GetUsername(),
+			AccessControl:  buildServiceUserAccessControlIn(user.Spec.AccessControl),
+			Authentication: user.Spec.Authentication,
 		},
 	)
 	if err != nil {
@@ -144,6 +156,29 @@ func (r *ServiceUserController) Update(ctx context.Context, user *v1alpha1.Servi
 		logr.FromContextOrDiscard(ctx).V(1).Info("skipping access control update since it isn't provided in the spec")
 	}
 
+	// Without a source secret, the operator generates the password when the rotation is due.
+	now := time.Now()
+	rotate := password == "" && isPasswordRotationDue(user, now)
+	var previous string
+	if rotate {
+		previous, err = readConnectionSecretPassword(ctx, r.Client, user)
+		if err != nil {
+			return UpdateResult{}, err
+		}
+		password = generatePassword()
+	}
+
+	// Aiven changes the authentication plugin when it resets the credentials, so the current password is sent again.
+	if password == "" && user.Spec.Authentication != "" {
+		u, err := r.avnGen.ServiceUserGet(ctx, user.Spec.Project, user.Spec.ServiceName, user.GetUsername())
+		if err != nil {
+			return UpdateResult{}, fmt.Errorf("getting service user: %w", err)
+		}
+		if u.Authentication != user.Spec.Authentication {
+			password = u.Password
+		}
+	}
+
 	wrotePassword, err := r.setAivenPasswordIfProvided(ctx, user, password)
 	if err != nil {
 		return UpdateResult{}, err
@@ -157,8 +192,13 @@ func (r *ServiceUserController) Update(ctx context.Context, user *v1alpha1.Servi
 	if err != nil {
 		return UpdateResult{}, fmt.Errorf("building connection details: %w", err)
 	}
+	setPreviousPasswordDetails(details, user, previous, now)
 
-	return UpdateResult{SecretDetails: details}, nil
+	res := UpdateResult{SecretDetails: details}
+	if rotate {
+		res.SecretPublished = func() { markPasswordRotated(r.rec, user, now) }
+	}
+	return res, nil
 }
 
 func (r *ServiceUserController) Delete(ctx context.Context, user *v1alpha1.ServiceUser) error {
@@ -219,7 +259,7 @@ func (r *ServiceUserController) findDuplicate(ctx context.Context, user *v1alpha
 	return nil, nil
 }
 
-// setAivenPasswordIfProvided pushes the password to Aiven if non-empty.
+// setAivenPasswordIfProvided pushes the password and the authentication plugin to Aiven if the password is non-empty.
 // Returns true when the password was actually updated.
 func (r *ServiceUserController) setAivenPasswordIfProvided(ctx context.Context, user *v1alpha1.ServiceUser, password string) (bool, error) {
 	if password == "" {
@@ -232,8 +272,9 @@ func (r *ServiceUserController) setAivenPasswordIfProvided(ctx context.Context,
 		user.Spec.ServiceName,
 		user.GetUsername(),
 		&service.ServiceUserCredentialsModifyIn{
-			NewPassword: &password,
-			Operation:   service.ServiceUserCredentialsModifyOperationTypeResetCredentials,
+			NewPassword:    &password,
+			Operation:      service.ServiceUserCredentialsModifyOperationTypeResetCredentials,
+			Authentication: user.Spec.Authentication,
 		},
 	); err != nil {
 		return false, fmt.Errorf("modifying service user credentials: %w", err)
@@ -329,7 +370,7 @@ func (r *ServiceUserController) fetchUser(
 	}
 
 	idx := slices.IndexFunc(svc.Components, func(c service.ComponentOut) bool {
-		return c.Component == svc.ServiceType
+		return c.Component == svc.ServiceType && c.Route != service.RouteTypePrivatelink
 	})
 	if idx < 0 {
 		return nil, nil, fmt.Errorf("service component %q not found", svc.ServiceType)
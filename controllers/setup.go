@@ -23,6 +23,19 @@ type SetupConfig struct {
 	KubeVersion     string
 	OperatorVersion string
 	PollInterval    time.Duration
+	DryRun          bool
+
+	// AdoptionPolicy is the default adoption policy for all kinds, Adopt when empty.
+	AdoptionPolicy string
+
+	// AdoptionPolicies overrides AdoptionPolicy per kind, e.g. "KafkaTopic": "Fail".
+	AdoptionPolicies map[string]string
+
+	// TokenProvider provides the operator token instead of DefaultToken, optional.
+	TokenProvider TokenProvider
+
+	// AivenAPI configures the rate limiter and the cache shared by all Aiven clients, defaults when nil.
+	AivenAPI *AivenAPIOptions
 }
 
 func SetupControllers(mgr ctrl.Manager, defaultToken, kubeVersion, operatorVersion string) error {
@@ -38,10 +51,15 @@ func SetupControllersWithConfig(mgr ctrl.Manager, cfg SetupConfig) error {
 		cfg.PollInterval = defaultPollInterval
 	}
 
+	registerMetrics()
+	if cfg.AivenAPI != nil {
+		configureAivenHTTPClient(*cfg.AivenAPI)
+	}
+
 	if err := (&SecretFinalizerGCController{
 		Client: mgr.GetClient(),
 		Log:    ctrl.Log.WithName("controllers").WithName("SecretFinalizerGCController"),
-	}).SetupWithManager(mgr, cfg.DefaultToken != ""); err != nil {
+	}).SetupWithManager(mgr, cfg.DefaultToken != "" || cfg.TokenProvider != nil); err != nil {
 		return fmt.Errorf("controller SecretFinalizerGCController: %w", err)
 	}
 
@@ -53,36 +71,65 @@ func SetupControllersWithConfig(mgr ctrl.Manager, cfg SetupConfig) error {
 	}
 
 	builders := map[string]reconcilerBuilder{
-		"Clickhouse":                 newClickhouseReconciler,
-		"ClickhouseDatabase":         newClickhouseDatabaseReconciler,
-		"ClickhouseRole":             newClickhouseRoleReconciler,
-		"ClickhouseUser":             newClickhouseUserReconciler,
-		"ClickhouseGrant":            newClickhouseGrantReconciler,
-		"ConnectionPool":             newConnectionPoolReconciler,
-		"Database":                   newDatabaseReconciler,
-		"Flink":                      newFlinkReconciler,
-		"Grafana":                    newGrafanaReconciler,
-		"Kafka":                      newKafkaReconciler,
-		"KafkaACL":                   newKafkaACLReconciler,
-		"KafkaNativeACL":             newKafkaNativeACLReconciler,
-		"KafkaConnect":               newKafkaConnectReconciler,
-		"KafkaConnector":             newKafkaConnectorReconciler,
-		"KafkaQuota":                 newKafkaQuotaReconciler,
-		"KafkaSchema":                newKafkaSchemaReconciler,
-		"KafkaSchemaRegistryACL":     newKafkaSchemaRegistryACLReconciler,
-		"KafkaTopic":                 newKafkaTopicReconciler,
-		"MySQL":                      newMySQLReconciler,
-		"OpenSearch":                 newOpenSearchReconciler,
-		"OpenSearchACLConfig":        newOpenSearchACLConfigReconciler,
-		"OrganizationProject":        newOrganizationProjectReconciler,
-		"PostgreSQL":                 newPostgreSQLReconciler,
-		"Project":                    newProjectReconciler,
-		"ProjectVPC":                 newProjectVPCReconciler,
-		"ServiceIntegration":         newServiceIntegrationReconciler,
-		"ServiceIntegrationEndpoint": newServiceIntegrationEndpointReconciler,
-		"ServiceUser":                newServiceUserReconciler,
-		"UpgradePipelineStep":        newUpgradePipelineStepReconciler,
-		"Valkey":                     newValkeyReconciler,
+		"AWSPrivateLink":                   newAWSPrivateLinkReconciler,
+		"AWSVPCPeeringConnection":          newAWSVPCPeeringConnectionReconciler,
+		"AzurePrivateLink":                 newAzurePrivateLinkReconciler,
+		"AzureVPCPeeringConnection":        newAzureVPCPeeringConnectionReconciler,
+		"BillingGroup":                     newBillingGroupReconciler,
+		"Clickhouse":                       newClickhouseReconciler,
+		"ClickhouseDatabase":               newClickhouseDatabaseReconciler,
+		"ClickhouseRole":                   newClickhouseRoleReconciler,
+		"ClickhouseUser":                   newClickhouseUserReconciler,
+		"ClickhouseGrant":                  newClickhouseGrantReconciler,
+		"ConnectionPool":                   newConnectionPoolReconciler,
+		"Database":                         newDatabaseReconciler,
+		"Flink":                            newFlinkReconciler,
+		"FlinkApplication":                 newFlinkApplicationReconciler,
+		"FlinkApplicationDeployment":       newFlinkApplicationDeploymentReconciler,
+		"FlinkJarApplication":              newFlinkJarApplicationReconciler,
+		"GCPPrivateLink":                   newGCPPrivateLinkReconciler,
+		"GCPVPCPeeringConnection":          newGCPVPCPeeringConnectionReconciler,
+		"Grafana":                          newGrafanaReconciler,
+		"Kafka":                            newKafkaReconciler,
+		"KafkaACL":                         newKafkaACLReconciler,
+		"KafkaNativeACL":                   newKafkaNativeACLReconciler,
+		"KafkaConnect":                     newKafkaConnectReconciler,
+		"KafkaConnector":                   newKafkaConnectorReconciler,
+		"KafkaMirrorMaker":                 newKafkaMirrorMakerReconciler,
+		"KafkaMirrorMakerReplicationFlow":  newKafkaMirrorMakerReplicationFlowReconciler,
+		"KafkaQuota":                       newKafkaQuotaReconciler,
+		"KafkaSchema":                      newKafkaSchemaReconciler,
+		"KafkaSchemaRegistryACL":           newKafkaSchemaRegistryACLReconciler,
+		"KafkaTopic":                       newKafkaTopicReconciler,
+		"MySQL":                            newMySQLReconciler,
+		"MySQLGrant":                       newMySQLGrantReconciler,
+		"OpenSearch":                       newOpenSearchReconciler,
+		"OpenSearchACLConfig":              newOpenSearchACLConfigReconciler,
+		"OpenSearchISMPolicy":              newOpenSearchISMPolicyReconciler,
+		"OpenSearchIndexTemplate":          newOpenSearchIndexTemplateReconciler,
+		"OpenSearchRole":                   newOpenSearchRoleReconciler,
+		"OpenSearchRoleMapping":            newOpenSearchRoleMappingReconciler,
+		"OpenSearchSecurityConfig":         newOpenSearchSecurityConfigReconciler,
+		"OpenSearchSnapshotRepository":     newOpenSearchSnapshotRepositoryReconciler,
+		"OrganizationApplicationUser":      newOrganizationApplicationUserReconciler,
+		"OrganizationApplicationUserToken": newOrganizationApplicationUserTokenReconciler,
+		"OrganizationGroupMember":          newOrganizationGroupMemberReconciler,
+		"OrganizationPermission":           newOrganizationPermissionReconciler,
+		"OrganizationProject":              newOrganizationProjectReconciler,
+		"OrganizationUserGroup":            newOrganizationUserGroupReconciler,
+		"PostgreSQL":                       newPostgreSQLReconciler,
+		"PostgreSQLExtension":              newPostgreSQLExtensionReconciler,
+		"PostgreSQLGrant":                  newPostgreSQLGrantReconciler,
+		"PostgreSQLSchema":                 newPostgreSQLSchemaReconciler,
+		"Project":                          newProjectReconciler,
+		"ProjectVPC":                       newProjectVPCReconciler,
+		"ServiceIntegration":               newServiceIntegrationReconciler,
+		"ServiceIntegrationEndpoint":       newServiceIntegrationEndpointReconciler,
+		"ServiceUser":                      newServiceUserReconciler,
+		"StaticIP":                         newStaticIPReconciler,
+		"TransitGatewayVPCAttachment":      newTransitGatewayVPCAttachmentReconciler,
+		"UpgradePipelineStep":              newUpgradePipelineStepReconciler,
+		"Valkey":                           newValkeyReconciler,
 	}
 
 	for k, v := range builders {
@@ -103,8 +150,18 @@ func newController(mgr ctrl.Manager, name string, cfg SetupConfig) Controller {
 		Scheme:          mgr.GetScheme(),
 		Recorder:        mgr.GetEventRecorderFor(strings.ToLower(name) + "-reconciler"),
 		DefaultToken:    cfg.DefaultToken,
+		TokenProvider:   cfg.TokenProvider,
 		KubeVersion:     cfg.KubeVersion,
 		OperatorVersion: cfg.OperatorVersion,
 		PollInterval:    cfg.PollInterval,
+		DryRun:          cfg.DryRun,
+		AdoptionPolicy:  cfg.adoptionPolicyFor(name),
+	}
+}
+
+func (cfg SetupConfig) adoptionPolicyFor(kind string) string {
+	if policy, ok := cfg.AdoptionPolicies[kind]; ok {
+		return policy
 	}
+	return cfg.AdoptionPolicy
 }
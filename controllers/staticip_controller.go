@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/staticip"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	staticIPStateCreated   = "created"
+	staticIPStateAvailable = "available"
+	staticIPStateAssigned  = "assigned"
+	staticIPStateDeleting  = "deleting"
+	staticIPStateDeleted   = "deleted"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips/finalizers,verbs=get;create;update
+
+// StaticIPController reconciles a StaticIP object.
+// Services associate and dissociate the static IPs they reference, see genericServiceHandler.
+type StaticIPController struct {
+	avnGen avngen.Client
+}
+
+func newStaticIPReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(_ Controller, avnGen avngen.Client) AivenController[*v1alpha1.StaticIP] {
+			return &StaticIPController{avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *StaticIPController) Observe(ctx context.Context, ip *v1alpha1.StaticIP) (Observation, error) {
+	if ip.Status.ID == "" {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	out, err := findStaticIP(ctx, r.avnGen, ip.Spec.Project, ip.Status.ID)
+	if err != nil {
+		return Observation{}, err
+	}
+	if out == nil || isStaticIPGone(string(out.State)) {
+		// The static IP vanished on Aiven side; trigger recreation.
+		return Observation{ResourceExists: false}, nil
+	}
+
+	ip.Status.IPAddress = out.IpAddress
+	ip.Status.State = string(out.State)
+	ip.Status.ServiceName = out.ServiceName
+
+	switch ip.Status.State {
+	case staticIPStateCreated, staticIPStateAvailable, staticIPStateAssigned:
+		markInstanceRunning(ip)
+	default:
+		meta.SetStatusCondition(&ip.Status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", fmt.Sprintf("Static IP is %s", ip.Status.State)))
+	}
+
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(ip),
+	}, nil
+}
+
+func (r *StaticIPController) Create(ctx context.Context, ip *v1alpha1.StaticIP) (CreateResult, error) {
+	delete(ip.GetAnnotations(), instanceIsRunningAnnotation)
+
+	out, err := r.avnGen.StaticIPCreate(ctx, ip.Spec.Project, &staticip.StaticIpcreateIn{
+		CloudName: ip.Spec.CloudName,
+	})
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create static IP: %w", err)
+	}
+
+	ip.Status.ID = out.StaticIpAddressId
+	ip.Status.IPAddress = out.IpAddress
+	ip.Status.State = string(out.State)
+
+	const reason = "Created"
+	meta.SetStatusCondition(&ip.Status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&ip.Status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, nil
+}
+
+func (r *StaticIPController) Update(_ context.Context, _ *v1alpha1.StaticIP) (UpdateResult, error) {
+	// StaticIP spec is fully immutable, so this is a no-op.
+	return UpdateResult{}, nil
+}
+
+func (r *StaticIPController) Delete(ctx context.Context, ip *v1alpha1.StaticIP) error {
+	// Nothing was ever created on Aiven side.
+	if ip.Status.ID == "" {
+		return nil
+	}
+
+	out, err := findStaticIP(ctx, r.avnGen, ip.Spec.Project, ip.Status.ID)
+	if err != nil {
+		return err
+	}
+	if out == nil || isStaticIPGone(string(out.State)) {
+		return nil
+	}
+
+	// Aiven refuses to delete an associated IP, the service must drop it from staticIps first.
+	if out.ServiceName != "" {
+		return fmt.Errorf("%w: static IP is associated with service %q", v1alpha1.ErrDeleteDependencies, out.ServiceName)
+	}
+
+	_, err = r.avnGen.StaticIPDelete(ctx, ip.Spec.Project, ip.Status.ID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// findStaticIP returns the static IP of the project, or nil if there is none with the ID.
+func findStaticIP(ctx context.Context, avnGen avngen.Client, project, id string) (*staticip.StaticIpOut, error) {
+	list, err := avnGen.StaticIPList(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list static IPs: %w", err)
+	}
+
+	for i := range list {
+		if list[i].StaticIpAddressId == id {
+			return &list[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func isStaticIPGone(state string) bool {
+	return state == staticIPStateDeleting || state == staticIPStateDeleted
+}
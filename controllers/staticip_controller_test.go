@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/staticip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestStaticIPController(t *testing.T) {
+	t.Parallel()
+
+	newStaticIP := func(t *testing.T) *v1alpha1.StaticIP {
+		t.Helper()
+		ip := newObjectFromExampleYAMLByKind[v1alpha1.StaticIP](t, "staticip", "StaticIP")
+		ip.Status.ID = "ip-id"
+		return ip
+	}
+
+	expectStaticIPList := func(t *testing.T, avn *avngen.MockClient, list string) {
+		t.Helper()
+		var out []staticip.StaticIpOut
+		require.NoError(t, json.Unmarshal([]byte(list), &out))
+		avn.EXPECT().StaticIPList(mock.Anything, "aiven-project-name").Return(out, nil).Once()
+	}
+
+	t.Run("Exposes the IP address and the associated service", func(t *testing.T) {
+		ip := newStaticIP(t)
+
+		avn := avngen.NewMockClient(t)
+		expectStaticIPList(t, avn, `[
+			{"static_ip_address_id": "other-id", "ip_address": "192.0.2.2", "state": "created", "cloud_name": "google-europe-west1"},
+			{"static_ip_address_id": "ip-id", "ip_address": "192.0.2.1", "state": "assigned", "service_name": "my-pg", "cloud_name": "google-europe-west1"}
+		]`)
+
+		obs, err := (&StaticIPController{avnGen: avn}).Observe(t.Context(), ip)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		assert.Equal(t, "192.0.2.1", ip.Status.IPAddress)
+		assert.Equal(t, "assigned", ip.Status.State)
+		assert.Equal(t, "my-pg", ip.Status.ServiceName)
+		assert.Equal(t, "true", ip.GetAnnotations()[instanceIsRunningAnnotation])
+	})
+
+	t.Run("Recreates a deleted IP", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		expectStaticIPList(t, avn, `[{"static_ip_address_id": "ip-id", "state": "deleted"}]`)
+
+		obs, err := (&StaticIPController{avnGen: avn}).Observe(t.Context(), newStaticIP(t))
+		require.NoError(t, err)
+		assert.False(t, obs.ResourceExists)
+	})
+
+	t.Run("Blocks the deletion of an associated IP", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		expectStaticIPList(t, avn, `[{"static_ip_address_id": "ip-id", "state": "available", "service_name": "my-pg"}]`)
+
+		err := (&StaticIPController{avnGen: avn}).Delete(t.Context(), newStaticIP(t))
+		require.ErrorIs(t, err, v1alpha1.ErrDeleteDependencies)
+		assert.ErrorContains(t, err, `static IP is associated with service "my-pg"`)
+	})
+
+	t.Run("Deletes a dissociated IP", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		expectStaticIPList(t, avn, `[{"static_ip_address_id": "ip-id", "state": "created"}]`)
+		avn.EXPECT().StaticIPDelete(mock.Anything, "aiven-project-name", "ip-id").Return(nil, nil).Once()
+
+		require.NoError(t, (&StaticIPController{avnGen: avn}).Delete(t.Context(), newStaticIP(t)))
+	})
+}
+
+func TestServiceStaticIPs(t *testing.T) {
+	t.Parallel()
+
+	newStaticIP := func(name, id string) *v1alpha1.StaticIP {
+		return &v1alpha1.StaticIP{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     v1alpha1.StaticIPStatus{ID: id},
+		}
+	}
+
+	t.Run("Associates the IPs that aren't associated yet", func(t *testing.T) {
+		var list []staticip.StaticIpOut
+		require.NoError(t, json.Unmarshal([]byte(`[
+			{"static_ip_address_id": "ip-1", "state": "created"},
+			{"static_ip_address_id": "ip-2", "state": "assigned", "service_name": "my-pg"}
+		]`), &list))
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().StaticIPList(mock.Anything, "my-project").Return(list, nil).Once()
+		avn.EXPECT().
+			ProjectStaticIPAssociate(mock.Anything, "my-project", "ip-1", &staticip.ProjectStaticIpassociateIn{ServiceName: "my-pg"}).
+			Return(nil, nil).Once()
+
+		ips := []*v1alpha1.StaticIP{newStaticIP("first", "ip-1"), newStaticIP("second", "ip-2")}
+		require.NoError(t, associateStaticIPs(t.Context(), avn, "my-project", "my-pg", ips))
+	})
+
+	t.Run("Refuses an IP associated with another service", func(t *testing.T) {
+		var list []staticip.StaticIpOut
+		require.NoError(t, json.Unmarshal([]byte(`[{"static_ip_address_id": "ip-1", "state": "assigned", "service_name": "my-kafka"}]`), &list))
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().StaticIPList(mock.Anything, "my-project").Return(list, nil).Once()
+
+		err := associateStaticIPs(t.Context(), avn, "my-project", "my-pg", []*v1alpha1.StaticIP{newStaticIP("first", "ip-1")})
+		require.ErrorContains(t, err, `static IP "first" is associated with service "my-kafka"`)
+	})
+
+	t.Run("Dissociates only the IPs that were associated by the operator", func(t *testing.T) {
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().ProjectStaticIPDissociate(mock.Anything, "my-project", "ip-2").Return(nil, nil).Once()
+
+		ips := []*v1alpha1.StaticIP{newStaticIP("first", "ip-1")}
+		require.NoError(t, dissociateStaticIPs(t.Context(), avn, "my-project", "my-pg", ips, []string{"ip-1", "ip-2"}))
+	})
+
+	t.Run("Toggles static_ips unless the user config sets it", func(t *testing.T) {
+		ips := []*v1alpha1.StaticIP{newStaticIP("first", "ip-1")}
+
+		userConfig := map[string]any{}
+		setStaticIPsUserConfig(userConfig, ips, nil)
+		assert.Equal(t, map[string]any{"static_ips": true}, userConfig)
+
+		userConfig = map[string]any{}
+		setStaticIPsUserConfig(userConfig, nil, []string{"ip-1"})
+		assert.Equal(t, map[string]any{"static_ips": false}, userConfig)
+
+		userConfig = map[string]any{}
+		setStaticIPsUserConfig(userConfig, nil, nil)
+		assert.Empty(t, userConfig)
+
+		userConfig = map[string]any{"static_ips": false}
+		setStaticIPsUserConfig(userConfig, ips, nil)
+		assert.Equal(t, map[string]any{"static_ips": false}, userConfig)
+	})
+}
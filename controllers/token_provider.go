@@ -0,0 +1,367 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// TokenProvider returns the operator Aiven token from a source other than a Kubernetes secret.
+type TokenProvider interface {
+	// Token returns the current token, fetching a new one when the cached one has expired.
+	Token(ctx context.Context) (string, error)
+
+	// Refresh drops the cached token, so the next Token call fetches a new one.
+	// It is called when Aiven rejects the token.
+	Refresh()
+}
+
+// TokenProviderOptions configures the operator token provider. Only one source can be set.
+type TokenProviderOptions struct {
+	// File is a file with the token, re-read when it changes, e.g. rendered by Vault agent or mounted by a CSI driver.
+	File string
+
+	// ExecCommand is a command that prints either the token or a JSON object with "token" and "expiresAt" (RFC 3339).
+	ExecCommand string
+
+	// TTL is how long a token is used when its source doesn't tell when it expires.
+	TTL time.Duration
+
+	// ExchangeURL is an OAuth 2.0 token exchange endpoint (RFC 8693)
+	// that exchanges the service account token for an Aiven token.
+	ExchangeURL string
+
+	// ExchangeAudience is the audience requested from ExchangeURL, optional.
+	ExchangeAudience string
+
+	// ServiceAccountTokenFile is the projected service account token sent to ExchangeURL.
+	ServiceAccountTokenFile string
+}
+
+const (
+	defaultTokenTTL = 5 * time.Minute
+
+	// tokenExpirySkew is how long before the expiration a token is renewed.
+	tokenExpirySkew = 30 * time.Second
+
+	tokenFetchTimeout = 30 * time.Second
+)
+
+var (
+	errMultipleTokenProviders = errors.New("only one of token file, token exec command and token exchange URL can be set")
+	errEmptyToken             = errors.New("token is empty")
+)
+
+// NewTokenProvider returns the provider configured in opts, or nil when none is configured.
+func NewTokenProvider(opts TokenProviderOptions) (TokenProvider, error) {
+	configured := 0
+	for _, v := range []string{opts.File, opts.ExecCommand, opts.ExchangeURL} {
+		if v != "" {
+			configured++
+		}
+	}
+	if configured > 1 {
+		return nil, errMultipleTokenProviders
+	}
+
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+
+	switch {
+	case opts.File != "":
+		return &fileTokenProvider{path: opts.File}, nil
+	case opts.ExecCommand != "":
+		args := strings.Fields(opts.ExecCommand)
+		return &cachedTokenProvider{fetch: func(ctx context.Context) (string, time.Time, error) {
+			return execToken(ctx, args, ttl)
+		}}, nil
+	case opts.ExchangeURL != "":
+		if _, err := url.ParseRequestURI(opts.ExchangeURL); err != nil {
+			return nil, fmt.Errorf("invalid token exchange URL: %w", err)
+		}
+		if opts.ServiceAccountTokenFile == "" {
+			return nil, errors.New("service account token file is required for the token exchange")
+		}
+		return &cachedTokenProvider{fetch: func(ctx context.Context) (string, time.Time, error) {
+			return exchangeToken(ctx, opts, ttl)
+		}}, nil
+	}
+	return nil, nil
+}
+
+// hasDefaultToken is true when the operator token is used instead of the tokens referenced by the objects.
+func (c *Controller) hasDefaultToken() bool {
+	return c.DefaultToken != "" || c.TokenProvider != nil
+}
+
+// providerToken returns the token of the operator token provider.
+func (c *Controller) providerToken(ctx context.Context, o v1alpha1.AivenManagedObject) (string, error) {
+	token, err := c.TokenProvider.Token(ctx)
+	if err != nil {
+		c.Recorder.Event(o, corev1.EventTypeWarning, eventUnableToGetToken, err.Error())
+		return "", fmt.Errorf("cannot get token from the token provider: %w", err)
+	}
+	return token, nil
+}
+
+// fileTokenProvider reads the token from a file and re-reads it when the file changes.
+type fileTokenProvider struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (p *fileTokenProvider) Token(_ context.Context) (string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read token file: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.token, nil
+	}
+
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %q: %w", p.path, errEmptyToken)
+	}
+
+	p.token, p.modTime, p.size = token, info.ModTime(), info.Size()
+	return token, nil
+}
+
+func (p *fileTokenProvider) Refresh() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
+
+// cachedTokenProvider keeps the fetched token until shortly before it expires.
+type cachedTokenProvider struct {
+	fetch func(ctx context.Context) (token string, expires time.Time, err error)
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func (p *cachedTokenProvider) Token(ctx context.Context) (string, error) {
+	// Concurrent reconciles wait for one fetch instead of running the command or the exchange several times.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && time.Now().Add(tokenExpirySkew).Before(p.expires) {
+		return p.token, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenFetchTimeout)
+	defer cancel()
+
+	token, expires, err := p.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	p.token, p.expires = token, expires
+	return token, nil
+}
+
+func (p *cachedTokenProvider) Refresh() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
+
+// execToken runs the command and parses its output,
+// which is either the token or a JSON object with "token" and "expiresAt".
+func execToken(ctx context.Context, args []string, ttl time.Duration) (string, time.Time, error) {
+	out, err := exec.CommandContext(ctx, args[0], args[1:]...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", time.Time{}, fmt.Errorf("token exec command failed: %w: %s", err, bytes.TrimSpace(exitErr.Stderr))
+		}
+		return "", time.Time{}, fmt.Errorf("token exec command failed: %w", err)
+	}
+
+	out = bytes.TrimSpace(out)
+	if !bytes.HasPrefix(out, []byte("{")) {
+		if len(out) == 0 {
+			return "", time.Time{}, fmt.Errorf("token exec command: %w", errEmptyToken)
+		}
+		return string(out), time.Now().Add(ttl), nil
+	}
+
+	var v struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err := json.Unmarshal(out, &v); err != nil {
+		return "", time.Time{}, fmt.Errorf("cannot parse the output of the token exec command: %w", err)
+	}
+	if v.Token == "" {
+		return "", time.Time{}, fmt.Errorf("token exec command: %w", errEmptyToken)
+	}
+	if v.ExpiresAt.IsZero() {
+		v.ExpiresAt = time.Now().Add(ttl)
+	}
+	return v.Token, v.ExpiresAt, nil
+}
+
+// exchangeToken exchanges the projected service account token for an Aiven token (RFC 8693).
+// The service account token is read on every exchange, because kubelet rotates it.
+func exchangeToken(ctx context.Context, opts TokenProviderOptions, ttl time.Duration) (string, time.Time, error) {
+	subject, err := os.ReadFile(opts.ServiceAccountTokenFile)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("cannot read service account token: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type":         {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"subject_token":      {strings.TrimSpace(string(subject))},
+		"subject_token_type": {"urn:ietf:params:oauth:token-type:jwt"},
+	}
+	if opts.ExchangeAudience != "" {
+		form.Set("audience", opts.ExchangeAudience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, opts.ExchangeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("token exchange failed: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("token exchange failed: %w", err)
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("token exchange failed with status %d: %s", rsp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var v struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", time.Time{}, fmt.Errorf("cannot parse the token exchange response: %w", err)
+	}
+	if v.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("token exchange: %w", errEmptyToken)
+	}
+
+	expires := time.Now().Add(ttl)
+	if v.ExpiresIn > 0 {
+		expires = time.Now().Add(time.Duration(v.ExpiresIn) * time.Second)
+	}
+	return v.AccessToken, expires, nil
+}
+
+// tokenProviderTransport sets the token of every request from the provider,
+// so the clients pick up a rotated token without being rebuilt.
+// When Aiven rejects the token, the provider is refreshed and the request is retried once.
+type tokenProviderTransport struct {
+	provider TokenProvider
+	next     http.RoundTripper
+}
+
+func (t *tokenProviderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rsp, err := t.roundTrip(req, req.Body)
+	if err != nil || !isInvalidTokenResponse(rsp) {
+		return rsp, err
+	}
+
+	// The body was consumed by the first request.
+	body := req.Body
+	if body != nil && body != http.NoBody {
+		if req.GetBody == nil {
+			return rsp, nil
+		}
+		if body, err = req.GetBody(); err != nil {
+			return rsp, nil
+		}
+	}
+
+	_ = rsp.Body.Close()
+	t.provider.Refresh()
+	return t.roundTrip(req, body)
+}
+
+func (t *tokenProviderTransport) roundTrip(req *http.Request, body io.ReadCloser) (*http.Response, error) {
+	token, err := t.provider.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get Aiven token: %w", err)
+	}
+
+	// A RoundTripper must not modify the request.
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	clone.Header.Set("Authorization", "aivenv1 "+token)
+	return t.next.RoundTrip(clone)
+}
+
+// isInvalidTokenResponse checks if Aiven rejected the token. The body is kept readable.
+func isInvalidTokenResponse(rsp *http.Response) bool {
+	if rsp.StatusCode != http.StatusUnauthorized && rsp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	body, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	rsp.Body = io.NopCloser(bytes.NewReader(body))
+	return err == nil && isInvalidTokenMessage(string(body))
+}
+
+// newTokenProviderClientFunc returns a constructor of Aiven clients that take the token from the provider.
+// The token passed to the constructor is only used until the first request.
+func newTokenProviderClientFunc(provider TokenProvider) func(token, kubeVersion, operatorVersion string) (avngen.Client, error) {
+	return func(token, kubeVersion, operatorVersion string) (avngen.Client, error) {
+		doer := &http.Client{Transport: &tokenProviderTransport{
+			provider: provider,
+			next:     getAivenHTTPClient().Transport,
+		}}
+		c, err := avngen.NewClient(
+			avngen.TokenOpt(token),
+			avngen.UserAgentOpt(userAgent(kubeVersion, operatorVersion)),
+			avngen.DoerOpt(doer),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return newMetricsClient(c), nil
+	}
+}
@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTokenProvider(t *testing.T) {
+	p, err := NewTokenProvider(TokenProviderOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	_, err = NewTokenProvider(TokenProviderOptions{File: "/token", ExecCommand: "echo token"})
+	require.ErrorIs(t, err, errMultipleTokenProviders)
+
+	_, err = NewTokenProvider(TokenProviderOptions{ExchangeURL: "https://sts.example.com/token"})
+	require.ErrorContains(t, err, "service account token file is required")
+}
+
+func TestFileTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	p, err := NewTokenProvider(TokenProviderOptions{File: path})
+	require.NoError(t, err)
+
+	token, err := p.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	// The file is replaced, e.g. by Vault agent.
+	require.NoError(t, os.WriteFile(path, []byte("second-token"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	token, err = p.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "second-token", token)
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	p.Refresh()
+	_, err = p.Token(t.Context())
+	require.ErrorIs(t, err, errEmptyToken)
+}
+
+func TestExecTokenProvider(t *testing.T) {
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "calls")
+	script := filepath.Join(dir, "token.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho x >> "+counter+"\ncat "+filepath.Join(dir, "out")+"\n"), 0o700))
+	calls := func() int {
+		b, _ := os.ReadFile(counter)
+		return strings.Count(string(b), "x")
+	}
+
+	t.Run("Plain token is cached for the TTL", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "out"), []byte("plain-token\n"), 0o600))
+		p, err := NewTokenProvider(TokenProviderOptions{ExecCommand: script, TTL: time.Hour})
+		require.NoError(t, err)
+
+		for range 3 {
+			token, err := p.Token(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, "plain-token", token)
+		}
+		assert.Equal(t, 1, calls())
+
+		p.Refresh()
+		_, err = p.Token(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls())
+	})
+
+	t.Run("Expired JSON token is fetched again", func(t *testing.T) {
+		out, err := json.Marshal(map[string]any{"token": "json-token", "expiresAt": time.Now().Add(time.Second)})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "out"), out, 0o600))
+		p, err := NewTokenProvider(TokenProviderOptions{ExecCommand: script, TTL: time.Hour})
+		require.NoError(t, err)
+
+		before := calls()
+		for range 2 {
+			token, err := p.Token(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, "json-token", token)
+		}
+		assert.Equal(t, before+2, calls(), "the token expires within tokenExpirySkew")
+	})
+
+	t.Run("Command errors are returned", func(t *testing.T) {
+		p, err := NewTokenProvider(TokenProviderOptions{ExecCommand: filepath.Join(dir, "missing")})
+		require.NoError(t, err)
+
+		_, err = p.Token(t.Context())
+		require.ErrorContains(t, err, "token exec command failed")
+	})
+}
+
+func TestExchangeTokenProvider(t *testing.T) {
+	var exchanges atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges.Add(1)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", r.Form.Get("grant_type"))
+		assert.Equal(t, "aiven", r.Form.Get("audience"))
+		if r.Form.Get("subject_token") != "sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, `{"error":"invalid_grant"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"access_token":"aiven-token","expires_in":3600}`)
+	}))
+	defer srv.Close()
+
+	saToken := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(saToken, []byte("sa-token"), 0o600))
+
+	p, err := NewTokenProvider(TokenProviderOptions{
+		ExchangeURL:             srv.URL,
+		ExchangeAudience:        "aiven",
+		ServiceAccountTokenFile: saToken,
+	})
+	require.NoError(t, err)
+
+	for range 2 {
+		token, err := p.Token(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "aiven-token", token)
+	}
+	assert.EqualValues(t, 1, exchanges.Load())
+
+	require.NoError(t, os.WriteFile(saToken, []byte("expired"), 0o600))
+	p.Refresh()
+	_, err = p.Token(t.Context())
+	require.ErrorContains(t, err, "token exchange failed with status 401")
+}
+
+type rotatingTokenProvider struct {
+	tokens    []string
+	refreshes int
+}
+
+func (p *rotatingTokenProvider) Token(_ context.Context) (string, error) {
+	return p.tokens[p.refreshes], nil
+}
+
+func (p *rotatingTokenProvider) Refresh() {
+	p.refreshes++
+}
+
+func TestTokenProviderTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Authorization") != "aivenv1 new-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `{"message":"Invalid token"}`)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	t.Run("Retries with a refreshed token", func(t *testing.T) {
+		p := &rotatingTokenProvider{tokens: []string{"old-token", "new-token"}}
+		c := &http.Client{Transport: &tokenProviderTransport{provider: p, next: http.DefaultTransport}}
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL, strings.NewReader("payload"))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "aivenv1 initial-token")
+
+		rsp, err := c.Do(req)
+		require.NoError(t, err)
+		defer rsp.Body.Close()
+		body, err := io.ReadAll(rsp.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+		assert.Equal(t, "payload", string(body), "the body is sent again")
+		assert.Equal(t, 1, p.refreshes)
+	})
+
+	t.Run("Returns the error when the refreshed token is also invalid", func(t *testing.T) {
+		p := &rotatingTokenProvider{tokens: []string{"old-token", "revoked-token", "unused"}}
+		c := &http.Client{Transport: &tokenProviderTransport{provider: p, next: http.DefaultTransport}}
+
+		code, body := doRequest(t, c, http.MethodGet, srv.URL, "initial-token")
+
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.True(t, isInvalidTokenMessage(body))
+		assert.Equal(t, 1, p.refreshes, "the request is retried only once")
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=aiven.io,resources=transitgatewayvpcattachments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=transitgatewayvpcattachments/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=transitgatewayvpcattachments/finalizers,verbs=get;create;update
+
+// TransitGatewayVPCAttachmentController reconciles a TransitGatewayVPCAttachment object.
+// Aiven models the attachment as a VPC peering connection with the transit gateway as the peer VPC.
+type TransitGatewayVPCAttachmentController struct {
+	client.Client
+	avnGen avngen.Client
+}
+
+func newTransitGatewayVPCAttachmentReconciler(c Controller) reconcilerType {
+	return newManagedReconciler(
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[*v1alpha1.TransitGatewayVPCAttachment] {
+			return &TransitGatewayVPCAttachmentController{Client: c.Client, avnGen: avnGen}
+		},
+		nil,
+	)
+}
+
+func (r *TransitGatewayVPCAttachmentController) Observe(ctx context.Context, a *v1alpha1.TransitGatewayVPCAttachment) (Observation, error) {
+	projectVPC, err := getPeeredProjectVPC(ctx, r.Client, a.Namespace, a.Spec.Project, a.Spec.ProjectVPCRef)
+	if err != nil {
+		return Observation{}, err
+	}
+
+	out, err := r.avnGen.VpcGet(ctx, a.Spec.Project, projectVPC.Status.ID)
+	if err != nil {
+		return Observation{}, fmt.Errorf("cannot get project VPC: %w", err)
+	}
+
+	pc := findVPCPeeringConnection(out.PeeringConnections, transitGatewayPeeringIn(a))
+	if pc == nil {
+		return Observation{ResourceExists: false}, nil
+	}
+
+	a.Status.ProjectVPCID = projectVPC.Status.ID
+	a.Status.UserPeerNetworkCIDRs = slices.Sorted(slices.Values(pc.UserPeerNetworkCidrs))
+	setVPCPeeringState(a, &a.Status.VPCPeeringConnectionStatus, pc)
+
+	add, remove := diffUserPeerNetworkCIDRs(a.Spec.UserPeerNetworkCIDRs, a.Status.UserPeerNetworkCIDRs)
+	return Observation{
+		ResourceExists:   true,
+		ResourceUpToDate: hasLatestGeneration(a) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (r *TransitGatewayVPCAttachmentController) Create(ctx context.Context, a *v1alpha1.TransitGatewayVPCAttachment) (CreateResult, error) {
+	delete(a.GetAnnotations(), instanceIsRunningAnnotation)
+
+	projectVPC, err := getPeeredProjectVPC(ctx, r.Client, a.Namespace, a.Spec.Project, a.Spec.ProjectVPCRef)
+	if err != nil {
+		return CreateResult{}, err
+	}
+	if err := validateUserPeerNetworkCIDRs(projectVPC.Spec.NetworkCidr, a.Spec.UserPeerNetworkCIDRs); err != nil {
+		return CreateResult{}, err
+	}
+
+	in := transitGatewayPeeringIn(a)
+	cidrs := slices.Clone(a.Spec.UserPeerNetworkCIDRs)
+	in.UserPeerNetworkCidrs = &cidrs
+	out, err := r.avnGen.VpcPeeringConnectionCreate(ctx, a.Spec.Project, projectVPC.Status.ID, in)
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create transit gateway VPC attachment: %w", err)
+	}
+
+	a.Status.ProjectVPCID = projectVPC.Status.ID
+	a.Status.State = string(out.State)
+	a.Status.UserPeerNetworkCIDRs = slices.Sorted(slices.Values(cidrs))
+
+	const reason = "Created"
+	meta.SetStatusCondition(&a.Status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&a.Status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, nil
+}
+
+// Update adds and removes the user peer network CIDRs that differ from the ones in Aiven.
+func (r *TransitGatewayVPCAttachmentController) Update(ctx context.Context, a *v1alpha1.TransitGatewayVPCAttachment) (UpdateResult, error) {
+	projectVPC, err := getPeeredProjectVPC(ctx, r.Client, a.Namespace, a.Spec.Project, a.Spec.ProjectVPCRef)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+	if err := validateUserPeerNetworkCIDRs(projectVPC.Spec.NetworkCidr, a.Spec.UserPeerNetworkCIDRs); err != nil {
+		return UpdateResult{}, err
+	}
+
+	add, remove := diffUserPeerNetworkCIDRs(a.Spec.UserPeerNetworkCIDRs, a.Status.UserPeerNetworkCIDRs)
+	if len(add) == 0 && len(remove) == 0 {
+		return UpdateResult{}, nil
+	}
+
+	in := &vpc.VpcPeeringConnectionUpdateIn{}
+	if len(add) > 0 {
+		addIn := make([]vpc.AddIn, 0, len(add))
+		for _, cidr := range add {
+			addIn = append(addIn, vpc.AddIn{
+				Cidr:             cidr,
+				PeerCloudAccount: a.Spec.AWSAccountID,
+				PeerVpc:          a.Spec.TransitGatewayID,
+			})
+		}
+		in.Add = &addIn
+	}
+	if len(remove) > 0 {
+		in.Delete = &remove
+	}
+
+	_, err = r.avnGen.VpcPeeringConnectionUpdate(ctx, a.Spec.Project, projectVPC.Status.ID, in)
+	if err != nil {
+		return UpdateResult{}, fmt.Errorf("cannot update user peer network CIDRs: %w", err)
+	}
+
+	a.Status.UserPeerNetworkCIDRs = slices.Sorted(slices.Values(a.Spec.UserPeerNetworkCIDRs))
+	return UpdateResult{}, nil
+}
+
+func (r *TransitGatewayVPCAttachmentController) Delete(ctx context.Context, a *v1alpha1.TransitGatewayVPCAttachment) error {
+	if a.Status.ProjectVPCID == "" {
+		return nil
+	}
+
+	_, err := r.avnGen.VpcPeeringConnectionDelete(ctx, a.Spec.Project, a.Status.ProjectVPCID, a.Spec.AWSAccountID, a.Spec.TransitGatewayID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func transitGatewayPeeringIn(a *v1alpha1.TransitGatewayVPCAttachment) *vpc.VpcPeeringConnectionCreateIn {
+	return &vpc.VpcPeeringConnectionCreateIn{
+		PeerCloudAccount: a.Spec.AWSAccountID,
+		PeerVpc:          a.Spec.TransitGatewayID,
+	}
+}
+
+// validateUserPeerNetworkCIDRs returns an error when a CIDR is invalid or overlaps the network of the VPC.
+func validateUserPeerNetworkCIDRs(networkCIDR string, cidrs []string) error {
+	network, err := netip.ParsePrefix(networkCIDR)
+	if err != nil {
+		return fmt.Errorf("invalid ProjectVPC network CIDR %q: %w", networkCIDR, err)
+	}
+
+	for _, cidr := range cidrs {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid user peer network CIDR %q: %w", cidr, err)
+		}
+		if p.Overlaps(network) {
+			return fmt.Errorf("user peer network CIDR %q overlaps the ProjectVPC network CIDR %q", cidr, networkCIDR)
+		}
+	}
+	return nil
+}
+
+// diffUserPeerNetworkCIDRs returns the CIDRs to add to and to remove from the attachment, sorted.
+func diffUserPeerNetworkCIDRs(desired, actual []string) (add, remove []string) {
+	for _, cidr := range desired {
+		if !slices.Contains(actual, cidr) && !slices.Contains(add, cidr) {
+			add = append(add, cidr)
+		}
+	}
+	for _, cidr := range actual {
+		if !slices.Contains(desired, cidr) {
+			remove = append(remove, cidr)
+		}
+	}
+	slices.Sort(add)
+	slices.Sort(remove)
+	return add, remove
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestTransitGatewayVPCAttachmentController(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newAttachment := func(t *testing.T) *v1alpha1.TransitGatewayVPCAttachment {
+		t.Helper()
+		a := newObjectFromExampleYAMLByKind[v1alpha1.TransitGatewayVPCAttachment](t, "transitgatewayvpcattachment", "TransitGatewayVPCAttachment")
+		a.Namespace = "default"
+		return a
+	}
+
+	newController := func(t *testing.T, avn avngen.Client) *TransitGatewayVPCAttachmentController {
+		t.Helper()
+		projectVPC := newObjectFromExampleYAMLByKind[v1alpha1.ProjectVPC](t, "transitgatewayvpcattachment", "ProjectVPC")
+		projectVPC.Namespace = "default"
+		projectVPC.Status.ID = "vpc-id"
+		return &TransitGatewayVPCAttachmentController{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(projectVPC).Build(),
+			avnGen: avn,
+		}
+	}
+
+	expectVpcGet := func(t *testing.T, avn *avngen.MockClient, peeringConnections string) {
+		t.Helper()
+		out := &vpc.VpcGetOut{}
+		require.NoError(t, json.Unmarshal([]byte(`{"peering_connections": `+peeringConnections+`}`), out))
+		avn.EXPECT().VpcGet(mock.Anything, "aiven-project-name", "vpc-id").Return(out, nil).Once()
+	}
+
+	t.Run("Creates the attachment with the CIDRs", func(t *testing.T) {
+		a := newAttachment(t)
+
+		avn := avngen.NewMockClient(t)
+		out := &vpc.VpcPeeringConnectionCreateOut{}
+		require.NoError(t, json.Unmarshal([]byte(`{"state": "APPROVED"}`), out))
+		avn.EXPECT().
+			VpcPeeringConnectionCreate(mock.Anything, "aiven-project-name", "vpc-id", mock.MatchedBy(func(in *vpc.VpcPeeringConnectionCreateIn) bool {
+				return in.PeerCloudAccount == "123456789012" &&
+					in.PeerVpc == "tgw-0a1b2c3d4e5f67890" &&
+					assert.ObjectsAreEqual([]string{"10.10.0.0/16", "172.16.0.0/20"}, *in.UserPeerNetworkCidrs)
+			})).
+			Return(out, nil).Once()
+
+		_, err := newController(t, avn).Create(t.Context(), a)
+		require.NoError(t, err)
+		assert.Equal(t, "APPROVED", a.Status.State)
+		assert.Equal(t, []string{"10.10.0.0/16", "172.16.0.0/20"}, a.Status.UserPeerNetworkCIDRs)
+	})
+
+	t.Run("Rejects CIDRs that overlap the VPC network", func(t *testing.T) {
+		a := newAttachment(t)
+		a.Spec.UserPeerNetworkCIDRs = []string{"10.10.0.0/16", "10.0.0.128/25"}
+
+		_, err := newController(t, avngen.NewMockClient(t)).Create(t.Context(), a)
+		require.ErrorContains(t, err, `user peer network CIDR "10.0.0.128/25" overlaps the ProjectVPC network CIDR "10.0.0.0/24"`)
+	})
+
+	t.Run("Adds and removes the changed CIDRs", func(t *testing.T) {
+		a := newAttachment(t)
+		a.Spec.UserPeerNetworkCIDRs = []string{"10.10.0.0/16", "192.168.0.0/24"}
+
+		avn := avngen.NewMockClient(t)
+		expectVpcGet(t, avn, `[
+			{"peer_cloud_account": "123456789012", "peer_vpc": "tgw-0a1b2c3d4e5f67890", "state": "ACTIVE", "state_info": {},
+			 "user_peer_network_cidrs": ["172.16.0.0/20", "10.10.0.0/16"]}
+		]`)
+		avn.EXPECT().
+			VpcPeeringConnectionUpdate(mock.Anything, "aiven-project-name", "vpc-id", mock.MatchedBy(func(in *vpc.VpcPeeringConnectionUpdateIn) bool {
+				return assert.ObjectsAreEqual([]vpc.AddIn{{
+					Cidr:             "192.168.0.0/24",
+					PeerCloudAccount: "123456789012",
+					PeerVpc:          "tgw-0a1b2c3d4e5f67890",
+				}}, *in.Add) && assert.ObjectsAreEqual([]string{"172.16.0.0/20"}, *in.Delete)
+			})).
+			Return(&vpc.VpcPeeringConnectionUpdateOut{}, nil).Once()
+
+		ctrl := newController(t, avn)
+		obs, err := ctrl.Observe(t.Context(), a)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		require.False(t, obs.ResourceUpToDate)
+		assert.Equal(t, "ACTIVE", a.Status.State)
+		assert.Equal(t, []string{"10.10.0.0/16", "172.16.0.0/20"}, a.Status.UserPeerNetworkCIDRs)
+
+		_, err = ctrl.Update(t.Context(), a)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.10.0.0/16", "192.168.0.0/24"}, a.Status.UserPeerNetworkCIDRs)
+	})
+}
@@ -68,6 +68,10 @@ func (a *valkeyAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
 	return &a.Spec.ServiceCommonSpec
 }
 
+func (a *valkeyAdapter) getServiceFork() *v1alpha1.ServiceForkFields {
+	return &a.Spec.ServiceForkFields
+}
+
 func (a *valkeyAdapter) getUserConfig() any {
 	return a.Spec.UserConfig
 }
@@ -82,6 +86,8 @@ func (a *valkeyAdapter) newSecret(s *service.ServiceGetOut) *corev1.Secret {
 		prefix + "USER":     s.ServiceUriParams["user"],
 	}
 
+	addPrivatelinkEndpointDetails(stringData, s.Components, "valkey", prefix)
+
 	return newSecret(a, stringData, false)
 }
 
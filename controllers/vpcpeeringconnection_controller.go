@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// VPC peering connection states reported by Aiven.
+const (
+	vpcPeeringStateActive               = "ACTIVE"
+	vpcPeeringStateDeleted              = "DELETED"
+	vpcPeeringStateDeleting             = "DELETING"
+	vpcPeeringStateInvalidSpecification = "INVALID_SPECIFICATION"
+)
+
+// vpcPeer describes the cloud side of a VPC peering connection.
+type vpcPeer[T v1alpha1.VPCPeeringConnection] struct {
+	// createIn returns the connection to create. Its peer fields also identify the connection within the VPC.
+	createIn func(obj T) *vpc.VpcPeeringConnectionCreateIn
+
+	// delete deletes the connection from the VPC.
+	delete func(ctx context.Context, avnGen avngen.Client, obj T, projectVPCID string) error
+
+	// setStatus sets the cloud side IDs from the state info of the connection.
+	// Returns the secret keys, without the prefix, the cloud side needs to accept the connection.
+	setStatus func(obj T, stateInfo map[string]string) SecretDetails
+}
+
+// vpcPeeringConnectionController reconciles the AWS, GCP and Azure VPC peering connections.
+type vpcPeeringConnectionController[T v1alpha1.VPCPeeringConnection] struct {
+	client.Client
+	avnGen avngen.Client
+	peer   vpcPeer[T]
+}
+
+func newVPCPeeringConnectionReconciler[T any, Obj interface {
+	*T
+	v1alpha1.VPCPeeringConnection
+}](c Controller, peer vpcPeer[Obj]) reconcilerType {
+	return newManagedReconciler[T, Obj](
+		c,
+		func(c Controller, avnGen avngen.Client) AivenController[Obj] {
+			return &vpcPeeringConnectionController[Obj]{Client: c.Client, avnGen: avnGen, peer: peer}
+		},
+		nil,
+	)
+}
+
+func (r *vpcPeeringConnectionController[T]) Observe(ctx context.Context, obj T) (Observation, error) {
+	details, err := r.refresh(ctx, obj)
+	switch {
+	case err != nil:
+		return Observation{}, err
+	case details == nil:
+		return Observation{ResourceExists: false}, nil
+	}
+
+	return Observation{
+		ResourceExists: true,
+		// The connection is immutable, the secret is the only thing an update changes.
+		ResourceUpToDate: hasLatestGeneration(obj),
+		SecretDetails:    details,
+	}, nil
+}
+
+func (r *vpcPeeringConnectionController[T]) Create(ctx context.Context, obj T) (CreateResult, error) {
+	delete(obj.GetAnnotations(), instanceIsRunningAnnotation)
+
+	projectVPCID, err := r.getProjectVPCID(ctx, obj)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	out, err := r.avnGen.VpcPeeringConnectionCreate(ctx, obj.GetPeeringSpec().Project, projectVPCID, r.peer.createIn(obj))
+	if err != nil {
+		return CreateResult{}, fmt.Errorf("cannot create VPC peering connection: %w", err)
+	}
+
+	status := obj.GetPeeringStatus()
+	status.ProjectVPCID = projectVPCID
+	status.State = string(out.State)
+
+	const reason = "Created"
+	meta.SetStatusCondition(&status.Conditions, getInitializedCondition(reason, "Successfully created the instance in Aiven"))
+	meta.SetStatusCondition(&status.Conditions, getRunningCondition(metav1.ConditionUnknown, reason, "Successfully created the instance in Aiven, status remains unknown"))
+
+	return CreateResult{}, nil
+}
+
+func (r *vpcPeeringConnectionController[T]) Update(ctx context.Context, obj T) (UpdateResult, error) {
+	details, err := r.refresh(ctx, obj)
+	if err != nil {
+		return UpdateResult{}, err
+	}
+	return UpdateResult{SecretDetails: details}, nil
+}
+
+func (r *vpcPeeringConnectionController[T]) Delete(ctx context.Context, obj T) error {
+	projectVPCID := obj.GetPeeringStatus().ProjectVPCID
+	if projectVPCID == "" {
+		return nil
+	}
+
+	err := r.peer.delete(ctx, r.avnGen, obj, projectVPCID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// refresh finds the connection in the VPC and updates the status.
+// Returns nil details when the connection doesn't exist.
+func (r *vpcPeeringConnectionController[T]) refresh(ctx context.Context, obj T) (SecretDetails, error) {
+	projectVPCID, err := r.getProjectVPCID(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.avnGen.VpcGet(ctx, obj.GetPeeringSpec().Project, projectVPCID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get project VPC: %w", err)
+	}
+
+	pc := findVPCPeeringConnection(out.PeeringConnections, r.peer.createIn(obj))
+	if pc == nil {
+		return nil, nil
+	}
+
+	status := obj.GetPeeringStatus()
+	status.ProjectVPCID = projectVPCID
+	stateInfo := setVPCPeeringState(obj, status, pc)
+
+	prefix := getSecretPrefix(obj)
+	details := SecretDetails{
+		prefix + "PROJECT_VPC_ID": projectVPCID,
+		prefix + "STATE":          status.State,
+	}
+	for k, v := range r.peer.setStatus(obj, stateInfo) {
+		details[prefix+k] = v
+	}
+	return details, nil
+}
+
+// getProjectVPCID returns the ID of the referenced ProjectVPC.
+func (r *vpcPeeringConnectionController[T]) getProjectVPCID(ctx context.Context, obj T) (string, error) {
+	spec := obj.GetPeeringSpec()
+	projectVPC, err := getPeeredProjectVPC(ctx, r.Client, obj.GetNamespace(), spec.Project, spec.ProjectVPCRef)
+	if err != nil {
+		return "", err
+	}
+	return projectVPC.Status.ID, nil
+}
+
+// getPeeredProjectVPC returns the referenced ProjectVPC once it is created in the given project.
+func getPeeredProjectVPC(ctx context.Context, c client.Reader, namespace, project string, ref v1alpha1.ResourceReference) (*v1alpha1.ProjectVPC, error) {
+	key := ref.ProjectVPC(namespace).NamespacedName
+
+	projectVPC := &v1alpha1.ProjectVPC{}
+	if err := c.Get(ctx, key, projectVPC); err != nil {
+		return nil, fmt.Errorf("cannot get ProjectVPC %q: %w", key, err)
+	}
+	if projectVPC.Spec.Project != project {
+		return nil, fmt.Errorf("ProjectVPC %q belongs to project %q, not %q", key, projectVPC.Spec.Project, project)
+	}
+	if projectVPC.Status.ID == "" {
+		return nil, fmt.Errorf("%w: ProjectVPC %q is not created yet", errPreconditionNotMet, key)
+	}
+	return projectVPC, nil
+}
+
+// findVPCPeeringConnection returns the connection with the peer of the given input, skipping the deleted ones.
+// The region and the resource group are compared only when the input sets them:
+// Aiven fills in the region of GCP and Azure peerings, and the VPC region of AWS peerings created without one.
+func findVPCPeeringConnection(connections []vpc.PeeringConnectionOut, in *vpc.VpcPeeringConnectionCreateIn) *vpc.PeeringConnectionOut {
+	for i, v := range connections {
+		state := string(v.State)
+		if state == vpcPeeringStateDeleting || state == vpcPeeringStateDeleted {
+			continue
+		}
+		if v.PeerCloudAccount == in.PeerCloudAccount && v.PeerVpc == in.PeerVpc &&
+			(in.PeerRegion == nil || fromAnyPointer(v.PeerRegion) == *in.PeerRegion) &&
+			(in.PeerResourceGroup == nil || fromAnyPointer(v.PeerResourceGroup) == *in.PeerResourceGroup) {
+			return &connections[i]
+		}
+	}
+	return nil
+}
+
+// setVPCPeeringState sets the state of the connection and marks the object running once the connection is active.
+// Returns the state info of the connection.
+func setVPCPeeringState(obj v1alpha1.AivenManagedObject, status *v1alpha1.VPCPeeringConnectionStatus, pc *vpc.PeeringConnectionOut) map[string]string {
+	stateInfo := vpcPeeringStateInfo(pc.StateInfo)
+	status.State = string(pc.State)
+	status.StateMessage = stateInfo["message"]
+
+	switch status.State {
+	case vpcPeeringStateActive:
+		markInstanceRunning(obj)
+	case vpcPeeringStateInvalidSpecification:
+		meta.SetStatusCondition(&status.Conditions, getRunningCondition(metav1.ConditionFalse, "InvalidSpecification", status.StateMessage))
+	default:
+		meta.SetStatusCondition(&status.Conditions, getRunningCondition(metav1.ConditionFalse, "CheckRunning", fmt.Sprintf("VPC peering connection is %s", status.State)))
+	}
+	return stateInfo
+}
+
+// vpcPeeringStateInfo returns the string values of the connection state info.
+// The keys depend on the cloud, for example `aws_vpc_peering_connection_id` or `to_project_id`.
+func vpcPeeringStateInfo(stateInfo any) map[string]string {
+	b, err := json.Marshal(stateInfo)
+	if err != nil {
+		return nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+
+	info := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			info[k] = s
+		}
+	}
+	return info
+}
@@ -0,0 +1,255 @@
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/vpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestVPCPeeringConnectionController(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newProjectVPC := func(t *testing.T, example, id string) *v1alpha1.ProjectVPC {
+		t.Helper()
+		projectVPC := newObjectFromExampleYAMLByKind[v1alpha1.ProjectVPC](t, example, "ProjectVPC")
+		projectVPC.Namespace = "default"
+		projectVPC.Status.ID = id
+		return projectVPC
+	}
+
+	newVpcGetOut := func(t *testing.T, peeringConnections string) *vpc.VpcGetOut {
+		t.Helper()
+		out := &vpc.VpcGetOut{}
+		require.NoError(t, json.Unmarshal([]byte(`{"peering_connections": `+peeringConnections+`}`), out))
+		return out
+	}
+
+	t.Run("Waits for the ProjectVPC to be created", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.AWSVPCPeeringConnection](t, "awsvpcpeeringconnection", "AWSVPCPeeringConnection")
+		conn.Namespace = "default"
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.AWSVPCPeeringConnection]{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newProjectVPC(t, "awsvpcpeeringconnection", "")).Build(),
+			avnGen: avngen.NewMockClient(t),
+			peer:   awsVPCPeer,
+		}
+
+		_, err := ctrl.Observe(t.Context(), conn)
+		require.ErrorIs(t, err, errPreconditionNotMet)
+	})
+
+	t.Run("Creates the AWS connection in the referenced VPC", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.AWSVPCPeeringConnection](t, "awsvpcpeeringconnection", "AWSVPCPeeringConnection")
+		conn.Namespace = "default"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			VpcGet(mock.Anything, "aiven-project-name", "vpc-id").
+			Return(newVpcGetOut(t, `[]`), nil).Once()
+
+		out := &vpc.VpcPeeringConnectionCreateOut{}
+		require.NoError(t, json.Unmarshal([]byte(`{"state": "APPROVED"}`), out))
+		avn.EXPECT().
+			VpcPeeringConnectionCreate(mock.Anything, "aiven-project-name", "vpc-id", mock.MatchedBy(func(in *vpc.VpcPeeringConnectionCreateIn) bool {
+				return in.PeerCloudAccount == "123456789012" &&
+					in.PeerVpc == "vpc-0a1b2c3d4e5f67890" &&
+					fromAnyPointer(in.PeerRegion) == "eu-west-1"
+			})).
+			Return(out, nil).Once()
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.AWSVPCPeeringConnection]{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newProjectVPC(t, "awsvpcpeeringconnection", "vpc-id")).Build(),
+			avnGen: avn,
+			peer:   awsVPCPeer,
+		}
+
+		obs, err := ctrl.Observe(t.Context(), conn)
+		require.NoError(t, err)
+		require.False(t, obs.ResourceExists)
+
+		_, err = ctrl.Create(t.Context(), conn)
+		require.NoError(t, err)
+		assert.Equal(t, "vpc-id", conn.Status.ProjectVPCID)
+		assert.Equal(t, "APPROVED", conn.Status.State)
+	})
+
+	t.Run("Exposes the AWS peering connection ID once active", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.AWSVPCPeeringConnection](t, "awsvpcpeeringconnection", "AWSVPCPeeringConnection")
+		conn.Namespace = "default"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			VpcGet(mock.Anything, "aiven-project-name", "vpc-id").
+			Return(newVpcGetOut(t, `[
+				{"peer_cloud_account": "123456789012", "peer_vpc": "vpc-0a1b2c3d4e5f67890", "peer_region": "eu-west-1", "state": "DELETED", "state_info": {}},
+				{"peer_cloud_account": "123456789012", "peer_vpc": "vpc-0a1b2c3d4e5f67890", "peer_region": "us-east-1", "state": "ACTIVE", "state_info": {}},
+				{"peer_cloud_account": "123456789012", "peer_vpc": "vpc-0a1b2c3d4e5f67890", "peer_region": "eu-west-1", "state": "ACTIVE",
+				 "state_info": {"message": "Peering connection active", "aws_vpc_peering_connection_id": "pcx-123"}}
+			]`), nil).Once()
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.AWSVPCPeeringConnection]{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newProjectVPC(t, "awsvpcpeeringconnection", "vpc-id")).Build(),
+			avnGen: avn,
+			peer:   awsVPCPeer,
+		}
+
+		obs, err := ctrl.Observe(t.Context(), conn)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		assert.Equal(t, "ACTIVE", conn.Status.State)
+		assert.Equal(t, "pcx-123", conn.Status.AWSVPCPeeringConnectionID)
+		assert.Equal(t, "true", conn.GetAnnotations()[instanceIsRunningAnnotation])
+		assert.Equal(t, SecretDetails{
+			"AWSVPCPEERINGCONNECTION_PROJECT_VPC_ID":                "vpc-id",
+			"AWSVPCPEERINGCONNECTION_STATE":                         "ACTIVE",
+			"AWSVPCPEERINGCONNECTION_AWS_VPC_PEERING_CONNECTION_ID": "pcx-123",
+			"AWSVPCPEERINGCONNECTION_AWS_ACCOUNT_ID":                "123456789012",
+			"AWSVPCPEERINGCONNECTION_AWS_VPC_ID":                    "vpc-0a1b2c3d4e5f67890",
+			"AWSVPCPEERINGCONNECTION_AWS_VPC_REGION":                "eu-west-1",
+		}, obs.SecretDetails)
+	})
+
+	t.Run("Builds the self link of the Aiven GCP network", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.GCPVPCPeeringConnection](t, "gcpvpcpeeringconnection", "GCPVPCPeeringConnection")
+		conn.Namespace = "default"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			VpcGet(mock.Anything, "aiven-project-name", "vpc-id").
+			Return(newVpcGetOut(t, `[
+				{"peer_cloud_account": "my-gcp-project", "peer_vpc": "my-gcp-network", "state": "PENDING_PEER",
+				 "state_info": {"to_project_id": "aiven-gcp-project", "to_vpc_network": "aiven-network"}}
+			]`), nil).Once()
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.GCPVPCPeeringConnection]{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newProjectVPC(t, "gcpvpcpeeringconnection", "vpc-id")).Build(),
+			avnGen: avn,
+			peer:   gcpVPCPeer,
+		}
+
+		obs, err := ctrl.Observe(t.Context(), conn)
+		require.NoError(t, err)
+		require.True(t, obs.ResourceExists)
+		assert.Equal(t, "PENDING_PEER", conn.Status.State)
+		assert.Equal(t, "https://www.googleapis.com/compute/v1/projects/aiven-gcp-project/global/networks/aiven-network", conn.Status.SelfLink)
+		assert.Equal(t, conn.Status.SelfLink, obs.SecretDetails["GCPVPCPEERINGCONNECTION_SELF_LINK"])
+		assert.NotContains(t, conn.GetAnnotations(), instanceIsRunningAnnotation)
+	})
+
+	t.Run("Reports an invalid Azure specification", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.AzureVPCPeeringConnection](t, "azurevpcpeeringconnection", "AzureVPCPeeringConnection")
+		conn.Namespace = "default"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			VpcGet(mock.Anything, "aiven-project-name", "vpc-id").
+			Return(newVpcGetOut(t, `[
+				{"peer_cloud_account": "00000000-0000-0000-0000-000000000001", "peer_vpc": "my-vnet", "peer_resource_group": "my-resource-group",
+				 "state": "INVALID_SPECIFICATION", "state_info": {"message": "VNet not found"}}
+			]`), nil).Once()
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.AzureVPCPeeringConnection]{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newProjectVPC(t, "azurevpcpeeringconnection", "vpc-id")).Build(),
+			avnGen: avn,
+			peer:   azureVPCPeer,
+		}
+
+		_, err := ctrl.Observe(t.Context(), conn)
+		require.NoError(t, err)
+		assert.Equal(t, "INVALID_SPECIFICATION", conn.Status.State)
+		assert.Equal(t, "VNet not found", conn.Status.StateMessage)
+
+		cond := meta.FindStatusCondition(conn.Status.Conditions, conditionTypeRunning)
+		require.NotNil(t, cond)
+		assert.Equal(t, "InvalidSpecification", cond.Reason)
+	})
+
+	t.Run("Deletes the Azure connection by resource group", func(t *testing.T) {
+		conn := newObjectFromExampleYAMLByKind[v1alpha1.AzureVPCPeeringConnection](t, "azurevpcpeeringconnection", "AzureVPCPeeringConnection")
+		conn.Status.ProjectVPCID = "vpc-id"
+
+		avn := avngen.NewMockClient(t)
+		avn.EXPECT().
+			VpcPeeringConnectionWithResourceGroupDelete(mock.Anything, "aiven-project-name", "vpc-id",
+				"00000000-0000-0000-0000-000000000001", "my-resource-group", "my-vnet").
+			Return(nil, newAivenError(404, "not found")).Once()
+
+		ctrl := &vpcPeeringConnectionController[*v1alpha1.AzureVPCPeeringConnection]{avnGen: avn, peer: azureVPCPeer}
+		require.NoError(t, ctrl.Delete(t.Context(), conn))
+	})
+}
+
+func TestFindVPCPeeringConnection(t *testing.T) {
+	t.Parallel()
+
+	var connections []vpc.PeeringConnectionOut
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"peer_cloud_account": "123456789012", "peer_vpc": "vpc-a", "peer_region": "eu-west-1", "state": "DELETED"},
+		{"peer_cloud_account": "123456789012", "peer_vpc": "vpc-a", "peer_region": "eu-west-1", "state": "ACTIVE"},
+		{"peer_cloud_account": "my-gcp-project", "peer_vpc": "my-network", "peer_region": "europe-west1", "state": "ACTIVE"},
+		{"peer_cloud_account": "subscription-id", "peer_vpc": "my-vnet", "peer_region": "westeurope", "peer_resource_group": "my-rg", "state": "ACTIVE"}
+	]`), &connections))
+
+	cases := []struct {
+		name     string
+		in       *vpc.VpcPeeringConnectionCreateIn
+		expected int
+	}{
+		{
+			name:     "AWS connection in the same region, skipping the deleted one",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "123456789012", PeerVpc: "vpc-a", PeerRegion: NilIfZero("eu-west-1")},
+			expected: 1,
+		},
+		{
+			name:     "AWS connection in another region",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "123456789012", PeerVpc: "vpc-a", PeerRegion: NilIfZero("us-east-1")},
+			expected: -1,
+		},
+		{
+			name:     "AWS connection without a region uses the one filled in by Aiven",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "123456789012", PeerVpc: "vpc-a"},
+			expected: 1,
+		},
+		{
+			name:     "GCP connection with the region filled in by Aiven",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "my-gcp-project", PeerVpc: "my-network"},
+			expected: 2,
+		},
+		{
+			name:     "Azure connection in the same resource group",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "subscription-id", PeerVpc: "my-vnet", PeerResourceGroup: NilIfZero("my-rg")},
+			expected: 3,
+		},
+		{
+			name:     "Azure connection in another resource group",
+			in:       &vpc.VpcPeeringConnectionCreateIn{PeerCloudAccount: "subscription-id", PeerVpc: "my-vnet", PeerResourceGroup: NilIfZero("other-rg")},
+			expected: -1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pc := findVPCPeeringConnection(connections, tc.in)
+			if tc.expected < 0 {
+				assert.Nil(t, pc)
+				return
+			}
+			assert.Same(t, &connections[tc.expected], pc)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var contextType = reflect.TypeFor[context.Context]()
+
+// generate returns the source of the wrapper methods of the interface.
+// Every method that takes a context passes it on labelled with the method name,
+// which is the Aiven API operation ID. Other methods are left to the embedded interface.
+func generate(iface reflect.Type, pkg, receiver string) ([]byte, error) {
+	imports := newImportSet()
+	imports.add(contextType.PkgPath())
+
+	var methods bytes.Buffer
+	for i := range iface.NumMethod() {
+		m := iface.Method(i)
+		if m.Type.NumIn() == 0 || m.Type.In(0) != contextType {
+			continue
+		}
+		writeMethod(&methods, imports, receiver, m)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by avngenmetrics generator. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+	src.WriteString("import (\n")
+	for _, p := range imports.paths() {
+		fmt.Fprintf(&src, "\t%s %q\n", imports.names[p], p)
+	}
+	src.WriteString(")\n")
+	src.Write(methods.Bytes())
+
+	b, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return b, nil
+}
+
+func writeMethod(w *bytes.Buffer, imports *importSet, receiver string, m reflect.Method) {
+	t := m.Type
+
+	params := make([]string, t.NumIn())
+	args := make([]string, t.NumIn())
+	params[0], args[0] = "ctx "+imports.typeName(contextType), fmt.Sprintf("withAPIOperation(ctx, %q)", m.Name)
+	for i := 1; i < t.NumIn(); i++ {
+		name := fmt.Sprintf("p%d", i)
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			params[i] = name + " ..." + imports.typeName(t.In(i).Elem())
+			args[i] = name + "..."
+		} else {
+			params[i] = name + " " + imports.typeName(t.In(i))
+			args[i] = name
+		}
+	}
+
+	results := make([]string, t.NumOut())
+	for i := range t.NumOut() {
+		results[i] = imports.typeName(t.Out(i))
+	}
+
+	fmt.Fprintf(w, "\nfunc (c *%s) %s(%s) ", receiver, m.Name, strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+	case 1:
+		w.WriteString(results[0] + " ")
+	default:
+		fmt.Fprintf(w, "(%s) ", strings.Join(results, ", "))
+	}
+
+	call := fmt.Sprintf("c.Client.%s(%s)", m.Name, strings.Join(args, ", "))
+	if len(results) == 0 {
+		fmt.Fprintf(w, "{\n\t%s\n}\n", call)
+	} else {
+		fmt.Fprintf(w, "{\n\treturn %s\n}\n", call)
+	}
+}
+
+// importSet names the imported packages, the handler packages of avngen share a few names.
+type importSet struct {
+	names map[string]string
+	taken map[string]bool
+}
+
+func newImportSet() *importSet {
+	return &importSet{names: make(map[string]string), taken: make(map[string]bool)}
+}
+
+func (s *importSet) add(pkgPath string) string {
+	if name, ok := s.names[pkgPath]; ok {
+		return name
+	}
+
+	base := strings.NewReplacer("-", "", ".", "").Replace(path.Base(pkgPath))
+	name := base
+	for i := 2; s.taken[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	s.names[pkgPath] = name
+	s.taken[name] = true
+	return name
+}
+
+func (s *importSet) paths() []string {
+	result := make([]string, 0, len(s.names))
+	for p := range s.names {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// typeName returns the type as written in the generated file, adding the imports it needs.
+func (s *importSet) typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+		return s.add(t.PkgPath()) + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + s.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + s.typeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), s.typeName(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", s.typeName(t.Key()), s.typeName(t.Elem()))
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "any"
+		}
+	}
+
+	// Unnamed structs, funcs and channels don't appear in the client signatures.
+	return t.String()
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClient interface {
+	ServiceGet(ctx context.Context, project, serviceName string, query ...[2]string) (*http.Request, error)
+	ServiceList(ctx context.Context, project string) ([]http.Header, error)
+	ServiceDelete(ctx context.Context, project, serviceName string) error
+	Tags(ctx context.Context, in map[string]any) (map[string]string, []byte, error)
+	Close()
+}
+
+func TestGenerate(t *testing.T) {
+	b, err := generate(reflect.TypeFor[testClient](), "controllers", "metricsClient")
+	require.NoError(t, err)
+
+	expected := `// Code generated by avngenmetrics generator. DO NOT EDIT.
+
+package controllers
+
+import (
+	context "context"
+	http "net/http"
+)
+
+func (c *metricsClient) ServiceDelete(ctx context.Context, p1 string, p2 string) error {
+	return c.Client.ServiceDelete(withAPIOperation(ctx, "ServiceDelete"), p1, p2)
+}
+
+func (c *metricsClient) ServiceGet(ctx context.Context, p1 string, p2 string, p3 ...[2]string) (*http.Request, error) {
+	return c.Client.ServiceGet(withAPIOperation(ctx, "ServiceGet"), p1, p2, p3...)
+}
+
+func (c *metricsClient) ServiceList(ctx context.Context, p1 string) ([]http.Header, error) {
+	return c.Client.ServiceList(withAPIOperation(ctx, "ServiceList"), p1)
+}
+
+func (c *metricsClient) Tags(ctx context.Context, p1 map[string]any) (map[string]string, []uint8, error) {
+	return c.Client.Tags(withAPIOperation(ctx, "Tags"), p1)
+}
+`
+	assert.Equal(t, expected, string(b), "methods without a context are left to the embedded client")
+}
@@ -0,0 +1,28 @@
+// Command avngenmetrics generates the methods of the controllers metricsClient,
+// which label every avngen.Client call with its Aiven API operation ID.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"reflect"
+
+	avngen "github.com/aiven/go-client-codegen"
+)
+
+func main() {
+	var output string
+	flag.StringVar(&output, "output", "zz_generated.metrics_client.go", "File to write the generated methods to")
+	flag.Parse()
+
+	b, err := generate(reflect.TypeFor[avngen.Client](), "controllers", "metricsClient")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = os.WriteFile(output, b, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
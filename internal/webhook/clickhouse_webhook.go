@@ -48,14 +48,15 @@ func (h *ClickhouseWebhook) ValidateCreate(_ context.Context, obj runtime.Object
 	in := obj.(*v1alpha1.Clickhouse)
 	clickhouselog.Info("validate create", "name", in.Name)
 
-	return nil, in.Spec.Validate()
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateFork(false))
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
-func (h *ClickhouseWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+func (h *ClickhouseWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.Clickhouse)
 	clickhouselog.Info("validate update", "name", in.Name)
-	return nil, in.Spec.Validate()
+	old := oldObj.(*v1alpha1.Clickhouse)
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateForkUpdate(&old.Spec.ServiceForkFields))
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
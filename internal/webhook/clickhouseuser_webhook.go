@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Aiven, Helsinki, Finland. https://aiven.io/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var clickhouseuserlog = logf.Log.WithName("clickhouseuser-resource")
+
+func SetupClickhouseUserWebhook(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.ClickhouseUser{}).
+		WithValidator(&ClickhouseUserWebhook{}).
+		Complete()
+}
+
+type ClickhouseUserWebhook struct{}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-clickhouseuser,mutating=false,failurePolicy=fail,groups=aiven.io,resources=clickhouseusers,versions=v1alpha1,name=vclickhouseuser.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &ClickhouseUserWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (h *ClickhouseUserWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	in := obj.(*v1alpha1.ClickhouseUser)
+	clickhouseuserlog.Info("validate create", "name", in.Name)
+
+	return nil, in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (h *ClickhouseUserWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	in := newObj.(*v1alpha1.ClickhouseUser)
+	clickhouseuserlog.Info("validate update", "name", in.Name)
+	return nil, in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (h *ClickhouseUserWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -51,14 +51,14 @@ func (h *ConnectionPoolWebhook) ValidateCreate(_ context.Context, obj runtime.Ob
 	in := obj.(*v1alpha1.ConnectionPool)
 	connectionpoollog.Info("validate create", "name", in.Name)
 
-	return nil, nil
+	return nil, in.Spec.ConnInfoSecretTarget.Validate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
 func (h *ConnectionPoolWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.ConnectionPool)
 	connectionpoollog.Info("validate update", "name", in.Name)
-	return nil, nil
+	return nil, in.Spec.ConnInfoSecretTarget.Validate()
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
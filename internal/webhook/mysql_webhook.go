@@ -48,15 +48,16 @@ func (h *MySQLWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (ad
 	in := obj.(*v1alpha1.MySQL)
 	mysqllog.Info("validate create", "name", in.Name)
 
-	return nil, in.Spec.Validate()
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateFork(true))
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
-func (h *MySQLWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+func (h *MySQLWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.MySQL)
 	mysqllog.Info("validate update", "name", in.Name)
 
-	return nil, in.Spec.Validate()
+	old := oldObj.(*v1alpha1.MySQL)
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateForkUpdate(&old.Spec.ServiceForkFields))
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
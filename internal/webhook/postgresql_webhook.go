@@ -48,15 +48,16 @@ func (h *PostgreSQLWebhook) ValidateCreate(_ context.Context, obj runtime.Object
 	in := obj.(*v1alpha1.PostgreSQL)
 	pglog.Info("validate create", "name", in.Name)
 
-	return nil, in.Spec.Validate()
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateFork(true))
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
-func (h *PostgreSQLWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+func (h *PostgreSQLWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.PostgreSQL)
 	pglog.Info("validate update", "name", in.Name)
 
-	return nil, in.Spec.Validate()
+	old := oldObj.(*v1alpha1.PostgreSQL)
+	return nil, errors.Join(in.Spec.Validate(), in.Spec.ValidateForkUpdate(&old.Spec.ServiceForkFields))
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
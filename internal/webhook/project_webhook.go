@@ -50,14 +50,14 @@ func (h *ProjectWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (
 	in := obj.(*v1alpha1.Project)
 	projectlog.Info("validate create", "name", in.Name)
 
-	return nil, nil
+	return nil, in.Spec.ConnInfoSecretTarget.Validate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
 func (h *ProjectWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.Project)
 	projectlog.Info("validate update", "name", in.Name)
-	return nil, nil
+	return nil, in.Spec.ConnInfoSecretTarget.Validate()
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
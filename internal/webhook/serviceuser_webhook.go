@@ -47,14 +47,14 @@ func (h *ServiceUserWebhook) ValidateCreate(_ context.Context, obj runtime.Objec
 	in := obj.(*v1alpha1.ServiceUser)
 	serviceuserlog.Info("validate create", "name", in.Name)
 
-	return nil, nil
+	return nil, in.Spec.Validate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
 func (h *ServiceUserWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
 	in := newObj.(*v1alpha1.ServiceUser)
 	serviceuserlog.Info("validate update", "name", in.Name)
-	return nil, nil
+	return nil, in.Spec.Validate()
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
@@ -64,6 +64,12 @@ func SetupWebhooks(mgr ctrl.Manager) error {
 	if err := SetupValkeyWebhook(mgr); err != nil {
 		return fmt.Errorf("webhook Valkey: %w", err)
 	}
+	if err := SetupClickhouseUserWebhook(mgr); err != nil {
+		return fmt.Errorf("webhook ClickhouseUser: %w", err)
+	}
+	if err := SetupOrganizationProjectWebhook(mgr); err != nil {
+		return fmt.Errorf("webhook OrganizationProject: %w", err)
+	}
 
 	//+kubebuilder:scaffold:builder
 	return nil
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -53,6 +54,11 @@ func main() {
 	var enableLeaderElection bool
 	var probeAddr string
 	var development bool
+	var dryRun bool
+	var adoptionPolicy string
+	var adoptionPolicyOverrides string
+	var aivenAPI controllers.AivenAPIOptions
+	var tokenProviderOpts controllers.TokenProviderOptions
 	var webhookPort int
 	flag.IntVar(&webhookPort, "webhook-port", webhookDefaultPort, "Webhook server port (default: 9443)")
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -61,6 +67,30 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&development, "development", true, "Configures the logger to use a development config (stacktraces on warnings, no sampling)")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"Plan changes to Aiven resources without applying them. "+
+			"Can be overridden per object with the controllers.aiven.io/dry-run annotation.")
+	flag.StringVar(&adoptionPolicy, "adoption-policy", "Adopt",
+		"What to do when a resource already exists at Aiven: Adopt, AdoptIfMatches or Fail. "+
+			"Can be overridden per object with the controllers.aiven.io/adoption-policy annotation.")
+	flag.StringVar(&adoptionPolicyOverrides, "adoption-policy-overrides", "",
+		"Comma-separated Kind=Policy pairs that override --adoption-policy for specific kinds, e.g. KafkaTopic=Fail.")
+	flag.Float64Var(&aivenAPI.RateLimit, "aiven-api-rate-limit", 10,
+		"Aiven API requests per second allowed per token, 0 to disable the limit.")
+	flag.IntVar(&aivenAPI.Burst, "aiven-api-burst", 20, "Aiven API requests per token that can be sent at once.")
+	flag.DurationVar(&aivenAPI.CacheTTL, "aiven-api-cache-ttl", 5*time.Second,
+		"How long the responses of idempotent Aiven API reads are shared between reconciles, 0 to disable the cache.")
+	flag.StringVar(&tokenProviderOpts.File, "token-file", "",
+		"File with the Aiven token, re-read when it changes. Used instead of DEFAULT_AIVEN_TOKEN.")
+	flag.StringVar(&tokenProviderOpts.ExecCommand, "token-exec-command", "",
+		"Command that prints the Aiven token, or a JSON object with \"token\" and \"expiresAt\". Used instead of DEFAULT_AIVEN_TOKEN.")
+	flag.StringVar(&tokenProviderOpts.ExchangeURL, "token-exchange-url", "",
+		"OAuth 2.0 token exchange endpoint that exchanges the service account token for an Aiven token. Used instead of DEFAULT_AIVEN_TOKEN.")
+	flag.StringVar(&tokenProviderOpts.ExchangeAudience, "token-exchange-audience", "", "Audience requested from --token-exchange-url.")
+	flag.StringVar(&tokenProviderOpts.ServiceAccountTokenFile, "service-account-token-file", "/var/run/secrets/aiven.io/serviceaccount/token",
+		"Projected service account token sent to --token-exchange-url.")
+	flag.DurationVar(&tokenProviderOpts.TTL, "token-ttl", 5*time.Minute,
+		"How long a token from --token-exec-command or --token-exchange-url is used when its expiration is unknown.")
 
 	opts := zap.Options{
 		Development: development,
@@ -140,8 +170,37 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := controllers.ValidateAdoptionPolicy(adoptionPolicy); err != nil {
+		setupLog.Error(err, "invalid --adoption-policy")
+		os.Exit(1)
+	}
+	adoptionPolicies, err := controllers.ParseAdoptionPolicies(adoptionPolicyOverrides)
+	if err != nil {
+		setupLog.Error(err, "invalid --adoption-policy-overrides")
+		os.Exit(1)
+	}
+
 	defaultToken := os.Getenv("DEFAULT_AIVEN_TOKEN")
-	err = controllers.SetupControllers(mgr, defaultToken, kubeVersion.String(), operatorVersion)
+	tokenProvider, err := controllers.NewTokenProvider(tokenProviderOpts)
+	if err != nil {
+		setupLog.Error(err, "invalid token provider")
+		os.Exit(1)
+	}
+	if tokenProvider != nil && defaultToken != "" {
+		setupLog.Error(nil, "DEFAULT_AIVEN_TOKEN can't be used together with a token provider")
+		os.Exit(1)
+	}
+
+	err = controllers.SetupControllersWithConfig(mgr, controllers.SetupConfig{
+		DefaultToken:     defaultToken,
+		TokenProvider:    tokenProvider,
+		KubeVersion:      kubeVersion.String(),
+		OperatorVersion:  operatorVersion,
+		DryRun:           dryRun,
+		AdoptionPolicy:   adoptionPolicy,
+		AdoptionPolicies: adoptionPolicies,
+		AivenAPI:         &aivenAPI,
+	})
 	if err != nil {
 		setupLog.Error(err, "controllers setup error")
 	}
@@ -0,0 +1,251 @@
+package mysqlutils
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type StatementType string
+
+const (
+	GRANT  StatementType = "GRANT"
+	REVOKE StatementType = "REVOKE"
+)
+
+// AllPrivileges is how SHOW GRANTS lists the ALL privilege
+const AllPrivileges = "ALL PRIVILEGES"
+
+// ManagedPrivileges are the privileges of the MySQLPrivilegeGrant.Privileges enum, the way SHOW GRANTS lists them, keep them in sync.
+// Other privileges, like the dynamic privileges Aiven grants to the service users, are never revoked.
+var ManagedPrivileges = []string{
+	AllPrivileges, "ALTER", "ALTER ROUTINE", "CREATE", "CREATE ROUTINE", "CREATE TEMPORARY TABLES", "CREATE VIEW",
+	"DELETE", "DROP", "EVENT", "EXECUTE", "INDEX", "INSERT", "LOCK TABLES", "REFERENCES", "SELECT", "SHOW VIEW",
+	"TRIGGER", "UPDATE",
+}
+
+// Grant is a parsed line of SHOW GRANTS, i.e. GRANT SELECT, INSERT ON `db`.* TO `user`@`%` WITH GRANT OPTION
+type Grant struct {
+	Privileges      []string
+	Database        string
+	Table           string
+	WithGrantOption bool
+}
+
+// ParseGrant parses a privilege grant line of SHOW GRANTS.
+// Returns false for role grants and lines it doesn't recognize.
+// Column privileges are skipped, only database and table privileges are managed.
+func ParseGrant(line string) (Grant, bool) {
+	rest, ok := strings.CutPrefix(line, "GRANT ")
+	if !ok {
+		return Grant{}, false
+	}
+
+	privileges, rest, ok := cutOutsideQuotes(rest, " ON ")
+	if !ok {
+		// A role grant: GRANT `role`@`%` TO `user`@`%`
+		return Grant{}, false
+	}
+
+	object, rest, ok := cutOutsideQuotes(rest, " TO ")
+	if !ok {
+		return Grant{}, false
+	}
+
+	database, table, ok := parseObject(object)
+	if !ok {
+		return Grant{}, false
+	}
+
+	g := Grant{
+		Database:        database,
+		Table:           table,
+		WithGrantOption: strings.HasSuffix(rest, " WITH GRANT OPTION"),
+	}
+	// MySQL 8 joins the dynamic privileges with a comma without a space, i.e. SELECT, INSERT, BACKUP_ADMIN,XA_RECOVER_ADMIN
+	for _, p := range splitOutsideQuotes(privileges, ",") {
+		p = strings.TrimSpace(p)
+		if strings.Contains(p, "(") {
+			continue
+		}
+		g.Privileges = append(g.Privileges, p)
+	}
+	return g, true
+}
+
+// ObjectGrant merges the grants on the database and table into one, with the privileges normalized.
+// An empty table is all tables of the database.
+func ObjectGrant(grants []Grant, database, table string) Grant {
+	if table == "" {
+		table = "*"
+	}
+	merged := Grant{Database: database, Table: table}
+	for _, g := range grants {
+		if g.Database != database || g.Table != table {
+			continue
+		}
+		merged.Privileges = append(merged.Privileges, g.Privileges...)
+		merged.WithGrantOption = merged.WithGrantOption || g.WithGrantOption
+	}
+	merged.Privileges = normalizePrivileges(merged.Privileges)
+	return merged
+}
+
+// GrantStatements returns the REVOKE statement of the ManagedPrivileges and the grant option the account has on the object
+// but the desired grant doesn't, and the GRANT statement of the desired grant if anything is missing.
+// The statements are empty when the grants are equal. Both grants must be built by ObjectGrant.
+func GrantStatements(user, host string, actual, desired Grant) (revoke, grant string) {
+	on := QuoteObject(desired.Database, desired.Table)
+	account := QuoteAccount(user, host)
+
+	var extra []string
+	for _, p := range actual.Privileges {
+		// ALL PRIVILEGES covers the privileges granted one by one
+		if slices.Contains(ManagedPrivileges, p) && !slices.Contains(desired.Privileges, p) && !slices.Contains(desired.Privileges, AllPrivileges) {
+			extra = append(extra, p)
+		}
+	}
+	if actual.WithGrantOption && !desired.WithGrantOption {
+		extra = append(extra, "GRANT OPTION")
+	}
+	if len(extra) > 0 {
+		revoke = fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(extra, ", "), on, account)
+	}
+
+	missing := desired.WithGrantOption && !actual.WithGrantOption
+	for _, p := range desired.Privileges {
+		missing = missing || !slices.Contains(actual.Privileges, p)
+	}
+	if missing && len(desired.Privileges) > 0 {
+		grant = fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(desired.Privileges, ", "), on, account)
+		if desired.WithGrantOption {
+			grant += " WITH GRANT OPTION"
+		}
+	}
+	return revoke, grant
+}
+
+// normalizePrivileges returns the sorted privileges without duplicates.
+// ALL PRIVILEGES includes the other privileges and USAGE means no privileges.
+func normalizePrivileges(privileges []string) []string {
+	result := make([]string, 0, len(privileges))
+	for _, p := range privileges {
+		p = NormalizePrivilege(p)
+		if p == AllPrivileges {
+			return []string{AllPrivileges}
+		}
+		if p != "USAGE" && !slices.Contains(result, p) {
+			result = append(result, p)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// NormalizePrivilege returns the privilege the way SHOW GRANTS lists it
+func NormalizePrivilege(p string) string {
+	p = strings.ToUpper(strings.TrimSpace(p))
+	if p == "ALL" {
+		return AllPrivileges
+	}
+	return p
+}
+
+// ApplyGrants runs the REVOKE and then the GRANT statements.
+// MySQL commits GRANT and REVOKE implicitly, so the statements can't run in a transaction.
+// REVOKE statements of users and grants that no longer exist are skipped.
+func ApplyGrants(ctx context.Context, db *sql.DB, revokes, grants []string) error {
+	for _, stmt := range revokes {
+		_, err := db.ExecContext(ctx, stmt)
+		if err != nil && !hasErrorNumber(err, errNonexistingGrant, errNonexistingTableGrant, errNoSuchTable) {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+
+	for _, stmt := range grants {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+// parseObject parses the privilege level, i.e. *.*, `db`.* or `db`.`table`
+func parseObject(object string) (string, string, bool) {
+	database, rest, ok := parseIdentifier(object)
+	if !ok || !strings.HasPrefix(rest, ".") {
+		return "", "", false
+	}
+	table, rest, ok := parseIdentifier(rest[1:])
+	if !ok || rest != "" {
+		return "", "", false
+	}
+	return database, table, true
+}
+
+// parseIdentifier returns the unquoted identifier or the asterisk, and the rest of the string
+func parseIdentifier(s string) (string, string, bool) {
+	if strings.HasPrefix(s, "*") {
+		return "*", s[1:], true
+	}
+	if !strings.HasPrefix(s, "`") {
+		return "", "", false
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '`' {
+			b.WriteByte(s[i])
+			continue
+		}
+		// Doubled backtick is an escaped backtick
+		if i+1 < len(s) && s[i+1] == '`' {
+			b.WriteByte('`')
+			i++
+			continue
+		}
+		return b.String(), s[i+1:], true
+	}
+	return "", "", false
+}
+
+// cutOutsideQuotes is strings.Cut that skips separators in quoted identifiers and column lists
+func cutOutsideQuotes(s, sep string) (string, string, bool) {
+	if i := indexOutsideQuotes(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+func splitOutsideQuotes(s, sep string) []string {
+	var parts []string
+	for {
+		i := indexOutsideQuotes(s, sep)
+		if i < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:i])
+		s = s[i+len(sep):]
+	}
+}
+
+func indexOutsideQuotes(s, sep string) int {
+	quoted := false
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '`':
+			quoted = !quoted
+		case quoted:
+		case s[i] == '(':
+			depth++
+		case s[i] == ')':
+			depth--
+		case depth == 0 && strings.HasPrefix(s[i:], sep):
+			return i
+		}
+	}
+	return -1
+}
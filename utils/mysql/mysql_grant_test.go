@@ -0,0 +1,149 @@
+package mysqlutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrant(t *testing.T) {
+	cases := []struct {
+		line     string
+		expected Grant
+		ok       bool
+	}{
+		{
+			line:     "GRANT USAGE ON *.* TO `app`@`%`",
+			expected: Grant{Privileges: []string{"USAGE"}, Database: "*", Table: "*"},
+			ok:       true,
+		},
+		{
+			line:     "GRANT SELECT, INSERT, CREATE TEMPORARY TABLES ON `shop`.* TO `app`@`%` WITH GRANT OPTION",
+			expected: Grant{Privileges: []string{"SELECT", "INSERT", "CREATE TEMPORARY TABLES"}, Database: "shop", Table: "*", WithGrantOption: true},
+			ok:       true,
+		},
+		{
+			line:     "GRANT ALL PRIVILEGES ON `we``ird`.`orders` TO `app`@`10.0.0.%`",
+			expected: Grant{Privileges: []string{"ALL PRIVILEGES"}, Database: "we`ird", Table: "orders"},
+			ok:       true,
+		},
+		{
+			// Column privileges are skipped
+			line:     "GRANT SELECT (`id`, `name`), UPDATE ON `shop`.`users` TO `app`@`%`",
+			expected: Grant{Privileges: []string{"UPDATE"}, Database: "shop", Table: "users"},
+			ok:       true,
+		},
+		{
+			// MySQL 8 lists the dynamic privileges without a space after the comma
+			line:     "GRANT BACKUP_ADMIN,REPLICATION_SLAVE_ADMIN,XA_RECOVER_ADMIN ON *.* TO `app`@`%`",
+			expected: Grant{Privileges: []string{"BACKUP_ADMIN", "REPLICATION_SLAVE_ADMIN", "XA_RECOVER_ADMIN"}, Database: "*", Table: "*"},
+			ok:       true,
+		},
+		{
+			line: "GRANT `reader`@`%` TO `app`@`%`",
+			ok:   false,
+		},
+		{
+			line: "REVOKE SELECT ON `shop`.* FROM `app`@`%`",
+			ok:   false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.line, func(t *testing.T) {
+			g, ok := ParseGrant(c.line)
+			assert.Equal(t, c.ok, ok)
+			if c.ok {
+				assert.Equal(t, c.expected, g)
+			}
+		})
+	}
+}
+
+func TestObjectGrant(t *testing.T) {
+	grants := []Grant{
+		{Privileges: []string{"USAGE"}, Database: "*", Table: "*"},
+		{Privileges: []string{"SELECT", "INSERT"}, Database: "shop", Table: "*"},
+		{Privileges: []string{"DELETE"}, Database: "shop", Table: "*", WithGrantOption: true},
+		{Privileges: []string{"SELECT", "ALL PRIVILEGES"}, Database: "shop", Table: "orders"},
+	}
+
+	assert.Equal(t, Grant{Database: "*", Table: "*", Privileges: []string{}}, ObjectGrant(grants, "*", "*"))
+	assert.Equal(t, Grant{Database: "shop", Table: "*", Privileges: []string{"DELETE", "INSERT", "SELECT"}, WithGrantOption: true}, ObjectGrant(grants, "shop", ""))
+	assert.Equal(t, Grant{Database: "shop", Table: "orders", Privileges: []string{"ALL PRIVILEGES"}}, ObjectGrant(grants, "shop", "orders"))
+	assert.Equal(t, Grant{Database: "other", Table: "*", Privileges: []string{}}, ObjectGrant(grants, "other", ""))
+}
+
+func TestGrantStatements(t *testing.T) {
+	grant := func(withGrantOption bool, privileges ...string) Grant {
+		return ObjectGrant([]Grant{{Privileges: privileges, Database: "shop", Table: "*", WithGrantOption: withGrantOption}}, "shop", "")
+	}
+
+	cases := []struct {
+		name            string
+		actual, desired Grant
+		revoke, grant   string
+	}{
+		{
+			name:    "Equal",
+			actual:  grant(false, "SELECT", "INSERT"),
+			desired: grant(false, "insert", "select"),
+		},
+		{
+			name:    "Missing privileges",
+			actual:  grant(false, "SELECT"),
+			desired: grant(false, "SELECT", "INSERT"),
+			grant:   "GRANT INSERT, SELECT ON `shop`.* TO `app`@`%`",
+		},
+		{
+			name:    "Extra privileges",
+			actual:  grant(false, "SELECT", "INSERT", "DELETE"),
+			desired: grant(false, "SELECT"),
+			revoke:  "REVOKE DELETE, INSERT ON `shop`.* FROM `app`@`%`",
+		},
+		{
+			name:    "Extra grant option",
+			actual:  grant(true, "SELECT"),
+			desired: grant(false, "SELECT"),
+			revoke:  "REVOKE GRANT OPTION ON `shop`.* FROM `app`@`%`",
+		},
+		{
+			name:    "Missing grant option",
+			actual:  grant(false, "SELECT"),
+			desired: grant(true, "SELECT"),
+			grant:   "GRANT SELECT ON `shop`.* TO `app`@`%` WITH GRANT OPTION",
+		},
+		{
+			name:    "ALL replaced with a privilege",
+			actual:  grant(false, "ALL PRIVILEGES"),
+			desired: grant(false, "SELECT"),
+			revoke:  "REVOKE ALL PRIVILEGES ON `shop`.* FROM `app`@`%`",
+			grant:   "GRANT SELECT ON `shop`.* TO `app`@`%`",
+		},
+		{
+			name:    "Privileges replaced with ALL",
+			actual:  grant(false, "SELECT"),
+			desired: grant(false, "ALL"),
+			grant:   "GRANT ALL PRIVILEGES ON `shop`.* TO `app`@`%`",
+		},
+		{
+			name:    "Privileges outside the enum are kept",
+			actual:  grant(false, "SELECT", "SHOW DATABASES", "REPLICATION_SLAVE_ADMIN"),
+			desired: grant(false, "SELECT"),
+		},
+		{
+			name:    "Removed from the manifest",
+			actual:  grant(true, "SELECT"),
+			desired: grant(false),
+			revoke:  "REVOKE SELECT, GRANT OPTION ON `shop`.* FROM `app`@`%`",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			revoke, grant := GrantStatements("app", "%", c.actual, c.desired)
+			assert.Equal(t, c.revoke, revoke)
+			assert.Equal(t, c.grant, grant)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package pgutils
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type StatementType string
+
+const (
+	GRANT  StatementType = "GRANT"
+	REVOKE StatementType = "REVOKE"
+)
+
+// ApplyGrants runs the REVOKE and then the GRANT statements in a single transaction,
+// so the grantees never lose the privileges that are kept.
+// REVOKE statements of roles and objects that no longer exist are skipped.
+func ApplyGrants(ctx context.Context, db *sql.DB, revokes, grants []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	for _, stmt := range revokes {
+		if err := execIgnoringMissing(ctx, tx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+
+	for _, stmt := range grants {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// execIgnoringMissing runs the statement in a savepoint, a failed statement would abort the whole transaction otherwise
+func execIgnoringMissing(ctx context.Context, tx *sql.Tx, stmt string) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT statement"); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		if !IsMissingObject(err) {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT statement")
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT statement")
+	return err
+}
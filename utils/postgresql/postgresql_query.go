@@ -0,0 +1,193 @@
+package pgutils
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/lib/pq"
+)
+
+// ErrDatabaseNotFound is returned by Open when the database doesn't exist yet
+var ErrDatabaseNotFound = errors.New("database does not exist")
+
+// Error codes of objects that don't exist, [see](https://www.postgresql.org/docs/current/errcodes-appendix.html)
+var missingObjectCodes = []pq.ErrorCode{
+	"3D000", // invalid_catalog_name
+	"3F000", // invalid_schema_name
+	"42704", // undefined_object
+	"42P01", // undefined_table
+	"42883", // undefined_function
+}
+
+// Open connects to the database of the service with the credentials of the URI
+func Open(ctx context.Context, uri *url.URL, database string) (*sql.DB, error) {
+	u := *uri
+	u.Path = "/" + database
+
+	db, err := sql.Open("postgres", u.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "3D000" {
+			return nil, fmt.Errorf("%w: %s", ErrDatabaseNotFound, database)
+		}
+		return nil, fmt.Errorf("PostgreSQL connection error: %w", err)
+	}
+	return db, nil
+}
+
+// IsMissingObject returns true if the statement failed because a role, schema or other object doesn't exist
+func IsMissingObject(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	for _, code := range missingObjectCodes {
+		if pqErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func QuoteIdentifier(name string) string {
+	return pq.QuoteIdentifier(name)
+}
+
+func QuoteIdentifiers(names []string) []string {
+	result := make([]string, len(names))
+	for i, v := range names {
+		result[i] = QuoteIdentifier(v)
+	}
+	return result
+}
+
+// Extension is an installed extension
+type Extension struct {
+	Version string
+	Schema  string
+}
+
+// GetExtension returns the installed extension, nil if it's not installed
+func GetExtension(ctx context.Context, db *sql.DB, name string) (*Extension, error) {
+	const query = `SELECT e.extversion, n.nspname FROM pg_extension e JOIN pg_namespace n ON n.oid = e.extnamespace WHERE e.extname = $1`
+
+	e := new(Extension)
+	err := db.QueryRowContext(ctx, query, name).Scan(&e.Version, &e.Schema)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// IsExtensionAvailable returns true if the extension can be installed in the database
+func IsExtensionAvailable(ctx context.Context, db *sql.DB, name string) (bool, error) {
+	var available bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = $1)`, name).Scan(&available)
+	return available, err
+}
+
+// CreateExtension installs the extension, the schema and version are optional
+func CreateExtension(ctx context.Context, db *sql.DB, name, schema, version string) error {
+	stmt := "CREATE EXTENSION IF NOT EXISTS " + QuoteIdentifier(name)
+	if schema != "" {
+		stmt += " SCHEMA " + QuoteIdentifier(schema)
+	}
+	if version != "" {
+		stmt += " VERSION " + pq.QuoteLiteral(version)
+	}
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+// AlterExtension moves the extension to the schema and updates it to the version, if they are set
+func AlterExtension(ctx context.Context, db *sql.DB, name, schema, version string) error {
+	if schema != "" {
+		if _, err := db.ExecContext(ctx, "ALTER EXTENSION "+QuoteIdentifier(name)+" SET SCHEMA "+QuoteIdentifier(schema)); err != nil {
+			return err
+		}
+	}
+	if version != "" {
+		if _, err := db.ExecContext(ctx, "ALTER EXTENSION "+QuoteIdentifier(name)+" UPDATE TO "+pq.QuoteLiteral(version)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DropExtension(ctx context.Context, db *sql.DB, name string) error {
+	_, err := db.ExecContext(ctx, "DROP EXTENSION IF EXISTS "+QuoteIdentifier(name))
+	return err
+}
+
+// GetSchemaOwner returns the owner of the schema, false if the schema doesn't exist
+func GetSchemaOwner(ctx context.Context, db *sql.DB, name string) (string, bool, error) {
+	var owner string
+	err := db.QueryRowContext(ctx, `SELECT pg_get_userbyid(nspowner) FROM pg_namespace WHERE nspname = $1`, name).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return owner, true, nil
+}
+
+// CreateSchema creates the schema, owned by the current user if the owner isn't set
+func CreateSchema(ctx context.Context, db *sql.DB, name, owner string) error {
+	stmt := "CREATE SCHEMA IF NOT EXISTS " + QuoteIdentifier(name)
+	if owner != "" {
+		stmt += " AUTHORIZATION " + QuoteIdentifier(owner)
+	}
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+func AlterSchemaOwner(ctx context.Context, db *sql.DB, name, owner string) error {
+	_, err := db.ExecContext(ctx, "ALTER SCHEMA "+QuoteIdentifier(name)+" OWNER TO "+QuoteIdentifier(owner))
+	return err
+}
+
+// DropSchema drops the schema. Fails if the schema isn't empty
+func DropSchema(ctx context.Context, db *sql.DB, name string) error {
+	_, err := db.ExecContext(ctx, "DROP SCHEMA IF EXISTS "+QuoteIdentifier(name))
+	return err
+}
+
+// QueryRoles returns the names of all roles
+func QueryRoles(ctx context.Context, db *sql.DB) ([]string, error) {
+	return queryNames(ctx, db, "SELECT rolname FROM pg_roles")
+}
+
+// QuerySchemas returns the names of all schemas of the database
+func QuerySchemas(ctx context.Context, db *sql.DB) ([]string, error) {
+	return queryNames(ctx, db, "SELECT nspname FROM pg_namespace")
+}
+
+func queryNames(ctx context.Context, db *sql.DB, query string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
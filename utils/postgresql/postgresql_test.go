@@ -0,0 +1,127 @@
+package pgutils
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDatabase connects to the local PostgreSQL from POSTGRESQL_TEST_URI, see "task test:postgresql"
+func openTestDatabase(t *testing.T) (context.Context, *url.URL) {
+	t.Helper()
+	raw := os.Getenv("POSTGRESQL_TEST_URI")
+	if raw == "" {
+		t.Skip("POSTGRESQL_TEST_URI is not set")
+	}
+	uri, err := url.Parse(raw)
+	require.NoError(t, err)
+	return context.Background(), uri
+}
+
+func TestOpenMissingDatabase(t *testing.T) {
+	ctx, uri := openTestDatabase(t)
+	_, err := Open(ctx, uri, "does_not_exist")
+	assert.ErrorIs(t, err, ErrDatabaseNotFound)
+}
+
+func TestExtension(t *testing.T) {
+	ctx, uri := openTestDatabase(t)
+	db, err := Open(ctx, uri, "postgres")
+	require.NoError(t, err)
+	defer db.Close()
+
+	const name = "pg_trgm"
+	require.NoError(t, DropExtension(ctx, db, name))
+	require.NoError(t, CreateSchema(ctx, db, "ext_test", ""))
+	defer DropSchema(ctx, db, "ext_test") //nolint:errcheck
+
+	ext, err := GetExtension(ctx, db, name)
+	require.NoError(t, err)
+	assert.Nil(t, ext)
+
+	available, err := IsExtensionAvailable(ctx, db, name)
+	require.NoError(t, err)
+	assert.True(t, available)
+
+	available, err = IsExtensionAvailable(ctx, db, "does_not_exist")
+	require.NoError(t, err)
+	assert.False(t, available)
+
+	require.NoError(t, CreateExtension(ctx, db, name, "public", ""))
+	ext, err = GetExtension(ctx, db, name)
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+	assert.Equal(t, "public", ext.Schema)
+
+	require.NoError(t, AlterExtension(ctx, db, name, "ext_test", ""))
+	ext, err = GetExtension(ctx, db, name)
+	require.NoError(t, err)
+	assert.Equal(t, "ext_test", ext.Schema)
+
+	require.NoError(t, DropExtension(ctx, db, name))
+}
+
+func TestSchema(t *testing.T) {
+	ctx, uri := openTestDatabase(t)
+	db, err := Open(ctx, uri, "postgres")
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = CreateSchema(ctx, db, "schema_test", "missing_role")
+	assert.True(t, IsMissingObject(err))
+
+	_, err = db.ExecContext(ctx, `CREATE ROLE "schema_owner"`)
+	require.NoError(t, err)
+	defer db.ExecContext(ctx, `DROP ROLE "schema_owner"`) //nolint:errcheck
+
+	require.NoError(t, CreateSchema(ctx, db, "schema_test", ""))
+	require.NoError(t, AlterSchemaOwner(ctx, db, "schema_test", "schema_owner"))
+
+	owner, exists, err := GetSchemaOwner(ctx, db, "schema_test")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "schema_owner", owner)
+
+	require.NoError(t, DropSchema(ctx, db, "schema_test"))
+	_, exists, err = GetSchemaOwner(ctx, db, "schema_test")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestApplyGrants(t *testing.T) {
+	ctx, uri := openTestDatabase(t)
+	db, err := Open(ctx, uri, "postgres")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, `CREATE ROLE "grant_test"`)
+	require.NoError(t, err)
+	defer db.ExecContext(ctx, `DROP OWNED BY "grant_test"; DROP ROLE "grant_test"`) //nolint:errcheck
+
+	hasUsage := func() bool {
+		var ok bool
+		require.NoError(t, db.QueryRowContext(ctx, `SELECT has_schema_privilege('grant_test', 'public', 'USAGE')`).Scan(&ok))
+		return ok
+	}
+
+	require.NoError(t, ApplyGrants(ctx, db, nil, []string{`GRANT USAGE ON SCHEMA "public" TO "grant_test"`}))
+	assert.True(t, hasUsage())
+
+	// Revokes of missing objects don't fail the transaction
+	revokes := []string{
+		`REVOKE USAGE ON SCHEMA "public" FROM "grant_test"`,
+		`REVOKE USAGE ON SCHEMA "missing_schema" FROM "grant_test"`,
+	}
+	require.NoError(t, ApplyGrants(ctx, db, revokes, nil))
+	assert.False(t, hasUsage())
+
+	// A failed grant rolls back the revokes
+	require.NoError(t, ApplyGrants(ctx, db, nil, []string{`GRANT USAGE ON SCHEMA "public" TO "grant_test"`}))
+	err = ApplyGrants(ctx, db, revokes[:1], []string{`GRANT USAGE ON SCHEMA "missing_schema" TO "grant_test"`})
+	assert.Error(t, err)
+	assert.True(t, hasUsage())
+}